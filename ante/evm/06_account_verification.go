@@ -1,6 +1,8 @@
 package evm
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
@@ -27,9 +29,71 @@ func VerifyAccountBalance(
 	from common.Address,
 	txData evmtypes.TxData,
 ) error {
+	account, err := VerifyAccount(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifySponsoredAccountBalance checks that the account balance is greater than the value
+// transferred by the transaction, which is used for sponsored transactions where the fees
+// are paid by a fee granter.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+// This method will fail if:
+// - from address is NOT an EOA
+// - account balance is lower than the transaction value
+func VerifySponsoredAccountBalance(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	account, err := VerifyAccount(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	value := txData.GetValue()
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if account.Balance.ToBig().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"failed to check sender balance: sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
+
+// VerifyAccount checks that the sender is an EOA without checking its balance, which is
+// used for sponsored transactions where the fees are paid by a fee granter.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+func VerifyAccount(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
-		return errorsmod.Wrapf(
+		return nil, errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
 		)
@@ -41,9 +105,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
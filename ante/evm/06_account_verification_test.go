@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/os/grpc"
 	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
 	"github.com/cosmos/evm/testutil/integration/os/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -141,6 +142,132 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestVerifySponsoredAccountBalance() {
+	// Setup
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    suite.chainID,
+			EVMChainID: suite.evmChainID,
+		}),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	senderKey := keyring.GetKey(1)
+	unfundedAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name                   string
+		from                   common.Address
+		expectedError          error
+		generateAccountAndArgs func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs)
+	}{
+		{
+			name:          "fail: sender is not EOA",
+			from:          senderKey.Addr,
+			expectedError: errortypes.ErrInvalidType,
+			generateAccountAndArgs: func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, from)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(from, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				statedbAccount.CodeHash = []byte("test")
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "fail: sender balance is lower than the transaction value",
+			from:          senderKey.Addr,
+			expectedError: errortypes.ErrInsufficientFunds,
+			generateAccountAndArgs: func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, from)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(from, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				txArgs.Amount = new(big.Int).Add(statedbAccount.Balance.ToBig(), big.NewInt(1))
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "fail: unfunded sender transfers value",
+			from:          unfundedAddr,
+			expectedError: errortypes.ErrInsufficientFunds,
+			generateAccountAndArgs: func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs) {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(from, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				txArgs.Amount = big.NewInt(1)
+				return nil, txArgs
+			},
+		},
+		{
+			name:          "fail: tx value is negative",
+			from:          senderKey.Addr,
+			expectedError: errortypes.ErrInvalidCoins,
+			generateAccountAndArgs: func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, from)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(from, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				txArgs.Amount = big.NewInt(-1)
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "success: unfunded sender without value does not pay the fees",
+			from:          unfundedAddr,
+			expectedError: nil,
+			generateAccountAndArgs: func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs) {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(from, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				txArgs.Amount = nil
+				return nil, txArgs
+			},
+		},
+		{
+			name:          "success: sender balance covers the transaction value",
+			from:          senderKey.Addr,
+			expectedError: nil,
+			generateAccountAndArgs: func(from common.Address) (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, from)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(from, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				txArgs.Amount = statedbAccount.Balance.ToBig()
+				return statedbAccount, txArgs
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), suite.chainID, tc.name), func() {
+			statedbAccount, txArgs := tc.generateAccountAndArgs(tc.from)
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			err = evm.VerifySponsoredAccountBalance(
+				unitNetwork.GetContext(),
+				unitNetwork.App.AccountKeeper,
+				statedbAccount,
+				tc.from,
+				txData,
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 func getDefaultStateDBAccount(unitNetwork *network.UnitTestNetwork, addr common.Address) *statedb.Account {
 	statedb := unitNetwork.GetStateDB()
 	return statedb.Keeper().GetAccount(unitNetwork.GetContext(), addr)
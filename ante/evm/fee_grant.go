@@ -0,0 +1,78 @@
+package evm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/x/vm/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// GetFeeGranter returns the fee granter registered for the contract called by the
+// transaction. Contract creations, transactions sent by the granter itself and
+// chains without a feegrant keeper are never sponsored.
+func GetFeeGranter(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
+	from common.Address,
+	to *common.Address,
+) (common.Address, bool) {
+	if feegrantKeeper == nil || to == nil {
+		return common.Address{}, false
+	}
+
+	granter, found := evmKeeper.GetFeeGranter(ctx, *to)
+	if !found || granter == from {
+		return common.Address{}, false
+	}
+
+	return granter, true
+}
+
+// UseFeeGrant deducts the transaction fees from the allowance given by the fee granter
+// to the sender and returns the account that pays the fees. If the granter has no
+// allowance for the sender, the sender pays the fees itself and its balance is checked
+// against the total transaction cost.
+func UseFeeGrant(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
+	feeGranter common.Address,
+	ethMsg *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	fees sdk.Coins,
+) (sdk.AccAddress, error) {
+	from := ethMsg.GetFrom()
+
+	err := feegrantKeeper.UseGrantedFees(ctx, feeGranter.Bytes(), from, fees, []sdk.Msg{ethMsg})
+	if errorsmod.IsOf(err, errortypes.ErrNotFound) {
+		balance := evmKeeper.GetBalance(ctx, common.BytesToAddress(from))
+		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(balance.ToBig()), txData); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to check sender balance")
+		}
+		return from, nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, common.BytesToAddress(from))
+	}
+
+	// the leftover gas of the transaction is refunded to the fee granter
+	evmKeeper.SetTransientFeePayer(ctx, common.HexToHash(ethMsg.Hash), feeGranter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(feeGranter.Bytes()).String()),
+		),
+	)
+
+	return feeGranter.Bytes(), nil
+}
@@ -0,0 +1,195 @@
+package evm_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmante "github.com/cosmos/evm/ante/evm"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/os/factory"
+	"github.com/cosmos/evm/testutil/integration/os/grpc"
+	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
+	"github.com/cosmos/evm/testutil/integration/os/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *EvmAnteTestSuite) TestGetFeeGranter() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    suite.chainID,
+			EVMChainID: suite.evmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	granter := keyring.GetAddr(0)
+	sender := keyring.GetAddr(1)
+	contractAddr := utiltx.GenerateAddress()
+	unitNetwork.App.EVMKeeper.SetFeeGranter(unitNetwork.GetContext(), contractAddr, granter)
+
+	testCases := []struct {
+		name          string
+		from          common.Address
+		to            *common.Address
+		withoutKeeper bool
+		expFound      bool
+	}{
+		{
+			name:     "not sponsored: contract creation",
+			from:     sender,
+			to:       nil,
+			expFound: false,
+		},
+		{
+			name:          "not sponsored: feegrant keeper is nil",
+			from:          sender,
+			to:            &contractAddr,
+			withoutKeeper: true,
+			expFound:      false,
+		},
+		{
+			name:     "not sponsored: contract has no fee granter",
+			from:     sender,
+			to:       &common.Address{},
+			expFound: false,
+		},
+		{
+			name:     "not sponsored: sender is the fee granter",
+			from:     granter,
+			to:       &contractAddr,
+			expFound: false,
+		},
+		{
+			name:     "sponsored: contract has a fee granter",
+			from:     sender,
+			to:       &contractAddr,
+			expFound: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), suite.chainID, tc.name), func() {
+			var feegrantKeeper authante.FeegrantKeeper = unitNetwork.App.FeeGrantKeeper
+			if tc.withoutKeeper {
+				feegrantKeeper = nil
+			}
+
+			feeGranter, found := evmante.GetFeeGranter(
+				unitNetwork.GetContext(),
+				unitNetwork.App.EVMKeeper,
+				feegrantKeeper,
+				tc.from,
+				tc.to,
+			)
+
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(granter, feeGranter)
+			}
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestUseFeeGrant() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    suite.chainID,
+			EVMChainID: suite.evmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	granterKey := keyring.GetKey(0)
+	senderKey := keyring.GetKey(1)
+
+	fees := sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(1000)))
+
+	testCases := []struct {
+		name          string
+		allowance     feegrant.FeeAllowanceI
+		expFeePayer   sdktypes.AccAddress
+		expSponsored  bool
+		expectedError string
+	}{
+		{
+			name:        "success: no allowance, sender pays the fees",
+			expFeePayer: senderKey.AccAddr,
+		},
+		{
+			name: "fail: allowance spend limit is lower than the fees",
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(1))),
+			},
+			expectedError: "does not allow to pay fees",
+		},
+		{
+			name:         "success: granter pays the fees",
+			allowance:    &feegrant.BasicAllowance{},
+			expFeePayer:  granterKey.AccAddr,
+			expSponsored: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), suite.chainID, tc.name), func() {
+			ctx := unitNetwork.GetContext()
+			if tc.allowance != nil {
+				err := unitNetwork.App.FeeGrantKeeper.GrantAllowance(ctx, granterKey.AccAddr, senderKey.AccAddr, tc.allowance)
+				suite.Require().NoError(err)
+			}
+
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			msg, err := txFactory.GenerateSignedMsgEthereumTx(senderKey.Priv, txArgs)
+			suite.Require().NoError(err)
+			txData, err := evmtypes.UnpackTxData(msg.Data)
+			suite.Require().NoError(err)
+
+			// Function under test
+			feePayer, err := evmante.UseFeeGrant(
+				ctx,
+				unitNetwork.App.EVMKeeper,
+				unitNetwork.App.FeeGrantKeeper,
+				granterKey.Addr,
+				&msg,
+				txData,
+				fees,
+			)
+
+			if tc.expectedError != "" {
+				suite.Require().ErrorContains(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFeePayer, feePayer)
+
+				refundPayer, found := unitNetwork.App.EVMKeeper.GetTransientFeePayer(ctx, common.HexToHash(msg.Hash))
+				suite.Require().Equal(tc.expSponsored, found)
+				if tc.expSponsored {
+					suite.Require().Equal(granterKey.Addr, refundPayer)
+				}
+			}
+
+			// Remove the allowance and clean block for next test
+			if tc.allowance != nil {
+				msgSrv := feegrantkeeper.NewMsgServerImpl(unitNetwork.App.FeeGrantKeeper)
+				_, _ = msgSrv.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+					Granter: granterKey.AccAddr.String(),
+					Grantee: senderKey.AccAddr.String(),
+				})
+			}
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
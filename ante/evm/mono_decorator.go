@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // MonoDecorator is a single decorator that handles all the prechecks for
@@ -24,6 +25,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
 }
 
@@ -33,16 +35,21 @@ type MonoDecorator struct {
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
 // decorators using the returned DecoratorUtils
+//
+// The feegrant keeper is optional. When set, the fees of transactions calling a
+// contract with a registered fee granter are deducted from the granter's allowance.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 	}
 }
@@ -139,14 +146,30 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// We get the account with the balance from the EVM keeper because it is
 		// using a wrapper of the bank keeper as a dependency to scale all
 		// balances to 18 decimals.
+		//
+		// Sponsored transactions only require the sender balance to cover the
+		// transferred value, as the fees are deducted from the allowance of the
+		// fee granter.
+		feeGranter, sponsored := GetFeeGranter(ctx, md.evmKeeper, md.feegrantKeeper, fromAddr, txData.GetTo())
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(
-			ctx,
-			md.accountKeeper,
-			account,
-			fromAddr,
-			txData,
-		); err != nil {
+		if sponsored {
+			err = VerifySponsoredAccountBalance(
+				ctx,
+				md.accountKeeper,
+				account,
+				fromAddr,
+				txData,
+			)
+		} else {
+			err = VerifyAccountBalance(
+				ctx,
+				md.accountKeeper,
+				account,
+				fromAddr,
+				txData,
+			)
+		}
+		if err != nil {
 			return ctx, err
 		}
 
@@ -184,11 +207,19 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		feePayer := from
+		if sponsored {
+			feePayer, err = UseFeeGrant(ctx, md.evmKeeper, md.feegrantKeeper, feeGranter, ethMsg, txData, msgFees)
+			if err != nil {
+				return ctx, err
+			}
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			md.evmKeeper,
 			msgFees,
-			from,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
@@ -36,6 +36,10 @@ type EVMKeeper interface {
 	// GetMinGasPrice returns the MinGasPrice param from the fee market module
 	// adapted according to the evm denom decimals
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
+	// GetFeeGranter returns the fee granter registered for the given contract
+	GetFeeGranter(ctx sdk.Context, contract common.Address) (common.Address, bool)
+	// SetTransientFeePayer sets the account that paid the fees of a sponsored transaction
+	SetTransientFeePayer(ctx sdk.Context, txHash common.Hash, feePayer common.Address)
 }
 
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
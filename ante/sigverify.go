@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/secp256r1"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -29,6 +30,8 @@ const (
 //
 // - ethsecp256k1 (Ethereum keys)
 //
+// - secp256r1 (WebAuthn passkeys)
+//
 // - ed25519 (Validators)
 //
 // - multisig (Cosmos SDK multisigs)
@@ -42,6 +45,10 @@ func SigVerificationGasConsumer(
 		// Ethereum keys
 		meter.ConsumeGas(Secp256k1VerifyCost, "ante verify: eth_secp256k1")
 		return nil
+	case *secp256r1.PubKey:
+		// Passkeys
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: eth_secp256r1")
+		return nil
 	case *ed25519.PubKey:
 		// Validator keys
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/evm/ante"
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	evmsecp256r1 "github.com/cosmos/evm/crypto/secp256r1"
 	"github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/server/config"
 
@@ -47,6 +48,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 
 	ethsecKey, _ := ethsecp256k1.GenerateKey()
 	skR1, _ := secp256r1.GenPrivKey()
+	passkey, _ := evmsecp256r1.GenerateKey()
 
 	type args struct {
 		meter  storetypes.GasMeter
@@ -84,6 +86,12 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 			p.SigVerifyCostSecp256r1(),
 			true,
 		},
+		{
+			"PubKeyEthSecp256r1",
+			args{storetypes.NewInfiniteGasMeter(), nil, passkey.PubKey(), params},
+			p.SigVerifyCostSecp256r1(),
+			false,
+		},
 		{
 			"Multisig",
 			args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params},
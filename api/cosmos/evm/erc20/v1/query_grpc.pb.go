@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_TokenPairs_FullMethodName = "/cosmos.evm.erc20.v1.Query/TokenPairs"
-	Query_TokenPair_FullMethodName  = "/cosmos.evm.erc20.v1.Query/TokenPair"
-	Query_Params_FullMethodName     = "/cosmos.evm.erc20.v1.Query/Params"
+	Query_TokenPairs_FullMethodName            = "/cosmos.evm.erc20.v1.Query/TokenPairs"
+	Query_TokenPair_FullMethodName             = "/cosmos.evm.erc20.v1.Query/TokenPair"
+	Query_Params_FullMethodName                = "/cosmos.evm.erc20.v1.Query/Params"
+	Query_TokenPairsByFilter_FullMethodName    = "/cosmos.evm.erc20.v1.Query/TokenPairsByFilter"
+	Query_ERC20AddressByIBCHash_FullMethodName = "/cosmos.evm.erc20.v1.Query/ERC20AddressByIBCHash"
+	Query_DenomByERC20Address_FullMethodName   = "/cosmos.evm.erc20.v1.Query/DenomByERC20Address"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,15 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenPairsByFilter retrieves the registered token pairs that match the
+	// given owner, status and origin filters
+	TokenPairsByFilter(ctx context.Context, in *QueryTokenPairsByFilterRequest, opts ...grpc.CallOption) (*QueryTokenPairsByFilterResponse, error)
+	// ERC20AddressByIBCHash retrieves the ERC20 contract address of the token
+	// pair registered for the given IBC denomination hash
+	ERC20AddressByIBCHash(ctx context.Context, in *QueryERC20AddressByIBCHashRequest, opts ...grpc.CallOption) (*QueryERC20AddressByIBCHashResponse, error)
+	// DenomByERC20Address retrieves the Cosmos denomination of the token pair
+	// registered for the given ERC20 contract or precompile address
+	DenomByERC20Address(ctx context.Context, in *QueryDenomByERC20AddressRequest, opts ...grpc.CallOption) (*QueryDenomByERC20AddressResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +83,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenPairsByFilter(ctx context.Context, in *QueryTokenPairsByFilterRequest, opts ...grpc.CallOption) (*QueryTokenPairsByFilterResponse, error) {
+	out := new(QueryTokenPairsByFilterResponse)
+	err := c.cc.Invoke(ctx, Query_TokenPairsByFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20AddressByIBCHash(ctx context.Context, in *QueryERC20AddressByIBCHashRequest, opts ...grpc.CallOption) (*QueryERC20AddressByIBCHashResponse, error) {
+	out := new(QueryERC20AddressByIBCHashResponse)
+	err := c.cc.Invoke(ctx, Query_ERC20AddressByIBCHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomByERC20Address(ctx context.Context, in *QueryDenomByERC20AddressRequest, opts ...grpc.CallOption) (*QueryDenomByERC20AddressResponse, error) {
+	out := new(QueryDenomByERC20AddressResponse)
+	err := c.cc.Invoke(ctx, Query_DenomByERC20Address_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +120,15 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenPairsByFilter retrieves the registered token pairs that match the
+	// given owner, status and origin filters
+	TokenPairsByFilter(context.Context, *QueryTokenPairsByFilterRequest) (*QueryTokenPairsByFilterResponse, error)
+	// ERC20AddressByIBCHash retrieves the ERC20 contract address of the token
+	// pair registered for the given IBC denomination hash
+	ERC20AddressByIBCHash(context.Context, *QueryERC20AddressByIBCHashRequest) (*QueryERC20AddressByIBCHashResponse, error)
+	// DenomByERC20Address retrieves the Cosmos denomination of the token pair
+	// registered for the given ERC20 contract or precompile address
+	DenomByERC20Address(context.Context, *QueryDenomByERC20AddressRequest) (*QueryDenomByERC20AddressResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +145,15 @@ func (UnimplementedQueryServer) TokenPair(context.Context, *QueryTokenPairReques
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenPairsByFilter(context.Context, *QueryTokenPairsByFilterRequest) (*QueryTokenPairsByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairsByFilter not implemented")
+}
+func (UnimplementedQueryServer) ERC20AddressByIBCHash(context.Context, *QueryERC20AddressByIBCHashRequest) (*QueryERC20AddressByIBCHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20AddressByIBCHash not implemented")
+}
+func (UnimplementedQueryServer) DenomByERC20Address(context.Context, *QueryDenomByERC20AddressRequest) (*QueryDenomByERC20AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomByERC20Address not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +221,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenPairsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenPairsByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairsByFilter(ctx, req.(*QueryTokenPairsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20AddressByIBCHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20AddressByIBCHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20AddressByIBCHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ERC20AddressByIBCHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20AddressByIBCHash(ctx, req.(*QueryERC20AddressByIBCHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomByERC20Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomByERC20AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomByERC20Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomByERC20Address_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomByERC20Address(ctx, req.(*QueryDenomByERC20AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +294,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenPairsByFilter",
+			Handler:    _Query_TokenPairsByFilter_Handler,
+		},
+		{
+			MethodName: "ERC20AddressByIBCHash",
+			Handler:    _Query_ERC20AddressByIBCHash_Handler,
+		},
+		{
+			MethodName: "DenomByERC20Address",
+			Handler:    _Query_DenomByERC20Address_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/query.proto",
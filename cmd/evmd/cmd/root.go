@@ -243,6 +243,11 @@ func initRootCmd(rootCmd *cobra.Command, osApp *evmd.EVMD) {
 		cosmosevmcmd.KeyCommands(evmd.DefaultNodeHome, true),
 	)
 
+	// add standalone JSON-RPC server command
+	rootCmd.AddCommand(
+		cosmosevmserver.NewJSONRPCCmd(evmd.EvmAppOptions),
+	)
+
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		sdkserver.StatusCommand(),
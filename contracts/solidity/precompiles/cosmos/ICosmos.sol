@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICosmos contract's address.
+address constant COSMOS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The ICosmos contract's instance.
+ICosmos constant COSMOS_CONTRACT = ICosmos(COSMOS_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Cosmos Precompiled Contract
+/// @dev The interface through which solidity contracts will execute Cosmos SDK messages.
+/// Only the messages whose type URL is allowed by the governance-controlled
+/// allowedCosmosMessages parameter of the x/vm module can be executed.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface ICosmos {
+    /// @dev Emitted when a Cosmos SDK message is executed.
+    /// @param signer The address of the message signer
+    /// @param typeUrl The type URL of the message
+    event MessageDispatched(address indexed signer, string typeUrl);
+
+    /// @dev Dispatch executes a Cosmos SDK message with the caller as its signer. The
+    /// signer fields of the message are overwritten with the caller address, so the
+    /// message is executed on behalf of the calling contract, or of the transaction
+    /// origin when called directly.
+    /// @param typeUrl The type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend"
+    /// @param message The protobuf encoding of the message
+    /// @return response The protobuf encoding of the message response
+    function dispatch(
+        string calldata typeUrl,
+        bytes calldata message
+    ) external returns (bytes memory response);
+
+    /// @dev IsAllowed returns true if the message with the given type URL can be executed.
+    /// @param typeUrl The type URL of the message
+    /// @return allowed true if the message is allowed
+    function isAllowed(string calldata typeUrl) external view returns (bool allowed);
+
+    /// @dev AllowedMessages returns the type URLs of the messages that can be executed.
+    /// @return typeUrls The type URLs of the allowed messages
+    function allowedMessages() external view returns (string[] memory typeUrls);
+}
@@ -42,6 +42,16 @@ interface DistributionI {
     /// @param amount the amount being claimed
     event ClaimRewards(address indexed delegatorAddress, uint256 amount);
 
+    /// @dev CompoundRewards defines an Event emitted for every validator the rewards are re-delegated to
+    /// @param delegatorAddress the address of the delegator
+    /// @param validatorAddress the address of the validator
+    /// @param amount the amount being re-delegated
+    event CompoundRewards(
+        address indexed delegatorAddress,
+        address indexed validatorAddress,
+        uint256 amount
+    );
+
     /// @dev SetWithdrawerAddress defines an Event emitted when a new withdrawer address is being set
     /// @param caller the caller of the transaction
     /// @param withdrawerAddress the newly set withdrawer address
@@ -99,6 +109,17 @@ interface DistributionI {
         uint32 maxRetrieve
     ) external returns (bool success);
 
+    /// @dev Claims the rewards from a select set of validators or all of them for a delegator
+    /// and re-delegates the rewards in the bond denom to the same validators.
+    /// The delegator's withdraw address must be the delegator itself.
+    /// @param delegatorAddress The address of the delegator
+    /// @param maxValidators The maximum number of validators to compound rewards from
+    /// @return amount The total amount re-delegated
+    function compoundRewards(
+        address delegatorAddress,
+        uint32 maxValidators
+    ) external returns (uint256 amount);
+
     /// @dev Change the address, that can withdraw the rewards of a delegator.
     /// Note that this address cannot be a module account.
     /// @param delegatorAddress The address of the delegator
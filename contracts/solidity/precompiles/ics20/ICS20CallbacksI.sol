@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Evmos Team
+/// @title ICS20 Packet Callbacks Interface
+/// @dev The interface that contracts sending ICS20 transfers through the ICS20
+/// precompile can implement to be notified about the outcome of the packet.
+/// The callbacks are called by the erc20 module account with a gas limit of
+/// 300,000. A reverting callback does not affect the acknowledgement or the
+/// timeout of the packet, nor the refund of the tokens.
+interface ICS20CallbacksI {
+    /// @dev Called when the packet sent by the contract is acknowledged.
+    /// @param sourcePort The port on which the packet was sent.
+    /// @param sourceChannel The channel on which the packet was sent.
+    /// @param sequence The sequence of the packet.
+    /// @param success Whether the packet was successfully received on the
+    /// counterparty chain. If false, the tokens have been refunded.
+    /// @param acknowledgement The raw acknowledgement bytes.
+    function onAckPacket(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence,
+        bool success,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev Called when the packet sent by the contract has timed out. The
+    /// tokens have been refunded.
+    /// @param sourcePort The port on which the packet was sent.
+    /// @param sourceChannel The channel on which the packet was sent.
+    /// @param sequence The sequence of the packet.
+    function onTimeoutPacket(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence
+    ) external;
+}
@@ -73,6 +73,33 @@ interface ICS20I {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferFrom defines a method for performing an IBC transfer on behalf of the sender.
+    /// The caller must have been granted an allowance by the sender on the ERC20 precompile
+    /// of the denomination, which is decreased by the transferred amount.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the owner of the tokens
+    /// @param receiver the bech32 address of the receiver
+    /// @param timeoutHeight the timeout height relative to the current block height.
+    /// The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
+    /// The timeout is disabled when set to 0
+    /// @param memo optional memo
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferFrom(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
     /// @dev denoms Defines a method for returning all denoms.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denoms(
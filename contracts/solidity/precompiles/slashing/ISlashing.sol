@@ -51,6 +51,29 @@ interface ISlashing {
     /// @param validator The address of the validator
     event ValidatorUnjailed(address indexed validator);
 
+    /// @dev Emitted by the chain at the end of the block when a validator is slashed.
+    /// This event is not emitted by a transaction and can only be queried through
+    /// eth_getLogs or log filters.
+    /// @param validator The address of the validator
+    /// @param power The consensus power of the validator at the infraction height
+    /// @param burnedAmount The amount of tokens burned
+    /// @param reason The reason of the slashing ("double_sign", "missing_signature" or "unspecified")
+    event ValidatorSlashed(address indexed validator, uint256 power, uint256 burnedAmount, string reason);
+
+    /// @dev Emitted by the chain at the end of the block when a validator is jailed.
+    /// This event is not emitted by a transaction and can only be queried through
+    /// eth_getLogs or log filters.
+    /// @param validator The address of the validator
+    event ValidatorJailed(address indexed validator);
+
+    /// @dev Emitted by the chain at the end of the block when an unbonding delegation
+    /// completes. This event is not emitted by a transaction and can only be queried
+    /// through eth_getLogs or log filters.
+    /// @param validator The address of the validator
+    /// @param delegator The address of the delegator
+    /// @param amount The amount of tokens returned to the delegator
+    event UnbondingCompleted(address indexed validator, address indexed delegator, uint256 amount);
+
     /// @dev GetSigningInfo returns the signing info for a specific validator.
     /// @param consAddress The validator consensus address
     /// @return signingInfo The validator signing info
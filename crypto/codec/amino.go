@@ -2,6 +2,7 @@ package codec
 
 import (
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/secp256r1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
@@ -16,11 +17,15 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		ethsecp256k1.PubKeyName, nil)
 	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{},
 		ethsecp256k1.PrivKeyName, nil)
+	cdc.RegisterConcrete(&secp256r1.PubKey{},
+		secp256r1.PubKeyName, nil)
+	cdc.RegisterConcrete(&secp256r1.PrivKey{},
+		secp256r1.PrivKeyName, nil)
 
 	keyring.RegisterLegacyAminoCodec(cdc)
 	cryptocodec.RegisterCrypto(cdc)
 
-	// NOTE: update SDK's amino codec to include the ethsecp256k1 keys.
+	// NOTE: update SDK's amino codec to include the ethsecp256k1 and secp256r1 keys.
 	// DO NOT REMOVE unless deprecated on the SDK.
 	legacy.Cdc = cdc
 }
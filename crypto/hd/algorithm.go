@@ -1,6 +1,13 @@
 package hd
 
 import (
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/ethereum/go-ethereum/accounts"
@@ -8,6 +15,7 @@ import (
 	bip39 "github.com/tyler-smith/go-bip39"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/secp256r1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -17,13 +25,16 @@ import (
 const (
 	// EthSecp256k1Type defines the ECDSA secp256k1 used on Ethereum
 	EthSecp256k1Type = hd.PubKeyType(ethsecp256k1.KeyType)
+	// EthSecp256r1Type defines the ECDSA secp256r1 (NIST P-256) used by passkeys
+	EthSecp256r1Type = hd.PubKeyType(secp256r1.KeyType)
 )
 
 var (
 	// SupportedAlgorithms defines the list of signing algorithms used on Cosmos EVM:
 	//  - eth_secp256k1 (Ethereum)
 	//  - secp256k1 (Tendermint)
-	SupportedAlgorithms = keyring.SigningAlgoList{EthSecp256k1, hd.Secp256k1}
+	//  - eth_secp256r1 (software passkeys)
+	SupportedAlgorithms = keyring.SigningAlgoList{EthSecp256k1, hd.Secp256k1, EthSecp256r1}
 	// SupportedAlgorithmsLedger defines the list of signing algorithms used on Cosmos EVM for the Ledger device:
 	//  - eth_secp256k1 (Ethereum)
 	//  - secp256k1 (Tendermint)
@@ -31,7 +42,7 @@ var (
 )
 
 // EthSecp256k1Option defines a function keys options for the ethereum Secp256k1 curve.
-// It supports eth_secp256k1 and secp256k1 keys for accounts.
+// It supports eth_secp256k1, secp256k1 and eth_secp256r1 keys for accounts.
 func EthSecp256k1Option() keyring.Option {
 	return func(options *keyring.Options) {
 		options.SupportedAlgos = SupportedAlgorithms
@@ -108,3 +119,115 @@ func (s ethSecp256k1Algo) Generate() hd.GenerateFn {
 		}
 	}
 }
+
+var (
+	_ keyring.SignatureAlgo = EthSecp256r1
+
+	// EthSecp256r1 uses the NIST P-256 ECDSA parameters, with SLIP-10 key derivation.
+	EthSecp256r1 = ethSecp256r1Algo{}
+)
+
+// slip10P256Seed is the SLIP-10 master key HMAC key of the NIST P-256 curve
+var slip10P256Seed = []byte("Nist256p1 seed")
+
+type ethSecp256r1Algo struct{}
+
+// Name returns eth_secp256r1
+func (s ethSecp256r1Algo) Name() hd.PubKeyType {
+	return EthSecp256r1Type
+}
+
+// Derive derives and returns the eth_secp256r1 private key for the given mnemonic and HD path,
+// following SLIP-10 as BIP-32 is only defined for secp256k1.
+func (s ethSecp256r1Algo) Derive() hd.DeriveFn {
+	return func(mnemonic, bip39Passphrase, path string) ([]byte, error) {
+		hdpath, err := accounts.ParseDerivationPath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		key, chainCode := slip10P256Master(seed)
+		for _, n := range hdpath {
+			key, chainCode, err = slip10P256Child(key, chainCode, n)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return key, nil
+	}
+}
+
+// Generate generates a eth_secp256r1 private key from the given bytes.
+func (s ethSecp256r1Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		bzArr := make([]byte, secp256r1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &secp256r1.PrivKey{
+			Key: bzArr,
+		}
+	}
+}
+
+// slip10P256Master returns the SLIP-10 master private key and chain code of the seed.
+func slip10P256Master(seed []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, slip10P256Seed)
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	// the master key must be a valid scalar, otherwise the hash is hashed again
+	for !validP256Scalar(i[:32]) {
+		mac = hmac.New(sha512.New, slip10P256Seed)
+		mac.Write(i)
+		i = mac.Sum(nil)
+	}
+
+	return i[:32], i[32:]
+}
+
+// slip10P256Child returns the SLIP-10 child private key and chain code at the given index.
+func slip10P256Child(key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	var data []byte
+	if index >= hdkeychain.HardenedKeyStart {
+		data = append([]byte{0}, key...)
+	} else {
+		priv, err := ecdh.P256().NewPrivateKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		// the uncompressed public key is 0x04 || X || Y
+		pub := priv.PublicKey().Bytes()
+		data = elliptic.MarshalCompressed(elliptic.P256(), new(big.Int).SetBytes(pub[1:33]), new(big.Int).SetBytes(pub[33:]))
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	n := elliptic.P256().Params().N
+	for {
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		i := mac.Sum(nil)
+
+		child := new(big.Int).SetBytes(i[:32])
+		if child.Cmp(n) < 0 {
+			child.Add(child, new(big.Int).SetBytes(key))
+			child.Mod(child, n)
+			if child.Sign() != 0 {
+				return child.FillBytes(make([]byte, 32)), i[32:], nil
+			}
+		}
+
+		// invalid child key, derive again from the right half of the hash
+		data = binary.BigEndian.AppendUint32(append([]byte{1}, i[32:]...), index)
+	}
+}
+
+// validP256Scalar returns true if the bytes are a non-zero scalar lower than the P-256 order.
+func validP256Scalar(bz []byte) bool {
+	k := new(big.Int).SetBytes(bz)
+	return k.Sign() != 0 && k.Cmp(elliptic.P256().Params().N) < 0
+}
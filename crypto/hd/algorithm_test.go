@@ -1,10 +1,12 @@
 package hd
 
 import (
+	"encoding/hex"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
@@ -127,3 +129,47 @@ func TestDerivation(t *testing.T) {
 	require.NotEqual(t, common.BytesToAddress(privkey.PubKey().Address()).String(), badAccount.Address.String())
 	require.NotEqual(t, common.BytesToAddress(badPrivKey.PubKey().Address()).String(), account.Address.Hex())
 }
+
+func TestSecp256r1Keyring(t *testing.T) {
+	dir := t.TempDir()
+	mockIn := strings.NewReader("")
+	kr, err := keyring.New("cosmos", keyring.BackendTest, dir, mockIn, TestCodec, EthSecp256k1Option())
+	require.NoError(t, err)
+
+	info, mnemonic, err := kr.NewMnemonic("passkey", keyring.English, cosmosevmtypes.BIP44HDPath, keyring.DefaultBIP39Passphrase, EthSecp256r1)
+	require.NoError(t, err)
+	pubKey, err := info.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, string(EthSecp256r1Type), pubKey.Type())
+
+	bz, err := EthSecp256r1.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, cosmosevmtypes.BIP44HDPath)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(EthSecp256r1.Generate()(bz).PubKey()))
+
+	msg := []byte("hello world")
+	sig, _, err := kr.Sign("passkey", msg, 0)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
+
+// TestSecp256r1Derivation checks the derivation against the SLIP-10 test vector 1 of the nist256p1 curve.
+func TestSecp256r1Derivation(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	key, chainCode := slip10P256Master(seed)
+	require.Equal(t, "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2", hex.EncodeToString(key))
+	require.Equal(t, "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea", hex.EncodeToString(chainCode))
+
+	// m/0H/1
+	key, chainCode, err = slip10P256Child(key, chainCode, hdkeychain.HardenedKeyStart)
+	require.NoError(t, err)
+	require.Equal(t, "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c", hex.EncodeToString(key))
+	key, chainCode, err = slip10P256Child(key, chainCode, 1)
+	require.NoError(t, err)
+	require.Equal(t, "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129", hex.EncodeToString(key))
+	require.Equal(t, "4187afff1aafa8445010097fb99d23aee9f599450c7bd140b6826ac22ba21d0c", hex.EncodeToString(chainCode))
+
+	_, err = EthSecp256r1.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, "/wrong/hdPath")
+	require.Error(t, err)
+}
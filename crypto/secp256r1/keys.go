@@ -0,0 +1,382 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	tmcrypto "github.com/cometbft/cometbft/crypto"
+
+	"github.com/cosmos/evm/ethereum/eip712"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// PrivKeySize defines the size of the PrivKey bytes
+	PrivKeySize = 32
+	// PubKeySize defines the size of the PubKey bytes
+	PubKeySize = 33
+	// SignatureSize defines the size of a signature in [R || S] format
+	SignatureSize = 64
+	// KeyType is the string constant for the secp256r1 algorithm with Ethereum addresses
+	KeyType = "eth_secp256r1"
+)
+
+// Amino encoding names
+const (
+	// PrivKeyName defines the amino encoding name for the EthSecp256r1 private key
+	PrivKeyName = "os/PrivKeyEthSecp256r1"
+	// PubKeyName defines the amino encoding name for the EthSecp256r1 public key
+	PubKeyName = "os/PubKeyEthSecp256r1"
+)
+
+// webAuthnTypeGet is the client data type of a WebAuthn assertion
+const webAuthnTypeGet = "webauthn.get"
+
+// halfOrder is used to reject malleable signatures, whose S is in the upper
+// half of the curve order.
+var halfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+// ----------------------------------------------------------------------------
+// secp256r1 Private Key
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+// GenerateKey generates a new random private key. It returns an error upon
+// failure.
+func GenerateKey() (*PrivKey, error) {
+	priv, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivKey{
+		Key: priv.Bytes(),
+	}, nil
+}
+
+// Bytes returns the byte representation of the ECDSA Private Key.
+func (privKey PrivKey) Bytes() []byte {
+	bz := make([]byte, len(privKey.Key))
+	copy(bz, privKey.Key)
+
+	return bz
+}
+
+// PubKey returns the ECDSA private key's public key. If the privkey is not valid
+// it returns a nil value.
+func (privKey PrivKey) PubKey() cryptotypes.PubKey {
+	ecdsaPrivKey, err := privKey.ToECDSA()
+	if err != nil {
+		return nil
+	}
+
+	return &PubKey{
+		Key: elliptic.MarshalCompressed(elliptic.P256(), ecdsaPrivKey.X, ecdsaPrivKey.Y),
+	}
+}
+
+// Equals returns true if two ECDSA private keys are equal and false otherwise.
+func (privKey PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type returns eth_secp256r1
+func (privKey PrivKey) Type() string {
+	return KeyType
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size, expected %d got %d", PrivKeySize, len(bz))
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// Sign creates an ECDSA signature on the secp256r1 curve over the SHA-256 hash
+// of the provided message. The produced signature is 64 bytes in [R || S] format,
+// with S normalized to the lower half of the curve order.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	return privKey.signDigest(digest[:])
+}
+
+// signDigest signs the given hash, normalizing S to the lower half of the curve order.
+func (privKey PrivKey) signDigest(digest []byte) ([]byte, error) {
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		return nil, err
+	}
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	r.FillBytes(sig[:SignatureSize/2])
+	s.FillBytes(sig[SignatureSize/2:])
+	return sig, nil
+}
+
+// ToECDSA returns the ECDSA private key as a reference to ecdsa.PrivateKey type.
+func (privKey PrivKey) ToECDSA() (*ecdsa.PrivateKey, error) {
+	key, err := ecdh.P256().NewPrivateKey(privKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// the uncompressed public key is 0x04 || X || Y
+	pub := key.PublicKey().Bytes()
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:]),
+		},
+		D: new(big.Int).SetBytes(privKey.Key),
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// secp256r1 Public Key
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns the Ethereum style address of the ECDSA public key, i.e. the
+// last 20 bytes of the Keccak256 hash of its uncompressed coordinates.
+// The function will return an empty address if the public key is invalid.
+func (pubKey PubKey) Address() tmcrypto.Address {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Key)
+	if x == nil {
+		return nil
+	}
+
+	bz := make([]byte, 64)
+	x.FillBytes(bz[:32])
+	y.FillBytes(bz[32:])
+	return tmcrypto.Address(crypto.Keccak256(bz)[12:])
+}
+
+// Bytes returns the raw bytes of the ECDSA public key.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("EthPubKeySecp256r1{%X}", pubKey.Key)
+}
+
+// Type returns eth_secp256r1
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// VerifySignature verifies that the ECDSA public key created a given signature over
+// the provided message. The signed hash is the SHA-256 hash of the message, or the
+// Keccak256 hash of its EIP-712 representation.
+//
+// The signature is either in [R || S] format over the hash, with S in the lower half
+// of the curve order, or a protobuf encoded WebAuthnSignature whose challenge is the
+// hash, as produced by passkeys. The WebAuthnSignature signature is accepted in the
+// ASN.1 DER encoding returned by the WebAuthn API, and its S value is normalized.
+func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
+	digest := sha256.Sum256(msg)
+	return pubKey.verifyDigest(digest[:], sig) || pubKey.verifySignatureAsEIP712(msg, sig)
+}
+
+// Verifies the signature over the Keccak256 hash of the EIP-712 representation of the
+// message payload. This is to support signing a Cosmos payload using EIP-712.
+func (pubKey PubKey) verifySignatureAsEIP712(msg, sig []byte) bool {
+	eip712Bytes, err := eip712.GetEIP712BytesForMsg(msg)
+	if err != nil {
+		return false
+	}
+
+	if pubKey.verifyDigest(crypto.Keccak256(eip712Bytes), sig) {
+		return true
+	}
+
+	// Try verifying the signature using the legacy EIP-712 encoding
+	legacyEIP712Bytes, err := eip712.LegacyGetEIP712BytesForMsg(msg)
+	if err != nil {
+		return false
+	}
+
+	return pubKey.verifyDigest(crypto.Keccak256(legacyEIP712Bytes), sig)
+}
+
+// verifyDigest verifies a raw or WebAuthn signature over the given hash.
+func (pubKey PubKey) verifyDigest(digest, sig []byte) bool {
+	if len(sig) == SignatureSize {
+		return pubKey.verifySignatureECDSA(digest, sig)
+	}
+
+	var webAuthnSig WebAuthnSignature
+	if err := webAuthnSig.Unmarshal(sig); err != nil {
+		return false
+	}
+	return pubKey.verifyWebAuthn(digest, &webAuthnSig)
+}
+
+// verifyWebAuthn verifies a WebAuthn assertion, which signs the SHA-256 hash of the
+// authenticator data and of the client data hash. The client data must be the one of
+// an assertion whose challenge is the base64url encoded message hash.
+func (pubKey PubKey) verifyWebAuthn(digest []byte, sig *WebAuthnSignature) bool {
+	// the authenticator data holds the 32 bytes RP ID hash, the flags and the counter,
+	// the user presence flag must be set
+	if len(sig.AuthenticatorData) < 37 || sig.AuthenticatorData[32]&0x01 == 0 {
+		return false
+	}
+
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(sig.ClientDataJSON, &clientData); err != nil {
+		return false
+	}
+	if clientData.Type != webAuthnTypeGet || clientData.Challenge != base64.RawURLEncoding.EncodeToString(digest) {
+		return false
+	}
+
+	clientDataHash := sha256.Sum256(sig.ClientDataJSON)
+	signedHash := sha256.Sum256(append(bytes.Clone(sig.AuthenticatorData), clientDataHash[:]...))
+
+	r, s, err := parseWebAuthnSignature(sig.Signature)
+	if err != nil {
+		return false
+	}
+	return pubKey.verifyECDSA(signedHash[:], r, s)
+}
+
+// parseWebAuthnSignature returns the R and S values of a WebAuthn assertion signature,
+// either ASN.1 DER encoded as returned by the WebAuthn API or in [R || S] format. As the
+// authenticators don't normalize S, it is moved to the lower half of the curve order,
+// which doesn't change the validity of the signature.
+func parseWebAuthnSignature(sig []byte) (r, s *big.Int, err error) {
+	var der struct {
+		R, S *big.Int
+	}
+	if rest, err := asn1.Unmarshal(sig, &der); err == nil && len(rest) == 0 {
+		r, s = der.R, der.S
+	} else if len(sig) == SignatureSize {
+		r = new(big.Int).SetBytes(sig[:SignatureSize/2])
+		s = new(big.Int).SetBytes(sig[SignatureSize/2:])
+	} else {
+		return nil, nil, fmt.Errorf("invalid WebAuthn signature encoding")
+	}
+
+	n := elliptic.P256().Params().N
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(n) >= 0 || s.Cmp(n) >= 0 {
+		return nil, nil, fmt.Errorf("invalid WebAuthn signature values")
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s = new(big.Int).Sub(n, s)
+	}
+	return r, s, nil
+}
+
+// Perform standard ECDSA signature verification for the given hash and [R || S] signature,
+// rejecting malleable signatures.
+func (pubKey PubKey) verifySignatureECDSA(digest, sig []byte) bool {
+	r := new(big.Int).SetBytes(sig[:SignatureSize/2])
+	s := new(big.Int).SetBytes(sig[SignatureSize/2:])
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	return pubKey.verifyECDSA(digest, r, s)
+}
+
+// verifyECDSA verifies the (r, s) signature of the given hash with the public key.
+func (pubKey PubKey) verifyECDSA(digest []byte, r, s *big.Int) bool {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Key)
+	if x == nil {
+		return false
+	}
+
+	return Verify(digest, r, s, x, y)
+}
@@ -0,0 +1,246 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestPrivKey(t *testing.T) {
+	// validate type and equality
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	require.Implements(t, (*cryptotypes.PrivKey)(nil), privKey)
+
+	// validate inequality
+	privKey2, err := GenerateKey()
+	require.NoError(t, err)
+	require.False(t, privKey.Equals(privKey2))
+
+	// validate Ethereum style address
+	key, err := privKey.ToECDSA()
+	require.NoError(t, err)
+	bz := make([]byte, 64)
+	key.X.FillBytes(bz[:32])
+	key.Y.FillBytes(bz[32:])
+	require.Equal(t, crypto.Keccak256(bz)[12:], privKey.PubKey().Address().Bytes())
+
+	// validate the signature is over the SHA-256 hash of the message
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+	digest := sha256.Sum256(msg)
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	require.True(t, ecdsa.Verify(&key.PublicKey, digest[:], r, s))
+	require.True(t, s.Cmp(halfOrder) <= 0)
+
+	// invalid keys
+	_, err = (&PrivKey{Key: make([]byte, PrivKeySize)}).ToECDSA()
+	require.Error(t, err)
+	require.Nil(t, (&PrivKey{Key: []byte{1}}).PubKey())
+}
+
+func TestPubKey_VerifySignature(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	pubKey := &PubKey{Key: privKey.PubKey().Bytes()}
+	require.Implements(t, (*cryptotypes.PubKey)(nil), pubKey)
+
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	// malleable signature with S in the upper half of the order
+	n := elliptic.P256().Params().N
+	highS := append(append([]byte{}, sig[:32]...), new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:])).FillBytes(make([]byte, 32))...)
+
+	otherKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		pubKey *PubKey
+		msg    []byte
+		sig    []byte
+		expRes bool
+	}{
+		{"valid signature", pubKey, msg, sig, true},
+		{"different message", pubKey, []byte("hello"), sig, false},
+		{"different key", otherKey.PubKey().(*PubKey), msg, sig, false},
+		{"malleable signature", pubKey, msg, highS, false},
+		{"invalid signature", pubKey, msg, []byte{1, 2, 3}, false},
+		{"invalid public key", &PubKey{Key: []byte{1}}, msg, sig, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRes, tc.pubKey.VerifySignature(tc.msg, tc.sig))
+		})
+	}
+}
+
+// webAuthnSign signs the message hash as a passkey, returning the encoded assertion.
+func webAuthnSign(t *testing.T, privKey *PrivKey, flags byte, typ string, digest []byte) []byte {
+	t.Helper()
+	return webAuthnSignWithEncoding(t, privKey, flags, typ, digest, nil)
+}
+
+// webAuthnSignWithEncoding signs the message hash as a passkey, encoding the
+// [R || S] assertion signature with the given function if not nil.
+func webAuthnSignWithEncoding(t *testing.T, privKey *PrivKey, flags byte, typ string, digest []byte, encode func([]byte) []byte) []byte {
+	t.Helper()
+
+	authData := append(make([]byte, 32), flags, 0, 0, 0, 1)
+	clientDataJSON := fmt.Sprintf(
+		`{"type":%q,"challenge":%q,"origin":"https://wallet.example"}`,
+		typ, base64.RawURLEncoding.EncodeToString(digest),
+	)
+	clientDataHash := sha256.Sum256([]byte(clientDataJSON))
+	signedHash := sha256.Sum256(append(authData, clientDataHash[:]...))
+
+	sig, err := privKey.signDigest(signedHash[:])
+	require.NoError(t, err)
+	if encode != nil {
+		sig = encode(sig)
+	}
+
+	bz, err := (&WebAuthnSignature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    []byte(clientDataJSON),
+		Signature:         sig,
+	}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestPubKey_VerifyWebAuthnSignature(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	msg := []byte("hello world")
+	digest := sha256.Sum256(msg)
+
+	// authenticators return DER encoded signatures, whose S is not normalized
+	n := elliptic.P256().Params().N
+	highS := func(sig []byte) []byte {
+		s := new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:]))
+		require.True(t, s.Cmp(halfOrder) > 0)
+		return append(append([]byte{}, sig[:32]...), s.FillBytes(make([]byte, 32))...)
+	}
+	der := func(sig []byte) []byte {
+		bz, err := asn1.Marshal(struct{ R, S *big.Int }{
+			new(big.Int).SetBytes(sig[:32]),
+			new(big.Int).SetBytes(sig[32:]),
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name   string
+		sig    []byte
+		expRes bool
+	}{
+		{"valid assertion", webAuthnSign(t, privKey, 0x05, webAuthnTypeGet, digest[:]), true},
+		{"DER encoded signature", webAuthnSignWithEncoding(t, privKey, 0x05, webAuthnTypeGet, digest[:], der), true},
+		{"high S signature", webAuthnSignWithEncoding(t, privKey, 0x05, webAuthnTypeGet, digest[:], highS), true},
+		{"DER encoded high S signature", webAuthnSignWithEncoding(t, privKey, 0x05, webAuthnTypeGet, digest[:], func(sig []byte) []byte {
+			return der(highS(sig))
+		}), true},
+		{"DER encoded signature with trailing bytes", webAuthnSignWithEncoding(t, privKey, 0x05, webAuthnTypeGet, digest[:], func(sig []byte) []byte {
+			return append(der(sig), 0x00)
+		}), false},
+		{"zero S signature", webAuthnSignWithEncoding(t, privKey, 0x05, webAuthnTypeGet, digest[:], func(sig []byte) []byte {
+			return append(append([]byte{}, sig[:32]...), make([]byte, 32)...)
+		}), false},
+		{"user not present", webAuthnSign(t, privKey, 0x04, webAuthnTypeGet, digest[:]), false},
+		{"registration client data", webAuthnSign(t, privKey, 0x05, "webauthn.create", digest[:]), false},
+		{"different challenge", webAuthnSign(t, privKey, 0x05, webAuthnTypeGet, crypto.Keccak256(msg)), false},
+		{"invalid encoding", []byte{0xff, 0xff, 0xff}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRes, pubKey.VerifySignature(msg, tc.sig))
+		})
+	}
+}
+
+func TestPubKey_MatchesPrecompileVerify(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	key, err := privKey.ToECDSA()
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("hello world"))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	require.True(t, Verify(digest[:], r, s, key.X, key.Y))
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey().(*PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"secp256r1 private key",
+			privKey,
+			&PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"secp256r1 public key",
+			pubKey,
+			&PubKey{},
+			append([]byte{33}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.Unmarshal(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
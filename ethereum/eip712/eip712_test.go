@@ -2,7 +2,11 @@ package eip712_test
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -13,6 +17,7 @@ import (
 
 	chainconfig "github.com/cosmos/evm/cmd/evmd/config"
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/secp256r1"
 	"github.com/cosmos/evm/ethereum/eip712"
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/os/network"
@@ -408,6 +413,32 @@ func (suite *EIP712TestSuite) verifyEIP712SignatureVerification(expectedSuccess
 	randBytes[0] = (signBytes[0] + 10) % 255
 	res = pubKey.VerifySignature(randBytes, sig)
 	suite.Require().False(res)
+
+	// Passkeys sign the Keccak256 hash of the EIP-712 bytes
+	r1PrivKey, err := secp256r1.GenerateKey()
+	suite.Require().NoError(err)
+	r1Sig := suite.signSecp256r1Digest(r1PrivKey, crypto.Keccak256(eip712Bytes))
+
+	res = r1PrivKey.PubKey().VerifySignature(signBytes, r1Sig)
+	suite.Require().True(res)
+
+	res = r1PrivKey.PubKey().VerifySignature(randBytes, r1Sig)
+	suite.Require().False(res)
+}
+
+// signSecp256r1Digest signs the hash with the secp256r1 key, with S in the lower half of the curve order.
+func (suite *EIP712TestSuite) signSecp256r1Digest(privKey *secp256r1.PrivKey, digest []byte) []byte {
+	key, err := privKey.ToECDSA()
+	suite.Require().NoError(err)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	suite.Require().NoError(err)
+	n := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+
+	return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
 }
 
 // verifySignDocFlattening tests the flattening algorithm against the sign doc's JSON payload,
@@ -222,6 +222,10 @@ type EVMD struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// BeginBlockers order before and after the SystemLogsUpgradeName upgrade
+	beginBlockers           []string
+	systemLogsBeginBlockers []string
 }
 
 // NewExampleApp returns a reference to an initialized EVMD.
@@ -350,6 +354,11 @@ func NewExampleApp(
 		BlockedAddresses(),
 		authAddr,
 		logger,
+	).WithMintCoinsRestriction(
+		// NOTE: the ERC-20 keeper is passed as a pointer since it's instantiated after
+		// the bank keeper. The hook registers an ERC-20 precompile for newly minted
+		// native coins when the AutoRegisterDenoms parameter is enabled.
+		erc20keeper.NewMintCoinsHook(&app.Erc20Keeper),
 	)
 
 	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
@@ -595,6 +604,9 @@ func NewExampleApp(
 			app.GovKeeper,
 			app.SlashingKeeper,
 			app.EvidenceKeeper,
+			app.FeeGrantKeeper,
+			app.MsgServiceRouter(),
+			app.GRPCQueryRouter(),
 			app.AppCodec(),
 		),
 	)
@@ -684,6 +696,11 @@ func NewExampleApp(
 		vestingtypes.ModuleName,
 	)
 
+	// NOTE: the order of the BeginBlockers changes once the SystemLogsUpgradeName
+	// upgrade is applied, see BeginBlocker
+	app.beginBlockers = app.ModuleManager.OrderBeginBlockers
+	app.systemLogsBeginBlockers = systemLogsBeginBlockers(app.beginBlockers)
+
 	// NOTE: the feemarket module should go last in order of end blockers that are actually doing something,
 	// to get the full block gas used.
 	app.ModuleManager.SetOrderEndBlockers(
@@ -857,6 +874,9 @@ func (app *EVMD) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *EVMD) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	if err := app.setOrderBeginBlockers(ctx); err != nil {
+		return sdk.BeginBlock{}, err
+	}
 	return app.ModuleManager.BeginBlock(ctx)
 }
 
@@ -884,7 +904,16 @@ func (app *EVMD) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci
 		panic(err)
 	}
 
-	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
+	res, err := app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := app.applyGenesisUpgrades(ctx, req.InitialHeight); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 func (app *EVMD) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
@@ -10,20 +10,25 @@ import (
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
+	cosmosprecompile "github.com/cosmos/evm/precompiles/cosmos"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
 	evidenceprecompile "github.com/cosmos/evm/precompiles/evidence"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	stargateprecompile "github.com/cosmos/evm/precompiles/stargate"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -47,6 +52,9 @@ func NewAvailableStaticPrecompiles(
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	evidenceKeeper evidencekeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	msgRouter baseapp.MessageRouter,
+	queryRouter *baseapp.GRPCQueryRouter,
 	codec codec.Codec,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
@@ -79,6 +87,7 @@ func NewAvailableStaticPrecompiles(
 		transferKeeper,
 		channelKeeper,
 		evmKeeper,
+		erc20Keeper,
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
@@ -104,6 +113,21 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
 	}
 
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, evmKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	cosmosPrecompile, err := cosmosprecompile.NewPrecompile(msgRouter, evmKeeper, codec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate cosmos precompile: %w", err))
+	}
+
+	stargatePrecompile, err := stargateprecompile.NewPrecompile(queryRouter, evmKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate stargate precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -116,6 +140,9 @@ func NewAvailableStaticPrecompiles(
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[evidencePrecompile.Address()] = evidencePrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[cosmosPrecompile.Address()] = cosmosPrecompile
+	precompiles[stargatePrecompile.Address()] = stargatePrecompile
 
 	return precompiles
 }
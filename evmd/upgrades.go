@@ -1,5 +1,86 @@
 package evmd
 
+import (
+	"context"
+	"slices"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SystemLogsUpgradeName is the name of the upgrade that moves the distribution,
+// slashing, evidence and staking BeginBlockers before the Cosmos EVM ones, so
+// that the EVM BeginBlocker collects their events as system logs. Changing the
+// order of the BeginBlockers is consensus breaking, so it only applies from the
+// height of this upgrade, or from genesis for the chains started with it.
+const SystemLogsUpgradeName = "evm-system-logs"
+
 func (app EVMD) RegisterUpgradeHandlers() {
-	// No upgrades registered yet
+	app.UpgradeKeeper.SetUpgradeHandler(
+		SystemLogsUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
+
+// applyGenesisUpgrades marks the upgrades of this version as done on the chains
+// started from genesis with it, so that they run the upgraded logic from their
+// initial height.
+func (app *EVMD) applyGenesisUpgrades(ctx sdk.Context, initialHeight int64) error {
+	headerInfo := ctx.HeaderInfo()
+	headerInfo.Height = max(initialHeight, 1)
+	ctx = ctx.WithHeaderInfo(headerInfo)
+
+	return app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   SystemLogsUpgradeName,
+		Height: headerInfo.Height,
+	})
+}
+
+// setOrderBeginBlockers sets the order of the BeginBlockers of the module manager
+// depending on whether the SystemLogsUpgradeName upgrade is applied. The upgrade
+// runs in the PreBlocker, so the new order applies from the upgrade height.
+func (app *EVMD) setOrderBeginBlockers(ctx sdk.Context) error {
+	doneHeight, err := app.UpgradeKeeper.GetDoneHeight(ctx, SystemLogsUpgradeName)
+	if err != nil {
+		return err
+	}
+
+	if doneHeight > 0 {
+		app.ModuleManager.OrderBeginBlockers = app.systemLogsBeginBlockers
+	} else {
+		app.ModuleManager.OrderBeginBlockers = app.beginBlockers
+	}
+	return nil
+}
+
+// systemLogsBeginBlockers returns the given BeginBlockers order with the
+// distribution, slashing, evidence and staking BeginBlockers moved right before
+// the Cosmos EVM ones.
+func systemLogsBeginBlockers(order []string) []string {
+	moved := []string{
+		distrtypes.ModuleName, slashingtypes.ModuleName,
+		evidencetypes.ModuleName, stakingtypes.ModuleName,
+	}
+
+	res := make([]string, 0, len(order))
+	for _, name := range order {
+		if slices.Contains(moved, name) {
+			continue
+		}
+		if name == erc20types.ModuleName {
+			res = append(res, moved...)
+		}
+		res = append(res, name)
+	}
+	return res
 }
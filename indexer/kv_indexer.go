@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -15,6 +17,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,11 +26,16 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash          = 1
+	KeyPrefixTxIndex         = 2
+	KeyPrefixAddressTx       = 3
+	KeyPrefixSenderNonce     = 4
+	KeyPrefixContractCreator = 5
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
 )
 
 var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
@@ -49,6 +57,8 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Records the addresses the message touches (sender, recipient, created contract
+// and log emitters), the sender nonce and the created contract
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
 
@@ -115,6 +125,17 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+
+			var logs []*ethtypes.Log
+			if result.Code == abci.CodeTypeOK {
+				logs, err = rpctypes.TxLogsFromEvents(result.Events, msgIndex)
+				if err != nil {
+					kv.logger.Debug("failed to parse logs", "hash", txHash, "error", err.Error())
+				}
+			}
+			if err := saveTxAppearances(batch, ethMsg, txHash, &txResult, logs); err != nil {
+				kv.logger.Error("Fail to index tx addresses", "err", err, "block", height, "hash", txHash)
+			}
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -161,6 +182,94 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosm
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetTxHashesByAddress returns the hashes of the eth txs the address appears in,
+// walking the blocks below blockNumber from the newest one when reverse is set, or
+// the blocks above blockNumber from the oldest one otherwise. A zero blockNumber
+// doesn't bound the reverse walk. It stops once pageSize txs are collected and the
+// current block is complete, and reports whether more txs are left.
+func (kv *KVIndexer) GetTxHashesByAddress(address common.Address, blockNumber int64, reverse bool, pageSize int) ([]common.Hash, bool, error) {
+	start, end := AddressTxKey(address, blockNumber+1, 0), addressTxPrefixEnd(address)
+	if reverse {
+		start, end = addressTxPrefix(address), addressTxPrefixEnd(address)
+		if blockNumber > 0 {
+			end = AddressTxKey(address, blockNumber, 0)
+		}
+	}
+
+	var more bool
+	hashes := make([]common.Hash, 0, pageSize)
+	lastHeight := int64(-1)
+	err := kv.iterateAddressTxs(start, end, reverse, func(tx cosmosevmtypes.AddressTx) bool {
+		if len(hashes) >= pageSize && tx.Height != lastHeight {
+			more = true
+			return false
+		}
+		hashes = append(hashes, tx.TxHash)
+		lastHeight = tx.Height
+		return true
+	})
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", address.Hex())
+	}
+	return hashes, more, nil
+}
+
+// GetByAddress returns up to limit eth txs the address appears in, walking them
+// from the newest one when reverse is set, or from the oldest one otherwise. The
+// walk resumes right after the cursor if it's not nil.
+func (kv *KVIndexer) GetByAddress(address common.Address, cursor *cosmosevmtypes.AddressTx, reverse bool, limit int) ([]cosmosevmtypes.AddressTx, error) {
+	start, end := addressTxPrefix(address), addressTxPrefixEnd(address)
+	if cursor != nil {
+		key := AddressTxKey(address, cursor.Height, cursor.EthTxIndex)
+		if reverse {
+			end = key
+		} else {
+			// the smallest key after the cursor one
+			start = append(key, 0)
+		}
+	}
+
+	txs := make([]cosmosevmtypes.AddressTx, 0, limit)
+	err := kv.iterateAddressTxs(start, end, reverse, func(tx cosmosevmtypes.AddressTx) bool {
+		txs = append(txs, tx)
+		return len(txs) < limit
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	return txs, nil
+}
+
+// GetTxHashBySenderAndNonce returns the hash of the eth tx sent by the sender with
+// the nonce, returns the empty hash if tx not found
+func (kv *KVIndexer) GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (common.Hash, error) {
+	bz, err := kv.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrapf(err, "GetTxHashBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// GetContractCreation returns the eth tx deploying the contract, returns nil if
+// the contract was not created by a top-level eth tx, as the contracts created by
+// other contracts can only be found by tracing the txs
+func (kv *KVIndexer) GetContractCreation(contract common.Address) (*cosmosevmtypes.ContractCreation, error) {
+	bz, err := kv.db.Get(ContractCreatorKey(contract))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreation %s", contract.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != common.HashLength+common.AddressLength {
+		return nil, fmt.Errorf("wrong contract creation length, expect: %d, got: %d", common.HashLength+common.AddressLength, len(bz))
+	}
+	return &cosmosevmtypes.ContractCreation{
+		TxHash:  common.BytesToHash(bz[:common.HashLength]),
+		Creator: common.BytesToAddress(bz[common.HashLength:]),
+	}, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +282,31 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), bz1...), bz2...)
+}
+
+func addressTxPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+}
+
+func addressTxPrefixEnd(address common.Address) []byte {
+	return storetypes.PrefixEndBytes(addressTxPrefix(address))
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	return append(append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract -> (tx hash, creator)`
+func ContractCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, contract.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -224,6 +358,93 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveTxAppearances indexes the addresses the eth tx touches, its sender nonce and
+// the contract it deploys into the kv db batch
+func saveTxAppearances(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *cosmosevmtypes.TxResult, logs []*ethtypes.Log) error {
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return fmt.Errorf("invalid tx data, hash: %s", txHash.Hex())
+	}
+
+	sender, err := txSender(msg, tx)
+	if err != nil {
+		return errorsmod.Wrap(err, "recover sender")
+	}
+
+	addresses := []common.Address{sender}
+	if to := tx.To(); to != nil {
+		addresses = append(addresses, *to)
+	} else if !txResult.Failed {
+		contract := crypto.CreateAddress(sender, tx.Nonce())
+		addresses = append(addresses, contract)
+		if err := batch.Set(ContractCreatorKey(contract), append(txHash.Bytes(), sender.Bytes()...)); err != nil {
+			return errorsmod.Wrap(err, "set contract-creator key")
+		}
+	}
+	for _, txLog := range logs {
+		addresses = append(addresses, txLog.Address)
+	}
+
+	for _, address := range addresses {
+		if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	if err := batch.Set(SenderNonceKey(sender, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender-nonce key")
+	}
+	return nil
+}
+
+// iterateAddressTxs visits the address-tx entries in the [start, end) key range,
+// until the callback returns false
+func (kv *KVIndexer) iterateAddressTxs(start, end []byte, reverse bool, cb func(cosmosevmtypes.AddressTx) bool) error {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != AddressTxKeyLength {
+			return fmt.Errorf("wrong address tx key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+		}
+
+		tx := cosmosevmtypes.AddressTx{
+			Height:     int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])), //#nosec G115 -- block number won't exceed int64
+			EthTxIndex: int32(sdk.BigEndianToUint64(key[1+common.AddressLength+8:])),                         //#nosec G115 -- index won't exceed int32
+			TxHash:     common.BytesToHash(it.Value()),
+		}
+		if !cb(tx) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// txSender returns the sender of the eth tx, recovering it from the signature
+// when the msg doesn't carry it
+func txSender(msg *evmtypes.MsgEthereumTx, tx *ethtypes.Transaction) (common.Address, error) {
+	if msg.From != "" {
+		return common.HexToAddress(msg.From), nil
+	}
+
+	var signer ethtypes.Signer = ethtypes.HomesteadSigner{}
+	if tx.Protected() {
+		signer = ethtypes.LatestSignerForChainID(tx.ChainId())
+	}
+	return ethtypes.Sender(signer, tx)
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
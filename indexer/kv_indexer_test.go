@@ -1,11 +1,14 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
+	"strconv"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,6 +20,7 @@ import (
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/os/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -190,3 +194,148 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerAddressTxs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := common.BigToAddress(big.NewInt(1))
+	emitter := common.BigToAddress(big.NewInt(2))
+
+	// buildTx signs an eth tx and returns its hash, the encoded cosmos tx and its result
+	buildTx := func(nonce uint64, to *common.Address, txIndex int, logs ...*types.Log) (common.Hash, cmttypes.Tx, *abci.ExecTxResult) {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 100000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		logAttrs := make([]abci.EventAttribute, 0, len(logs))
+		for _, txLog := range logs {
+			bz, err := json.Marshal(txLog)
+			require.NoError(t, err)
+			logAttrs = append(logAttrs, abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)})
+		}
+
+		return txHash, txBz, &abci.ExecTxResult{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: strconv.Itoa(txIndex)},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: logAttrs},
+			},
+		}
+	}
+
+	hash1, tx1, res1 := buildTx(0, &to, 0)
+	hash2, tx2, res2 := buildTx(1, nil, 0, &types.Log{Address: emitter.Hex(), Topics: []string{common.Hash{}.Hex()}})
+	hash3, tx3, res3 := buildTx(2, &to, 0)
+	hash4, tx4, res4 := buildTx(3, &to, 1)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	blocks := []struct {
+		txs     []cmttypes.Tx
+		results []*abci.ExecTxResult
+	}{
+		{[]cmttypes.Tx{tx1}, []*abci.ExecTxResult{res1}},
+		{[]cmttypes.Tx{tx2}, []*abci.ExecTxResult{res2}},
+		{[]cmttypes.Tx{tx3, tx4}, []*abci.ExecTxResult{res3, res4}},
+	}
+	for i, b := range blocks {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: int64(i + 1)}, Data: cmttypes.Data{Txs: b.txs}}
+		require.NoError(t, idxer.IndexBlock(block, b.results))
+	}
+
+	contract := crypto.CreateAddress(from, 1)
+
+	testCases := []struct {
+		name        string
+		address     common.Address
+		blockNumber int64
+		reverse     bool
+		pageSize    int
+		expHashes   []common.Hash
+		expMore     bool
+	}{
+		{"latest page, block not split", from, 0, true, 1, []common.Hash{hash4, hash3}, true},
+		{"latest page", from, 0, true, 3, []common.Hash{hash4, hash3, hash2}, true},
+		{"before block", from, 3, true, 10, []common.Hash{hash2, hash1}, false},
+		{"first page", from, 0, false, 1, []common.Hash{hash1}, true},
+		{"after block", from, 1, false, 10, []common.Hash{hash2, hash3, hash4}, false},
+		{"recipient", to, 0, true, 10, []common.Hash{hash4, hash3, hash1}, false},
+		{"created contract", contract, 0, true, 10, []common.Hash{hash2}, false},
+		{"log emitter", emitter, 0, false, 10, []common.Hash{hash2}, false},
+		{"unknown address", common.BigToAddress(big.NewInt(3)), 0, true, 10, []common.Hash{}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, more, err := idxer.GetTxHashesByAddress(tc.address, tc.blockNumber, tc.reverse, tc.pageSize)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+			require.Equal(t, tc.expMore, more)
+		})
+	}
+
+	cursorCases := []struct {
+		name      string
+		address   common.Address
+		cursor    *cosmosevmtypes.AddressTx
+		reverse   bool
+		limit     int
+		expHashes []common.Hash
+	}{
+		{"newest", from, nil, true, 3, []common.Hash{hash4, hash3, hash2}},
+		{"before cursor", from, &cosmosevmtypes.AddressTx{Height: 3, EthTxIndex: 1}, true, 2, []common.Hash{hash3, hash2}},
+		{"oldest", from, nil, false, 1, []common.Hash{hash1}},
+		{"after cursor", from, &cosmosevmtypes.AddressTx{Height: 3, EthTxIndex: 0}, false, 10, []common.Hash{hash4}},
+		{"after last", to, &cosmosevmtypes.AddressTx{Height: 3, EthTxIndex: 1}, false, 10, []common.Hash{}},
+	}
+	for _, tc := range cursorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txs, err := idxer.GetByAddress(tc.address, tc.cursor, tc.reverse, tc.limit)
+			require.NoError(t, err)
+			hashes := make([]common.Hash, 0, len(txs))
+			for _, tx := range txs {
+				hashes = append(hashes, tx.TxHash)
+			}
+			require.Equal(t, tc.expHashes, hashes)
+		})
+	}
+
+	hash, err := idxer.GetTxHashBySenderAndNonce(from, 2)
+	require.NoError(t, err)
+	require.Equal(t, hash3, hash)
+	hash, err = idxer.GetTxHashBySenderAndNonce(from, 4)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, hash)
+
+	creation, err := idxer.GetContractCreation(contract)
+	require.NoError(t, err)
+	require.Equal(t, hash2, creation.TxHash)
+	require.Equal(t, from, creation.Creator)
+	creation, err = idxer.GetContractCreation(to)
+	require.NoError(t, err)
+	require.Nil(t, creation)
+}
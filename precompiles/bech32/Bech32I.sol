@@ -10,7 +10,8 @@ Bech32I constant BECH32_CONTRACT = Bech32I(Bech32_PRECOMPILE_ADDRESS);
 /// @author Evmos Team
 /// @title Bech32 Precompiled Contract
 /// @dev The interface through which solidity contracts can convert addresses from
-/// hex to bech32 and vice versa.
+/// hex to bech32 and vice versa. The account, validator and consensus methods use the
+/// bech32 prefixes configured by the chain.
 /// @custom:address 0x0000000000000000000000000000000000000400
 interface Bech32I {
     /// @dev Defines a method for converting a hex formatted address to bech32.
@@ -28,4 +29,76 @@ interface Bech32I {
     function bech32ToHex(
         string memory bech32Address
     ) external returns (address addr);
+
+    /// @dev Defines a method for converting a hex formatted address to a bech32
+    /// account address using the chain account prefix.
+    /// @param addr The hex address to be converted.
+    /// @return bech32Address The account address in bech32 format.
+    function hexToAccount(
+        address addr
+    ) external returns (string memory bech32Address);
+
+    /// @dev Defines a method for converting a hex formatted address to a bech32
+    /// validator operator address using the chain validator prefix.
+    /// @param addr The hex address to be converted.
+    /// @return bech32Address The validator operator address in bech32 format.
+    function hexToValoper(
+        address addr
+    ) external returns (string memory bech32Address);
+
+    /// @dev Defines a method for converting a hex formatted address to a bech32
+    /// consensus address using the chain consensus prefix.
+    /// @param addr The hex address to be converted.
+    /// @return bech32Address The consensus address in bech32 format.
+    function hexToValcons(
+        address addr
+    ) external returns (string memory bech32Address);
+
+    /// @dev Defines a method for converting a bech32 account address with the
+    /// chain account prefix to hex.
+    /// @param bech32Address The bech32 account address to be converted.
+    /// @return addr The address in hex format.
+    function accountToHex(
+        string memory bech32Address
+    ) external returns (address addr);
+
+    /// @dev Defines a method for converting a bech32 validator operator address
+    /// with the chain validator prefix to hex.
+    /// @param bech32Address The bech32 validator operator address to be converted.
+    /// @return addr The address in hex format.
+    function valoperToHex(
+        string memory bech32Address
+    ) external returns (address addr);
+
+    /// @dev Defines a method for converting a bech32 consensus address with the
+    /// chain consensus prefix to hex.
+    /// @param bech32Address The bech32 consensus address to be converted.
+    /// @return addr The address in hex format.
+    function valconsToHex(
+        string memory bech32Address
+    ) external returns (address addr);
+
+    /// @dev Defines a method for converting a list of hex formatted addresses to bech32.
+    /// @param addrs The hex addresses to be converted.
+    /// @param prefix The human readable prefix (HRP) of the bech32 addresses.
+    /// @return bech32Addresses The addresses in bech32 format.
+    function hexToBech32Batch(
+        address[] memory addrs,
+        string memory prefix
+    ) external returns (string[] memory bech32Addresses);
+
+    /// @dev Defines a method for converting a list of bech32 formatted addresses to hex.
+    /// @param bech32Addresses The bech32 addresses to be converted.
+    /// @return addrs The addresses in hex format.
+    function bech32ToHexBatch(
+        string[] memory bech32Addresses
+    ) external returns (address[] memory addrs);
+
+    /// @dev Defines a method for checking if a string is a valid bech32 address
+    /// with any human readable prefix (HRP).
+    /// @param bech32Address The string to be checked.
+    /// @return valid True if the string is a valid bech32 address.
+    function isValid(
+        string memory bech32Address
+    ) external returns (bool valid);
 }
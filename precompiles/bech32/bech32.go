@@ -14,6 +14,9 @@ import (
 
 var _ vm.PrecompiledContract = &Precompile{}
 
+// GasPerByte defines the gas charged for each byte of the method arguments.
+const GasPerByte = 3
+
 // Embed abi json file to the executable binary. Needed when importing as dependency.
 //
 //go:embed abi.json
@@ -48,9 +51,14 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(evmtypes.Bech32PrecompileAddress)
 }
 
-// RequiredGas calculates the contract gas use.
-func (p Precompile) RequiredGas(_ []byte) uint64 {
-	return p.baseGas
+// RequiredGas calculates the contract gas use. On top of the base gas, each byte
+// of the ABI encoded arguments is charged so that batch conversions scale with
+// the number of addresses.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return p.baseGas
+	}
+	return p.baseGas + GasPerByte*uint64(len(input[4:]))
 }
 
 // Run executes the precompiled contract bech32 methods defined in the ABI.
@@ -79,6 +87,26 @@ func (p Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, er
 		bz, err = p.HexToBech32(method, args)
 	case Bech32ToHexMethod:
 		bz, err = p.Bech32ToHex(method, args)
+	case HexToAccountMethod:
+		bz, err = p.HexToAccount(method, args)
+	case HexToValoperMethod:
+		bz, err = p.HexToValoper(method, args)
+	case HexToValconsMethod:
+		bz, err = p.HexToValcons(method, args)
+	case AccountToHexMethod:
+		bz, err = p.AccountToHex(method, args)
+	case ValoperToHexMethod:
+		bz, err = p.ValoperToHex(method, args)
+	case ValconsToHexMethod:
+		bz, err = p.ValconsToHex(method, args)
+	case HexToBech32BatchMethod:
+		bz, err = p.HexToBech32Batch(method, args)
+	case Bech32ToHexBatchMethod:
+		bz, err = p.Bech32ToHexBatch(method, args)
+	case IsValidMethod:
+		bz, err = p.IsValid(method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
 	if err != nil {
@@ -41,6 +41,10 @@ func (s *PrecompileTestSuite) TestNewPrecompile() {
 				s.Require().NoError(err)
 				s.Require().NotNil(p)
 				s.Require().Equal(tc.baseGas, p.RequiredGas([]byte{}))
+
+				input, err := p.Pack(bech32.Bech32ToHexBatchMethod, []string{"", ""})
+				s.Require().NoError(err)
+				s.Require().Equal(tc.baseGas+bech32.GasPerByte*uint64(len(input)-4), p.RequiredGas(input))
 			} else {
 				s.Require().Error(err)
 				s.Require().Nil(p)
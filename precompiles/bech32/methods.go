@@ -19,6 +19,33 @@ const (
 	// Bech32ToHexMethod defines the ABI method name to convert a bech32
 	// formatted address string to an EIP-55 address.
 	Bech32ToHexMethod = "bech32ToHex"
+	// HexToAccountMethod defines the ABI method name to convert a hex address
+	// to a bech32 account address with the chain account prefix.
+	HexToAccountMethod = "hexToAccount"
+	// HexToValoperMethod defines the ABI method name to convert a hex address
+	// to a bech32 validator operator address with the chain validator prefix.
+	HexToValoperMethod = "hexToValoper"
+	// HexToValconsMethod defines the ABI method name to convert a hex address
+	// to a bech32 consensus address with the chain consensus prefix.
+	HexToValconsMethod = "hexToValcons"
+	// AccountToHexMethod defines the ABI method name to convert a bech32
+	// account address with the chain account prefix to a hex address.
+	AccountToHexMethod = "accountToHex"
+	// ValoperToHexMethod defines the ABI method name to convert a bech32
+	// validator operator address with the chain validator prefix to a hex address.
+	ValoperToHexMethod = "valoperToHex"
+	// ValconsToHexMethod defines the ABI method name to convert a bech32
+	// consensus address with the chain consensus prefix to a hex address.
+	ValconsToHexMethod = "valconsToHex"
+	// HexToBech32BatchMethod defines the ABI method name to convert a list
+	// of hex addresses to bech32 address strings with the same prefix.
+	HexToBech32BatchMethod = "hexToBech32Batch"
+	// Bech32ToHexBatchMethod defines the ABI method name to convert a list
+	// of bech32 address strings to hex addresses.
+	Bech32ToHexBatchMethod = "bech32ToHexBatch"
+	// IsValidMethod defines the ABI method name to check whether a string is
+	// a valid bech32 address.
+	IsValidMethod = "isValid"
 )
 
 // HexToBech32 converts a hex address to its corresponding Bech32 format. The Human Readable Prefix
@@ -37,22 +64,12 @@ func (p Precompile) HexToBech32(
 		return nil, fmt.Errorf("invalid hex address")
 	}
 
-	cfg := sdk.GetConfig()
-
 	prefix, _ := args[1].(string)
 	if strings.TrimSpace(prefix) == "" {
-		return nil, fmt.Errorf(
-			"invalid bech32 human readable prefix (HRP). Please provide a either an account, validator or consensus address prefix (eg: %s, %s, %s)",
-			cfg.GetBech32AccountAddrPrefix(), cfg.GetBech32ValidatorAddrPrefix(), cfg.GetBech32ConsensusAddrPrefix(),
-		)
+		return nil, invalidPrefixError()
 	}
 
-	// NOTE: safety check, should not happen given that the address is 20 bytes.
-	if err := sdk.VerifyAddressFormat(address.Bytes()); err != nil {
-		return nil, err
-	}
-
-	bech32Str, err := sdk.Bech32ifyAddressBytes(prefix, address.Bytes())
+	bech32Str, err := hexToBech32(address, prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -76,19 +93,231 @@ func (p Precompile) Bech32ToHex(
 		return nil, fmt.Errorf("invalid bech32 address: %v", args[0])
 	}
 
-	bech32Prefix := strings.SplitN(address, "1", 2)[0]
-	if bech32Prefix == address {
-		return nil, fmt.Errorf("invalid bech32 address: %s", address)
+	addr, err := bech32ToHex(address, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(addr)
+}
+
+// HexToAccount converts a hex address to a bech32 account address using the
+// account prefix of the chain configuration.
+func (p Precompile) HexToAccount(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.hexToConfigPrefix(method, args, sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// HexToValoper converts a hex address to a bech32 validator operator address
+// using the validator prefix of the chain configuration.
+func (p Precompile) HexToValoper(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.hexToConfigPrefix(method, args, sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+// HexToValcons converts a hex address to a bech32 consensus address using the
+// consensus prefix of the chain configuration.
+func (p Precompile) HexToValcons(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.hexToConfigPrefix(method, args, sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+}
+
+// AccountToHex converts a bech32 account address to its hex format. It fails if
+// the address prefix is not the account prefix of the chain configuration.
+func (p Precompile) AccountToHex(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.configPrefixToHex(method, args, sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// ValoperToHex converts a bech32 validator operator address to its hex format. It
+// fails if the address prefix is not the validator prefix of the chain configuration.
+func (p Precompile) ValoperToHex(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.configPrefixToHex(method, args, sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+// ValconsToHex converts a bech32 consensus address to its hex format. It fails if
+// the address prefix is not the consensus prefix of the chain configuration.
+func (p Precompile) ValconsToHex(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.configPrefixToHex(method, args, sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+}
+
+// HexToBech32Batch converts a list of hex addresses to their bech32 format using
+// the given Human Readable Prefix (HRP). It fails if any of the conversions fails.
+func (p Precompile) HexToBech32Batch(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	addresses, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex addresses")
+	}
+
+	prefix, _ := args[1].(string)
+	if strings.TrimSpace(prefix) == "" {
+		return nil, invalidPrefixError()
+	}
+
+	bech32Addrs := make([]string, len(addresses))
+	for i, address := range addresses {
+		bech32Str, err := hexToBech32(address, prefix)
+		if err != nil {
+			return nil, err
+		}
+		bech32Addrs[i] = bech32Str
+	}
+
+	return method.Outputs.Pack(bech32Addrs)
+}
+
+// Bech32ToHexBatch converts a list of bech32 addresses to their hex format. The
+// addresses can have different prefixes. It fails if any of the conversions fails.
+func (p Precompile) Bech32ToHexBatch(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	bech32Addrs, ok := args[0].([]string)
+	if !ok {
+		return nil, fmt.Errorf("invalid bech32 addresses: %v", args[0])
 	}
 
-	addressBz, err := sdk.GetFromBech32(address, bech32Prefix)
+	addresses := make([]common.Address, len(bech32Addrs))
+	for i, bech32Addr := range bech32Addrs {
+		addr, err := bech32ToHex(bech32Addr, "")
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = addr
+	}
+
+	return method.Outputs.Pack(addresses)
+}
+
+// IsValid returns true if the given string is a valid bech32 address with any
+// Human Readable Prefix (HRP).
+func (p Precompile) IsValid(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid bech32 address: %v", args[0])
+	}
+
+	_, err := bech32ToHex(address, "")
+	return method.Outputs.Pack(err == nil)
+}
+
+// hexToConfigPrefix converts the hex address argument to bech32 with the given prefix.
+func (p Precompile) hexToConfigPrefix(
+	method *abi.Method,
+	args []interface{},
+	prefix string,
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex address")
+	}
+
+	bech32Str, err := hexToBech32(address, prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := sdk.VerifyAddressFormat(addressBz); err != nil {
+	return method.Outputs.Pack(bech32Str)
+}
+
+// configPrefixToHex converts the bech32 address argument with the given prefix to hex.
+func (p Precompile) configPrefixToHex(
+	method *abi.Method,
+	args []interface{},
+	prefix string,
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(string)
+	if !ok || address == "" {
+		return nil, fmt.Errorf("invalid bech32 address: %v", args[0])
+	}
+
+	addr, err := bech32ToHex(address, prefix)
+	if err != nil {
 		return nil, err
 	}
 
-	return method.Outputs.Pack(common.BytesToAddress(addressBz))
+	return method.Outputs.Pack(addr)
+}
+
+// hexToBech32 encodes the address bytes to bech32 with the given prefix.
+func hexToBech32(address common.Address, prefix string) (string, error) {
+	// NOTE: safety check, should not happen given that the address is 20 bytes.
+	if err := sdk.VerifyAddressFormat(address.Bytes()); err != nil {
+		return "", err
+	}
+
+	return sdk.Bech32ifyAddressBytes(prefix, address.Bytes())
+}
+
+// bech32ToHex decodes the bech32 address to its hex format. If the prefix is
+// empty, the prefix of the address is used.
+func bech32ToHex(address, prefix string) (common.Address, error) {
+	bech32Prefix := strings.SplitN(address, "1", 2)[0]
+	if address == "" || bech32Prefix == address {
+		return common.Address{}, fmt.Errorf("invalid bech32 address: %s", address)
+	}
+
+	if prefix == "" {
+		prefix = bech32Prefix
+	}
+
+	addressBz, err := sdk.GetFromBech32(address, prefix)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if err := sdk.VerifyAddressFormat(addressBz); err != nil {
+		return common.Address{}, err
+	}
+
+	return common.BytesToAddress(addressBz), nil
+}
+
+// invalidPrefixError returns the error raised when the bech32 prefix is empty.
+func invalidPrefixError() error {
+	cfg := sdk.GetConfig()
+	return fmt.Errorf(
+		"invalid bech32 human readable prefix (HRP). Please provide a either an account, validator or consensus address prefix (eg: %s, %s, %s)",
+		cfg.GetBech32AccountAddrPrefix(), cfg.GetBech32ValidatorAddrPrefix(), cfg.GetBech32ConsensusAddrPrefix(),
+	)
 }
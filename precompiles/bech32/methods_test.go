@@ -202,3 +202,213 @@ func (s *PrecompileTestSuite) TestBech32ToHex() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestConfigPrefixConversions() {
+	// setup basic test suite
+	s.SetupTest()
+
+	addr := s.keyring.GetAddr(0)
+	accAddr := sdk.AccAddress(addr.Bytes()).String()
+	valAddr := sdk.ValAddress(addr.Bytes()).String()
+	consAddr := sdk.ConsAddress(addr.Bytes()).String()
+
+	testCases := []struct {
+		name        string
+		methodName  string
+		args        []interface{}
+		expOutput   interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - hexToAccount invalid args length",
+			bech32.HexToAccountMethod,
+			[]interface{}{},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - hexToValoper invalid hex address",
+			bech32.HexToValoperMethod,
+			[]interface{}{""},
+			nil,
+			true,
+			"invalid hex address",
+		},
+		{
+			"success - hexToAccount",
+			bech32.HexToAccountMethod,
+			[]interface{}{addr},
+			accAddr,
+			false,
+			"",
+		},
+		{
+			"success - hexToValoper",
+			bech32.HexToValoperMethod,
+			[]interface{}{addr},
+			valAddr,
+			false,
+			"",
+		},
+		{
+			"success - hexToValcons",
+			bech32.HexToValconsMethod,
+			[]interface{}{addr},
+			consAddr,
+			false,
+			"",
+		},
+		{
+			"fail - accountToHex empty bech32 address",
+			bech32.AccountToHexMethod,
+			[]interface{}{""},
+			nil,
+			true,
+			"invalid bech32 address",
+		},
+		{
+			"fail - valoperToHex with account prefix",
+			bech32.ValoperToHexMethod,
+			[]interface{}{accAddr},
+			nil,
+			true,
+			"invalid Bech32 prefix",
+		},
+		{
+			"fail - valconsToHex with validator prefix",
+			bech32.ValconsToHexMethod,
+			[]interface{}{valAddr},
+			nil,
+			true,
+			"invalid Bech32 prefix",
+		},
+		{
+			"success - accountToHex",
+			bech32.AccountToHexMethod,
+			[]interface{}{accAddr},
+			addr,
+			false,
+			"",
+		},
+		{
+			"success - valoperToHex",
+			bech32.ValoperToHexMethod,
+			[]interface{}{valAddr},
+			addr,
+			false,
+			"",
+		},
+		{
+			"success - valconsToHex",
+			bech32.ValconsToHexMethod,
+			[]interface{}{consAddr},
+			addr,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.methodName]
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.methodName {
+			case bech32.HexToAccountMethod:
+				bz, err = s.precompile.HexToAccount(&method, tc.args)
+			case bech32.HexToValoperMethod:
+				bz, err = s.precompile.HexToValoper(&method, tc.args)
+			case bech32.HexToValconsMethod:
+				bz, err = s.precompile.HexToValcons(&method, tc.args)
+			case bech32.AccountToHexMethod:
+				bz, err = s.precompile.AccountToHex(&method, tc.args)
+			case bech32.ValoperToHexMethod:
+				bz, err = s.precompile.ValoperToHex(&method, tc.args)
+			case bech32.ValconsToHexMethod:
+				bz, err = s.precompile.ValconsToHex(&method, tc.args)
+			}
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				out, err := s.precompile.Unpack(tc.methodName, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out, 1)
+				s.Require().Equal(tc.expOutput, out[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestHexToBech32Batch() {
+	// setup basic test suite
+	s.SetupTest()
+
+	method := s.precompile.Methods[bech32.HexToBech32BatchMethod]
+	addrs := []common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+
+	_, err := s.precompile.HexToBech32Batch(&method, []interface{}{addrs, ""})
+	s.Require().ErrorContains(err, "invalid bech32 human readable prefix (HRP)")
+
+	bz, err := s.precompile.HexToBech32Batch(&method, []interface{}{addrs, chainconfig.Bech32Prefix})
+	s.Require().NoError(err)
+	out, err := s.precompile.Unpack(bech32.HexToBech32BatchMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		[]string{s.keyring.GetAccAddr(0).String(), s.keyring.GetAccAddr(1).String()},
+		out[0],
+	)
+}
+
+func (s *PrecompileTestSuite) TestBech32ToHexBatch() {
+	// setup basic test suite
+	s.SetupTest()
+
+	method := s.precompile.Methods[bech32.Bech32ToHexBatchMethod]
+	valAddr := sdk.ValAddress(s.keyring.GetAddr(1).Bytes()).String()
+
+	_, err := s.precompile.Bech32ToHexBatch(&method, []interface{}{[]string{s.keyring.GetAccAddr(0).String(), ""}})
+	s.Require().ErrorContains(err, "invalid bech32 address")
+
+	bz, err := s.precompile.Bech32ToHexBatch(&method, []interface{}{[]string{s.keyring.GetAccAddr(0).String(), valAddr}})
+	s.Require().NoError(err)
+	out, err := s.precompile.Unpack(bech32.Bech32ToHexBatchMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}, out[0])
+}
+
+func (s *PrecompileTestSuite) TestIsValid() {
+	// setup basic test suite
+	s.SetupTest()
+
+	method := s.precompile.Methods[bech32.IsValidMethod]
+
+	testCases := []struct {
+		name     string
+		address  string
+		expValid bool
+	}{
+		{"invalid - empty string", "", false},
+		{"invalid - missing separator", chainconfig.Bech32Prefix, false},
+		{"invalid - bad checksum", s.keyring.GetAccAddr(0).String() + "q", false},
+		{"valid - account address", s.keyring.GetAccAddr(0).String(), true},
+		{"valid - foreign prefix", sdk.MustBech32ifyAddressBytes("osmo", s.keyring.GetAddr(0).Bytes()), true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := s.precompile.IsValid(&method, []interface{}{tc.address})
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(bech32.IsValidMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expValid, out[0])
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package cosmos
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract that executes Cosmos SDK messages.
+type Precompile struct {
+	cmn.Precompile
+	msgRouter baseapp.MessageRouter
+	evmKeeper *evmkeeper.Keeper
+	codec     codec.Codec
+}
+
+// LoadABI loads the cosmos ABI from the embedded abi.json file
+// for the cosmos precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new cosmos Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	msgRouter baseapp.MessageRouter,
+	evmKeeper *evmkeeper.Keeper,
+	codec codec.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		msgRouter: msgRouter,
+		evmKeeper: evmKeeper,
+		codec:     codec,
+	}
+
+	// SetAddress defines the address of the cosmos precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.CosmosPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract cosmos methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err, stateDB, snapshot)()
+
+	return p.RunAtomic(snapshot, stateDB, func() ([]byte, error) {
+		switch method.Name {
+		// cosmos transactions
+		case DispatchMethod:
+			bz, err = p.Dispatch(ctx, method, stateDB, contract, args)
+		// cosmos queries
+		case IsAllowedMethod:
+			bz, err = p.IsAllowed(ctx, method, contract, args)
+		case AllowedMessagesMethod:
+			bz, err = p.AllowedMessages(ctx, method, contract, args)
+		default:
+			return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		cost := ctx.GasMeter().GasConsumed() - initialGas
+
+		if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+			return nil, vm.ErrOutOfGas
+		}
+
+		if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+			return nil, err
+		}
+
+		return bz, nil
+	})
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	return method.Name == DispatchMethod
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "cosmos")
+}
@@ -0,0 +1,14 @@
+package cosmos
+
+const (
+	// ErrMessageNotAllowed is raised when the message type URL is not in the allowlist.
+	ErrMessageNotAllowed = "cosmos message %s is not allowed"
+	// ErrInvalidMessage is raised when the message cannot be decoded.
+	ErrInvalidMessage = "invalid cosmos message %s: %s"
+	// ErrUnroutableMessage is raised when no handler is registered for the message.
+	ErrUnroutableMessage = "no message handler found for %s"
+	// ErrUnsupportedSigner is raised when the signer of the message cannot be set.
+	ErrUnsupportedSigner = "cosmos message %s has an unsupported signer field: %s"
+	// ErrInvalidSigners is raised when the message signers are not the caller.
+	ErrInvalidSigners = "cosmos message signers must be %s, got %v"
+)
@@ -0,0 +1,49 @@
+package cosmos
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeMessageDispatched defines the event type for the cosmos Dispatch transaction.
+	EventTypeMessageDispatched = "MessageDispatched"
+)
+
+// EmitMessageDispatchedEvent creates a new event emitted on a Dispatch transaction.
+func (p Precompile) EmitMessageDispatchedEvent(ctx sdk.Context, stateDB vm.StateDB, signer common.Address, typeURL string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeMessageDispatched]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(signer)
+	if err != nil {
+		return err
+	}
+
+	// Pack the type URL
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(typeURL)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
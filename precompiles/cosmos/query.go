@@ -0,0 +1,46 @@
+package cosmos
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// IsAllowedMethod defines the ABI method name for the query checking
+	// whether a message type can be dispatched.
+	IsAllowedMethod = "isAllowed"
+	// AllowedMessagesMethod defines the ABI method name for the query of all
+	// the message types that can be dispatched.
+	AllowedMessagesMethod = "allowedMessages"
+)
+
+// IsAllowed returns whether the given message type URL can be dispatched.
+func (p *Precompile) IsAllowed(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	typeURL, err := ParseIsAllowedArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.evmKeeper.GetParams(ctx).IsAllowedCosmosMessage(typeURL))
+}
+
+// AllowedMessages returns all the message type URLs that can be dispatched.
+func (p *Precompile) AllowedMessages(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	allowed := p.evmKeeper.GetParams(ctx).AllowedCosmosMessages
+	if allowed == nil {
+		allowed = []string{}
+	}
+	return method.Outputs.Pack(allowed)
+}
@@ -0,0 +1,93 @@
+package cosmos_test
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/cosmos"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestIsAllowed() {
+	method := s.precompile.Methods[cosmos.IsAllowedMethod]
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAllowed  bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - message not allowed",
+			func() []interface{} {
+				return []interface{}{sendTypeURL}
+			},
+			false,
+			false,
+			"",
+		},
+		{
+			"success - message allowed",
+			func() []interface{} {
+				s.allowMessages(sendTypeURL)
+				return []interface{}{sendTypeURL}
+			},
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.IsAllowed(s.network.GetContext(), &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAllowed, out[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowedMessages() {
+	method := s.precompile.Methods[cosmos.AllowedMessagesMethod]
+	typeURLs := []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	}
+
+	bz, err := s.precompile.AllowedMessages(s.network.GetContext(), &method, nil, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Empty(out[0])
+
+	s.allowMessages(typeURLs...)
+
+	bz, err = s.precompile.AllowedMessages(s.network.GetContext(), &method, nil, nil)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(typeURLs, out[0])
+}
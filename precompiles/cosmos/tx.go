@@ -0,0 +1,74 @@
+package cosmos
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DispatchMethod defines the ABI method name for the cosmos Dispatch transaction.
+	DispatchMethod = "dispatch"
+)
+
+// Dispatch executes an allowlisted Cosmos SDK message on behalf of the caller.
+// The signer fields of the message are overwritten with the caller address, so
+// a contract can only act on its own behalf.
+func (p *Precompile) Dispatch(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	typeURL, value, err := ParseDispatchArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.evmKeeper.GetParams(ctx).IsAllowedCosmosMessage(typeURL) {
+		return nil, fmt.Errorf(ErrMessageNotAllowed, typeURL)
+	}
+
+	msg, err := p.NewMsg(contract.Caller(), typeURL, value)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := p.msgRouter.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf(ErrUnroutableMessage, typeURL)
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"type_url", typeURL,
+		"signer", contract.Caller().String(),
+	)
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	entries, err := NewBalanceChangeEntries(res.GetEvents())
+	if err != nil {
+		return nil, err
+	}
+	p.SetBalanceChangeEntries(entries...)
+
+	if err = p.EmitMessageDispatchedEvent(ctx, stateDB, contract.Caller(), typeURL); err != nil {
+		return nil, err
+	}
+
+	var response []byte
+	if len(res.MsgResponses) > 0 {
+		response = res.MsgResponses[0].Value
+	}
+	return method.Outputs.Pack(response)
+}
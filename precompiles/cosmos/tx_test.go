@@ -0,0 +1,132 @@
+package cosmos_test
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/cosmos"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/os/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestDispatch() {
+	method := s.precompile.Methods[cosmos.DispatchMethod]
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewInt(1e18)))
+
+	// newMsgSend returns a bank send message from a random address, which must
+	// be overwritten by the caller address on dispatch.
+	newMsgSend := func() []byte {
+		msg := &banktypes.MsgSend{
+			FromAddress: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			ToAddress:   s.keyring.GetAccAddr(1).String(),
+			Amount:      amount,
+		}
+		bz, err := msg.Marshal()
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - message not allowed",
+			func() []interface{} {
+				return []interface{}{sendTypeURL, newMsgSend()}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cosmos.ErrMessageNotAllowed, sendTypeURL),
+		},
+		{
+			"fail - invalid message",
+			func() []interface{} {
+				s.allowMessages(sendTypeURL)
+				return []interface{}{sendTypeURL, []byte{0xff}}
+			},
+			func() {},
+			true,
+			"invalid cosmos message",
+		},
+		{
+			"fail - message validation",
+			func() []interface{} {
+				s.allowMessages(sendTypeURL)
+				msg := &banktypes.MsgSend{ToAddress: s.keyring.GetAccAddr(1).String()}
+				bz, err := msg.Marshal()
+				s.Require().NoError(err)
+				return []interface{}{sendTypeURL, bz}
+			},
+			func() {},
+			true,
+			"invalid coins",
+		},
+		{
+			"success - message dispatched on behalf of the caller",
+			func() []interface{} {
+				s.allowMessages(sendTypeURL)
+				return []interface{}{sendTypeURL, newMsgSend()}
+			},
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(1),
+					s.network.GetBaseDenom(),
+				)
+				s.Require().Equal(
+					network.PrefundedAccountInitialBalance.Add(amount[0].Amount),
+					balance.Amount,
+				)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.Dispatch(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Len(out, 1)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package cosmos
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	cosmosproto "github.com/cosmos/cosmos-proto"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// validatorAddressScalar is the cosmos_proto scalar of the fields holding a
+// validator operator address.
+const validatorAddressScalar = "cosmos.ValidatorAddressString"
+
+// ParseDispatchArgs parses the arguments of the dispatch transaction.
+// args: [string typeUrl, bytes message]
+func ParseDispatchArgs(args []interface{}) (string, []byte, error) {
+	if len(args) != 2 {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	typeURL, ok := args[0].(string)
+	if !ok {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidType, "typeUrl", "", args[0])
+	}
+
+	value, ok := args[1].([]byte)
+	if !ok {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidType, "message", []byte{}, args[1])
+	}
+
+	return typeURL, value, nil
+}
+
+// ParseIsAllowedArgs parses the arguments of the isAllowed query.
+// args: [string typeUrl]
+func ParseIsAllowedArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	typeURL, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "typeUrl", "", args[0])
+	}
+
+	return typeURL, nil
+}
+
+// NewMsg decodes the protobuf encoded message of the given type URL and sets
+// its signer fields to the caller. It fails if the resulting message signers
+// are not exactly the caller.
+func (p Precompile) NewMsg(caller common.Address, typeURL string, value []byte) (sdk.Msg, error) {
+	var msg sdk.Msg
+	if err := p.codec.UnpackAny(&codectypes.Any{TypeUrl: typeURL, Value: value}, &msg); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMessage, typeURL, err)
+	}
+
+	if err := setSigners(msg, caller); err != nil {
+		return nil, err
+	}
+
+	signers, _, err := p.codec.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMessage, typeURL, err)
+	}
+	if len(signers) != 1 || common.BytesToAddress(signers[0]) != caller {
+		return nil, fmt.Errorf(ErrInvalidSigners, caller, signers)
+	}
+
+	return msg, nil
+}
+
+// setSigners overwrites the string fields declared with the cosmos.msg.v1.signer
+// option by the bech32 encoding of the caller address.
+func setSigners(msg sdk.Msg, caller common.Address) error {
+	typeURL := sdk.MsgTypeURL(msg)
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return fmt.Errorf(ErrInvalidMessage, typeURL, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return fmt.Errorf(ErrInvalidMessage, typeURL, "not a message")
+	}
+
+	signerFields, ok := proto.GetExtension(msgDesc.Options(), msgv1.E_Signer).([]string)
+	if !ok || len(signerFields) == 0 {
+		return fmt.Errorf(ErrUnsupportedSigner, typeURL, "no signer option")
+	}
+
+	msgValue := reflect.ValueOf(msg)
+	if msgValue.Kind() != reflect.Ptr || msgValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf(ErrUnsupportedSigner, typeURL, "not a struct")
+	}
+	msgValue = msgValue.Elem()
+
+	for _, name := range signerFields {
+		fieldDesc := msgDesc.Fields().ByName(protoreflect.Name(name))
+		if fieldDesc == nil || fieldDesc.Kind() != protoreflect.StringKind || fieldDesc.IsList() {
+			return fmt.Errorf(ErrUnsupportedSigner, typeURL, name)
+		}
+
+		field, ok := fieldByProtoName(msgValue, name)
+		if !ok || !field.CanSet() || field.Kind() != reflect.String {
+			return fmt.Errorf(ErrUnsupportedSigner, typeURL, name)
+		}
+
+		signer := sdk.AccAddress(caller.Bytes()).String()
+		if scalar, _ := proto.GetExtension(fieldDesc.Options(), cosmosproto.E_Scalar).(string); scalar == validatorAddressScalar {
+			signer = sdk.ValAddress(caller.Bytes()).String()
+		}
+		field.SetString(signer)
+	}
+
+	return nil
+}
+
+// fieldByProtoName returns the struct field generated for the protobuf field
+// with the given name.
+func fieldByProtoName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if part == "name="+name {
+				return v.Field(i), true
+			}
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// NewBalanceChangeEntries returns the balance change entries of the EVM coin
+// transfers recorded in the coin_spent and coin_received events.
+func NewBalanceChangeEntries(events sdk.Events) ([]cmn.BalanceChangeEntry, error) {
+	var entries []cmn.BalanceChangeEntry
+	for _, event := range events {
+		var (
+			addrKey string
+			op      cmn.Operation
+		)
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, op = banktypes.AttributeKeySpender, cmn.Sub
+		case banktypes.EventTypeCoinReceived:
+			addrKey, op = banktypes.AttributeKeyReceiver, cmn.Add
+		default:
+			continue
+		}
+
+		var (
+			addr   sdk.AccAddress
+			amount sdk.Coins
+			err    error
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				addr, err = sdk.AccAddressFromBech32(attr.Value)
+			case sdk.AttributeKeyAmount:
+				amount, err = sdk.ParseCoinsNormalized(attr.Value)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if addr.Empty() {
+			continue
+		}
+
+		convertedAmount, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(amount.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt()))
+		if err != nil {
+			return nil, err
+		}
+		if convertedAmount.Cmp(uint256.NewInt(0)) == 1 {
+			entries = append(entries, cmn.NewBalanceChangeEntry(common.BytesToAddress(addr), convertedAmount, op))
+		}
+	}
+	return entries, nil
+}
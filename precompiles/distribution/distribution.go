@@ -99,6 +99,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		// Custom transactions
 		case ClaimRewardsMethod:
 			bz, err = p.ClaimRewards(ctx, contract, stateDB, method, args)
+		case CompoundRewardsMethod:
+			bz, err = p.CompoundRewards(ctx, contract, stateDB, method, args)
 		// Distribution transactions
 		case SetWithdrawAddressMethod:
 			bz, err = p.SetWithdrawAddress(ctx, contract, stateDB, method, args)
@@ -153,6 +155,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available distribution transactions are:
 //   - ClaimRewards
+//   - CompoundRewards
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorReward
 //   - WithdrawValidatorCommission
@@ -161,6 +164,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case ClaimRewardsMethod,
+		CompoundRewardsMethod,
 		SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardMethod,
 		WithdrawValidatorCommissionMethod,
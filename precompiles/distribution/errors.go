@@ -5,4 +5,6 @@ const (
 	ErrDifferentValidator = "origin address %s is not the same as validator address %s"
 	// ErrInvalidAmount is raised when the given sdk coins amount is invalid
 	ErrInvalidAmount = "invalid amount %s"
+	// ErrWithdrawerNotDelegator is raised when compounding rewards of a delegator that withdraws to another address.
+	ErrWithdrawerNotDelegator = "withdraw address %s is not the delegator address %s"
 )
@@ -12,6 +12,8 @@ import (
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,6 +30,8 @@ const (
 	EventTypeClaimRewards = "ClaimRewards"
 	// EventTypeDepositValidatorRewardsPool defines the event type for the distribution DepositValidatorRewardsPoolMethod transaction.
 	EventTypeDepositValidatorRewardsPool = "DepositValidatorRewardsPool"
+	// EventTypeCompoundRewards defines the event type for the distribution CompoundRewardsMethod transaction.
+	EventTypeCompoundRewards = "CompoundRewards"
 )
 
 // EmitClaimRewardsEvent creates a new event emitted on a ClaimRewards transaction.
@@ -68,6 +72,40 @@ func (p Precompile) EmitClaimRewardsEvent(ctx sdk.Context, stateDB vm.StateDB, d
 	return nil
 }
 
+// EmitCompoundRewardsEvent creates a new event emitted for every validator on a CompoundRewards transaction.
+func (p Precompile) EmitCompoundRewardsEvent(ctx sdk.Context, stateDB vm.StateDB, delegatorAddress common.Address, valAddr sdk.ValAddress, amount math.Int) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeCompoundRewards]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(delegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(common.BytesToAddress(valAddr.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	var b bytes.Buffer
+	b.Write(cmn.PackNum(reflect.ValueOf(amount.BigInt())))
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        b.Bytes(),
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
 // EmitSetWithdrawAddressEvent creates a new event emitted on a SetWithdrawAddressMethod transaction.
 func (p Precompile) EmitSetWithdrawAddressEvent(ctx sdk.Context, stateDB vm.StateDB, caller common.Address, withdrawerAddress string) error {
 	// Prepare the event topics
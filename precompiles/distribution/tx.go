@@ -14,6 +14,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -33,6 +35,8 @@ const (
 	// DepositValidatorRewardsPoolMethod defines the ABI method name for the distribution
 	// DepositValidatorRewardsPool transaction
 	DepositValidatorRewardsPoolMethod = "depositValidatorRewardsPool"
+	// CompoundRewardsMethod defines the ABI method name for the custom CompoundRewards transaction
+	CompoundRewardsMethod = "compoundRewards"
 )
 
 // ClaimRewards claims the rewards accumulated by a delegator from multiple or all validators.
@@ -103,6 +107,101 @@ func (p *Precompile) ClaimRewards(
 	return method.Outputs.Pack(true)
 }
 
+// CompoundRewards withdraws the rewards accumulated by a delegator from multiple or all
+// validators and re-delegates the bond denom rewards to the same validators.
+func (p *Precompile) CompoundRewards(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, maxValidators, err := parseClaimRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	maxVals, err := p.stakingKeeper.MaxValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxValidators > maxVals {
+		return nil, fmt.Errorf("maxValidators (%d) parameter exceeds the maximum number of validators (%d)", maxValidators, maxVals)
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorAddr.String())
+	}
+
+	// The rewards are sent to the withdraw address, so they can only be re-delegated
+	// when the delegator withdraws to itself.
+	withdrawerHexAddr, err := p.getWithdrawerHexAddr(ctx, delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawerHexAddr != delegatorAddr {
+		return nil, fmt.Errorf(ErrWithdrawerNotDelegator, withdrawerHexAddr.String(), delegatorAddr.String())
+	}
+
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr.Bytes(), maxValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	totalCoins := sdk.Coins{}
+	compounded := sdk.Coins{}
+	for _, validator := range res.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), valAddr)
+		if err != nil {
+			return nil, err
+		}
+		totalCoins = totalCoins.Add(coins...)
+
+		amount := coins.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		coin := sdk.NewCoin(bondDenom, amount)
+		if _, err := msgSrv.Delegate(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: sdk.AccAddress(delegatorAddr.Bytes()).String(),
+			ValidatorAddress: validator.OperatorAddress,
+			Amount:           coin,
+		}); err != nil {
+			return nil, err
+		}
+		compounded = compounded.Add(coin)
+
+		if err := p.EmitCompoundRewardsEvent(ctx, stateDB, delegatorAddr, valAddr, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only the withdrawn rewards that were not re-delegated change the delegator balance.
+	remaining := totalCoins.Sub(compounded...)
+	convertedAmount, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(remaining.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt()))
+	if err != nil {
+		return nil, err
+	}
+	if convertedAmount.Cmp(uint256.NewInt(0)) == 1 {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(delegatorAddr, convertedAmount, cmn.Add))
+	}
+
+	return method.Outputs.Pack(compounded.AmountOf(bondDenom).BigInt())
+}
+
 // SetWithdrawAddress sets the withdrawal address for a delegator (or validator self-delegation).
 func (p Precompile) SetWithdrawAddress(
 	ctx sdk.Context,
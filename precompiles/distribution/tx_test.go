@@ -494,6 +494,165 @@ func (s *PrecompileTestSuite) TestClaimRewards() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestCompoundRewards() {
+	var (
+		ctx           sdk.Context
+		prevBalance   sdk.Coin
+		prevDelegated math.Int
+	)
+	method := s.precompile.Methods[distribution.CompoundRewardsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - too many validators",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(32_000_000),
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"maxValidators (32000000) parameter exceeds the maximum number of validators (100)",
+		},
+		{
+			"fail - different delegator address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					uint32(3),
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - withdraw address is not the delegator",
+			func() []interface{} {
+				err := s.network.App.DistrKeeper.SetDelegatorWithdrawAddr(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(3),
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"is not the delegator address",
+		},
+		{
+			"success - compound rewards from all validators",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(3),
+				}
+			},
+			func(data []byte) {
+				expRewards := expRewardsAmt.Mul(math.NewInt(3))
+
+				out, err := method.Outputs.Unpack(data)
+				s.Require().NoError(err)
+				s.Require().Equal(expRewards.BigInt(), out[0])
+
+				// rewards are re-delegated instead of sent to the delegator
+				balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), testconstants.ExampleAttoDenom)
+				s.Require().Equal(prevBalance.Amount, balance.Amount)
+
+				delegated, err := s.network.App.StakingKeeper.GetDelegatorBonded(ctx, s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+				s.Require().Equal(prevDelegated.Add(expRewards), delegated)
+			},
+			20000,
+			false,
+			"",
+		},
+		{
+			"success - compound rewards from only 1 validator",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(1),
+				}
+			},
+			func(data []byte) {
+				out, err := method.Outputs.Unpack(data)
+				s.Require().NoError(err)
+				s.Require().Equal(expRewardsAmt.BigInt(), out[0])
+
+				delegated, err := s.network.App.StakingKeeper.GetDelegatorBonded(ctx, s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+				s.Require().Equal(prevDelegated.Add(expRewardsAmt), delegated)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var (
+				contract *vm.Contract
+				err      error
+			)
+			addr := s.keyring.GetAddr(0)
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, addr, s.precompile.Address(), tc.gas)
+
+			validators := s.network.GetValidators()
+			srs := make([]stakingRewards, len(validators))
+			for i, val := range validators {
+				srs[i] = stakingRewards{
+					Delegator: addr.Bytes(),
+					Validator: val,
+					RewardAmt: testRewardsAmt,
+				}
+			}
+
+			ctx, err = s.prepareStakingRewards(ctx, srs...)
+			s.Require().NoError(err)
+
+			prevBalance = s.network.App.BankKeeper.GetBalance(ctx, addr.Bytes(), testconstants.ExampleAttoDenom)
+			prevDelegated, err = s.network.App.StakingKeeper.GetDelegatorBonded(ctx, addr.Bytes())
+			s.Require().NoError(err)
+
+			args := tc.malleate()
+			bz, err := s.precompile.CompoundRewards(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestFundCommunityPool() {
 	var ctx sdk.Context
 	method := s.precompile.Methods[distribution.FundCommunityPoolMethod]
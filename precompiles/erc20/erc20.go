@@ -32,6 +32,9 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+	GasPermit            = 52_118
+	GasNonces            = 2_603
+	GasDomainSeparator   = 4_385
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -51,6 +54,12 @@ type Precompile struct {
 	BankKeeper bankkeeper.Keeper
 }
 
+// LoadABI loads the IERC20MetadataAllowance ABI from the embedded abi.json file
+// for the ERC-20 precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, abiPath)
+}
+
 // NewPrecompile creates a new ERC-20 Precompile instance as a
 // PrecompiledContract interface.
 func NewPrecompile(
@@ -59,7 +68,7 @@ func NewPrecompile(
 	erc20Keeper Erc20Keeper,
 	transferKeeper transferkeeper.Keeper,
 ) (*Precompile, error) {
-	newABI, err := cmn.LoadABI(f, abiPath)
+	newABI, err := LoadABI()
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +130,13 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	// EIP-2612 permit
+	case PermitMethod:
+		return GasPermit
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
 	default:
 		return 0
 	}
@@ -170,7 +186,8 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		TransferFromMethod,
 		ApproveMethod,
 		IncreaseAllowanceMethod,
-		DecreaseAllowanceMethod:
+		DecreaseAllowanceMethod,
+		PermitMethod:
 		return true
 	default:
 		return false
@@ -210,6 +227,13 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 permit
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -37,6 +37,10 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// ERC20Permit errors
+	ErrPermitExpired          = errors.New("ERC20Permit: expired deadline")
+	ErrInvalidPermitSignature = errors.New("ERC20Permit: invalid signature")
 )
 
 // BuildExecRevertedErr returns a mocked error that should align with the
@@ -0,0 +1,192 @@
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 Permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 Nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+
+	// permitVersion is the version used in the EIP-712 domain of the permit signatures.
+	permitVersion = "1"
+)
+
+var (
+	// eip712DomainTypehash is the EIP-712 type hash of the domain used for permit signatures.
+	eip712DomainTypehash = crypto.Keccak256Hash(
+		[]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"),
+	)
+	// permitTypehash is the EIP-712 type hash of the EIP-2612 Permit struct.
+	permitTypehash = crypto.Keccak256Hash(
+		[]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"),
+	)
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given a valid EIP-712 signature of the owner. It consumes the owner's
+// current nonce and emits the Approval event on success.
+//
+// In contrast to Approve, the owner is not the caller of the method but the
+// account recovered from the signature, which allows third parties to submit
+// approvals on behalf of the owner.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, v, r, s, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	digest := permitDigest(domainSeparator, owner, spender, value, new(big.Int).SetUint64(nonce), deadline)
+
+	signer, err := recoverSigner(digest, v, r, s)
+	if err != nil || signer != owner {
+		return nil, ErrInvalidPermitSignature
+	}
+
+	p.erc20Keeper.IncrementPermitNonce(ctx, p.Address(), owner)
+
+	if err := p.setAllowance(ctx, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current permit nonce of the given owner. Every successful
+// call to Permit increases the owner's nonce by one.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used to sign permits
+// for the token.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
+
+// domainSeparator builds the EIP-712 domain separator from the token name, the
+// permit version, the EVM chain ID and the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	if chainID == nil {
+		return common.Hash{}, fmt.Errorf("chain ID not set")
+	}
+
+	return crypto.Keccak256Hash(
+		eip712DomainTypehash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(permitVersion)),
+		common.BigToHash(chainID).Bytes(),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	), nil
+}
+
+// permitDigest returns the EIP-712 digest of a permit that has to be signed by the owner.
+func permitDigest(
+	domainSeparator common.Hash,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) common.Hash {
+	structHash := crypto.Keccak256(
+		permitTypehash.Bytes(),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		common.BigToHash(value).Bytes(),
+		common.BigToHash(nonce).Bytes(),
+		common.BigToHash(deadline).Bytes(),
+	)
+
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash)
+}
+
+// recoverSigner returns the address that signed the given digest. Only
+// signatures in the lower half of the curve order are accepted to prevent
+// signature malleability, same as ecrecover is used in the reference
+// implementation of EIP-2612.
+func recoverSigner(digest common.Hash, v uint8, r, s [32]byte) (common.Address, error) {
+	if v < 27 {
+		return common.Address{}, fmt.Errorf("invalid signature recovery id: %d", v)
+	}
+	recoveryID := v - 27
+
+	if !crypto.ValidateSignatureValues(recoveryID, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, fmt.Errorf("invalid signature values")
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = recoveryID
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
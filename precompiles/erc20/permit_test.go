@@ -0,0 +1,234 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// permitTypedData returns the EIP-712 typed data of a permit for the given precompile.
+func permitTypedData(
+	precompile *erc20.Precompile,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              validMetadataName,
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: precompile.Address().Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    (*math.HexOrDecimal256)(value),
+			"nonce":    (*math.HexOrDecimal256)(nonce),
+			"deadline": (*math.HexOrDecimal256)(deadline),
+		},
+	}
+}
+
+// setupPermitPrecompile sets up an instance of the ERC20 precompile for a coin
+// with registered metadata, so that the EIP-712 domain name is available.
+func (s *PrecompileTestSuite) setupPermitPrecompile() *erc20.Precompile {
+	ctx := s.network.GetContext()
+	err := s.network.App.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(validMetadata.Base, 1)})
+	s.Require().NoError(err)
+	s.network.App.BankKeeper.SetDenomMetaData(ctx, validMetadata)
+
+	return s.setupERC20Precompile(validMetadataDenom)
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	s.SetupTest()
+	precompile := s.setupPermitPrecompile()
+	method := precompile.Methods[erc20.DomainSeparatorMethod]
+
+	typedData := permitTypedData(precompile, common.Address{}, common.Address{}, common.Big0, common.Big0, common.Big0)
+	expDomainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	s.Require().NoError(err)
+
+	bz, err := precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, []interface{}{})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal([32]byte(common.BytesToHash(expDomainSeparator)), out[0])
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	var (
+		precompile *erc20.Precompile
+		ctx        sdk.Context
+	)
+
+	method := s.precompile.Methods[erc20.PermitMethod]
+	noncesMethod := s.precompile.Methods[erc20.NoncesMethod]
+	value := big.NewInt(100)
+
+	// signPermit returns the permit arguments signed by the key at the given keyring index.
+	signPermit := func(signerIdx int, owner common.Address, nonce, deadline *big.Int) []interface{} {
+		spender := s.keyring.GetAddr(1)
+		typedData := permitTypedData(precompile, owner, spender, value, nonce, deadline)
+		digest, _, err := apitypes.TypedDataAndHash(typedData)
+		s.Require().NoError(err)
+
+		privKey, ok := s.keyring.GetPrivKey(signerIdx).(*ethsecp256k1.PrivKey)
+		s.Require().True(ok)
+		key, err := privKey.ToECDSA()
+		s.Require().NoError(err)
+
+		sig, err := crypto.Sign(digest, key)
+		s.Require().NoError(err)
+
+		var r, ss [32]byte
+		copy(r[:], sig[:32])
+		copy(ss[:], sig[32:64])
+		return []interface{}{owner, spender, value, deadline, sig[64] + 27, r, ss}
+	}
+
+	farDeadline := func() *big.Int {
+		return big.NewInt(ctx.BlockTime().Unix() + 3600)
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func() []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func() []interface{} {
+				return signPermit(0, s.keyring.GetAddr(0), common.Big0, big.NewInt(ctx.BlockTime().Unix()-1))
+			},
+			errContains: erc20.ErrPermitExpired.Error(),
+		},
+		{
+			name: "fail - signed by different account",
+			malleate: func() []interface{} {
+				return signPermit(1, s.keyring.GetAddr(0), common.Big0, farDeadline())
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			name: "fail - wrong nonce",
+			malleate: func() []interface{} {
+				return signPermit(0, s.keyring.GetAddr(0), common.Big1, farDeadline())
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			name: "fail - invalid recovery id",
+			malleate: func() []interface{} {
+				args := signPermit(0, s.keyring.GetAddr(0), common.Big0, farDeadline())
+				args[4] = uint8(1)
+				return args
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			name: "fail - replayed signature",
+			malleate: func() []interface{} {
+				args := signPermit(0, s.keyring.GetAddr(0), common.Big0, farDeadline())
+				_, err := precompile.Permit(ctx, nil, s.network.GetStateDB(), &method, args)
+				s.Require().NoError(err)
+				return args
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			name: "pass - valid permit",
+			malleate: func() []interface{} {
+				return signPermit(0, s.keyring.GetAddr(0), common.Big0, farDeadline())
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(
+					precompile.Address(),
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					value,
+				)
+
+				bz, err := precompile.Nonces(ctx, nil, nil, &noncesMethod, []interface{}{s.keyring.GetAddr(0)})
+				s.requireOut(bz, err, noncesMethod, true, "", common.Big1)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			precompile = s.setupPermitPrecompile()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(1),
+				precompile.Address(),
+				200_000,
+			)
+
+			var args []interface{}
+			if tc.malleate != nil {
+				args = tc.malleate()
+			}
+
+			_, err := precompile.Permit(
+				ctx,
+				contract,
+				s.network.GetStateDB(),
+				&method,
+				args,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
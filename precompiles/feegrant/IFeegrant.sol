@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance defines a fee allowance given by a granter to a grantee.
+/// Periodic and allowed message allowances are represented by their
+/// underlying basic allowance.
+struct Allowance {
+    /// @dev Address of the account paying the fees
+    address granter;
+    /// @dev Address of the account whose fees are paid
+    address grantee;
+    /// @dev Maximum amount of tokens that can be spent, empty if unlimited
+    Coin[] spendLimit;
+    /// @dev Unix timestamp in seconds when the allowance expires, zero if it never expires
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with feegrant.
+/// Ethereum transactions calling a contract with a registered fee granter have
+/// their fees deducted from the allowance given by the granter to the sender.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Emitted when a fee allowance is revoked
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Emitted when the fee granter of a contract is set
+    /// @param contractAddress The address of the sponsored contract
+    /// @param granter The address of the fee granter, zero if removed
+    event SetFeeGranter(address indexed contractAddress, address indexed granter);
+
+    /// @dev Grants a basic fee allowance from the granter to the grantee.
+    /// @param granter The address of the granter, must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of tokens that can be spent, empty for unlimited
+    /// @param expiration The unix timestamp in seconds when the allowance expires, zero for none
+    /// @return success Whether the transaction was successful or not
+    function grant(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance given by the granter to the grantee.
+    /// @param granter The address of the granter, must be the caller
+    /// @param grantee The address of the grantee
+    /// @return success Whether the transaction was successful or not
+    function revoke(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Sets the fee granter that pays the fees of the Ethereum transactions calling
+    /// the contract, for the senders that were given an allowance by the granter.
+    /// @param contractAddress The address of the sponsored contract, must be the caller
+    /// @param granter The address of the fee granter, zero to remove it. The granter must
+    /// have given an allowance to the contract to consent to sponsor it.
+    /// @return success Whether the transaction was successful or not
+    function setFeeGranter(
+        address contractAddress,
+        address granter
+    ) external returns (bool success);
+
+    /// @dev Queries the fee allowance given by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev Queries all the fee allowances given to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pageRequest Defines a pagination for the request
+    /// @return allowances The fee allowances
+    /// @return pageResponse The pagination response for the query
+    function allowances(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev Queries the fee granter registered for the contract.
+    /// @param contractAddress The address of the contract
+    /// @return granter The address of the fee granter, zero if none
+    function feeGranter(
+        address contractAddress
+    ) external view returns (address granter);
+}
@@ -0,0 +1,16 @@
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidContract is raised when the contract address is not valid.
+	ErrInvalidContract = "invalid contract address: %v"
+	// ErrInvalidExpiration is raised when the expiration is negative.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %s"
+	// ErrGranterNotConsented is raised when the fee granter did not give an allowance to the contract.
+	ErrGranterNotConsented = "fee granter %s has not given an allowance to the contract %s"
+)
@@ -0,0 +1,64 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrantAllowance defines the event type for the feegrant GrantMethod transaction.
+	EventTypeGrantAllowance = "GrantAllowance"
+	// EventTypeRevokeAllowance defines the event type for the feegrant RevokeMethod transaction.
+	EventTypeRevokeAllowance = "RevokeAllowance"
+	// EventTypeSetFeeGranter defines the event type for the feegrant SetFeeGranterMethod transaction.
+	EventTypeSetFeeGranter = "SetFeeGranter"
+)
+
+// EmitGrantAllowanceEvent emits the GrantAllowance event
+func (p Precompile) EmitGrantAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAddressPairEvent(ctx, stateDB, EventTypeGrantAllowance, granter, grantee)
+}
+
+// EmitRevokeAllowanceEvent emits the RevokeAllowance event
+func (p Precompile) EmitRevokeAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAddressPairEvent(ctx, stateDB, EventTypeRevokeAllowance, granter, grantee)
+}
+
+// EmitSetFeeGranterEvent emits the SetFeeGranter event
+func (p Precompile) EmitSetFeeGranterEvent(ctx sdk.Context, stateDB vm.StateDB, contract, granter common.Address) error {
+	return p.emitAddressPairEvent(ctx, stateDB, EventTypeSetFeeGranter, contract, granter)
+}
+
+// emitAddressPairEvent emits an event whose only arguments are two indexed addresses
+func (p Precompile) emitAddressPairEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, first, second common.Address) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(first)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(second)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
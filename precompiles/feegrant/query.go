@@ -0,0 +1,83 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query
+	AllowancesMethod = "allowances"
+	// FeeGranterMethod defines the ABI method name for the query of the fee granter
+	// registered for a contract
+	FeeGranterMethod = "feeGranter"
+)
+
+// Allowance implements the query to get the fee allowance given by a granter to a grantee.
+func (p *Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowanceOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowance)
+}
+
+// Allowances implements the query to get all the fee allowances given to a grantee.
+func (p *Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
+
+// FeeGranter implements the query to get the fee granter registered for a contract.
+// It returns the zero address if the contract has no fee granter.
+func (p *Precompile) FeeGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contract, err := ParseFeeGranterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, _ := p.evmKeeper.GetFeeGranter(ctx, contract)
+	return method.Outputs.Pack(granter)
+}
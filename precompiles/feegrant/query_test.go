@@ -0,0 +1,115 @@
+package feegrant_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance found",
+			func() []interface{} {
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Allowance(s.network.GetContext(), &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				var out feegrant.AllowanceOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), out.Allowance.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Allowance.Grantee)
+				s.Require().Empty(out.Allowance.SpendLimit)
+				s.Require().Zero(out.Allowance.Expiration)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2))
+	s.grantAllowance(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2))
+
+	bz, err := s.precompile.Allowances(
+		s.network.GetContext(),
+		&method,
+		nil,
+		[]interface{}{s.keyring.GetAddr(2), query.PageRequest{Limit: 10, CountTotal: true}},
+	)
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Allowances, 2)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	for _, allowance := range out.Allowances {
+		s.Require().Equal(s.keyring.GetAddr(2), allowance.Grantee)
+	}
+}
+
+func (s *PrecompileTestSuite) TestFeeGranter() {
+	method := s.precompile.Methods[feegrant.FeeGranterMethod]
+	contractAddr := utiltx.GenerateAddress()
+
+	bz, err := s.precompile.FeeGranter(s.network.GetContext(), &method, nil, []interface{}{contractAddr})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(common.Address{}, out[0])
+
+	s.network.App.EVMKeeper.SetFeeGranter(s.network.GetContext(), contractAddr, s.keyring.GetAddr(0))
+
+	bz, err = s.precompile.FeeGranter(s.network.GetContext(), &method, nil, []interface{}{contractAddr})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), out[0])
+}
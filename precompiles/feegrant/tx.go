@@ -0,0 +1,128 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the feegrant RevokeAllowance
+	// transaction.
+	RevokeMethod = "revoke"
+	// SetFeeGranterMethod defines the ABI method name for the transaction that
+	// registers the fee granter sponsoring the calls to a contract.
+	SetFeeGranterMethod = "setFeeGranter"
+)
+
+// Grant implements the grant precompile transaction, which gives a basic fee
+// allowance from the granter to the grantee.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantAllowance(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke implements the revoke precompile transaction, which removes the fee
+// allowance given by the granter to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevokeAllowance(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetFeeGranter implements the setFeeGranter precompile transaction, which registers
+// the fee granter that pays the fees of the Ethereum transactions calling the contract.
+// It can only be called by the contract itself and the granter must consent to sponsor
+// the contract by giving it a fee allowance first. The fees are only sponsored for the
+// senders that were given an allowance by the granter.
+func (p Precompile) SetFeeGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, granter, err := ParseSetFeeGranterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != contractAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), contractAddr.String())
+	}
+
+	if granter == (common.Address{}) {
+		p.evmKeeper.DeleteFeeGranter(ctx, contractAddr)
+	} else {
+		// the allowance from the granter to the contract records the granter consent
+		if _, err := p.feegrantKeeper.GetAllowance(ctx, granter.Bytes(), contractAddr.Bytes()); err != nil {
+			return nil, fmt.Errorf(ErrGranterNotConsented, granter, contractAddr)
+		}
+		p.evmKeeper.SetFeeGranter(ctx, contractAddr, granter)
+	}
+
+	if err := p.EmitSetFeeGranterEvent(ctx, stateDB, contractAddr, granter); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,311 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdkmath "cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[feegrant.GrantMethod]
+	spendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), "", spendLimit, int64(0),
+				}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(-1),
+				}
+			},
+			func() {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(), s.keyring.GetAddr(1), spendLimit, int64(0),
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - allowance granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0),
+				}
+			},
+			func() {
+				grant, err := s.network.App.FeeGrantKeeper.GetAllowance(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(0),
+					s.keyring.GetAccAddr(1),
+				)
+				s.Require().NoError(err)
+				basic, ok := grant.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(
+					sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewIntFromBigInt(spendLimit[0].Amount))),
+					basic.SpendLimit,
+				)
+				s.Require().Nil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.Grant(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[feegrant.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), s.keyring.GetAddr(1)}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance revoked",
+			func() []interface{} {
+				s.grantAllowance(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.Revoke(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+
+				_, err := s.network.App.FeeGrantKeeper.GetAllowance(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(0),
+					s.keyring.GetAccAddr(1),
+				)
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSetFeeGranter() {
+	method := s.precompile.Methods[feegrant.SetFeeGranterMethod]
+	contractAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		caller      common.Address
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			contractAddr,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the contract address",
+			utiltx.GenerateAddress(),
+			func() []interface{} {
+				return []interface{}{contractAddr, s.keyring.GetAddr(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter has not given an allowance to the contract",
+			contractAddr,
+			func() []interface{} {
+				return []interface{}{contractAddr, s.keyring.GetAddr(0)}
+			},
+			func() {},
+			true,
+			"has not given an allowance to the contract",
+		},
+		{
+			"success - fee granter set",
+			contractAddr,
+			func() []interface{} {
+				ctx := s.network.GetContext()
+				acc := s.network.App.AccountKeeper.NewAccountWithAddress(ctx, contractAddr.Bytes())
+				s.network.App.AccountKeeper.SetAccount(ctx, acc)
+				s.grantAllowance(s.keyring.GetAccAddr(0), contractAddr.Bytes())
+				return []interface{}{contractAddr, s.keyring.GetAddr(0)}
+			},
+			func() {
+				granter, found := s.network.App.EVMKeeper.GetFeeGranter(s.network.GetContext(), contractAddr)
+				s.Require().True(found)
+				s.Require().Equal(s.keyring.GetAddr(0), granter)
+			},
+			false,
+			"",
+		},
+		{
+			"success - fee granter removed with the zero address",
+			contractAddr,
+			func() []interface{} {
+				s.network.App.EVMKeeper.SetFeeGranter(s.network.GetContext(), contractAddr, s.keyring.GetAddr(0))
+				return []interface{}{contractAddr, common.Address{}}
+			},
+			func() {
+				_, found := s.network.App.EVMKeeper.GetFeeGranter(s.network.GetContext(), contractAddr)
+				s.Require().False(found)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				tc.caller,
+				s.precompile.Address(),
+				200000,
+			)
+
+			res, err := s.precompile.SetFeeGranter(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grantAllowance gives a basic fee allowance without spend limit from the
+// granter to the grantee.
+func (s *PrecompileTestSuite) grantAllowance(granter, grantee sdk.AccAddress) {
+	err := s.network.App.FeeGrantKeeper.GrantAllowance(
+		s.network.GetContext(),
+		granter,
+		grantee,
+		&feegranttypes.BasicAllowance{},
+	)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,267 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Allowance represents a fee allowance given by a granter to a grantee.
+// Only the basic allowance fields are exposed. The expiration is a unix
+// timestamp in seconds, or zero if the allowance does not expire.
+type Allowance struct {
+	Granter    common.Address `abi:"granter"`
+	Grantee    common.Address `abi:"grantee"`
+	SpendLimit []cmn.Coin     `abi:"spendLimit"`
+	Expiration int64          `abi:"expiration"`
+}
+
+// AllowanceOutput represents the output of the allowance query
+type AllowanceOutput struct {
+	Allowance Allowance
+}
+
+// AllowancesOutput represents the output of the allowances query
+type AllowancesOutput struct {
+	Allowances   []Allowance        `abi:"allowances"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// AllowancesInput represents the input for the allowances query
+type AllowancesInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pageRequest"`
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance with a basic allowance.
+// args: [granter, grantee, []cmn.Coin spendLimit, int64 expiration]
+func NewMsgGrantAllowance(args []interface{}) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	allowance := &feegrant.BasicAllowance{SpendLimit: spendLimit}
+	if expiration > 0 {
+		exp := time.Unix(expiration, 0).UTC()
+		allowance.Expiration = &exp
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance.
+// args: [granter, grantee]
+func NewMsgRevokeAllowance(args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, granter, grantee, nil
+}
+
+// ParseSetFeeGranterArgs parses the arguments of the setFeeGranter transaction.
+// A zero granter address removes the fee granter of the contract.
+func ParseSetFeeGranterArgs(args []interface{}) (common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	contract, ok := args[0].(common.Address)
+	if !ok || contract == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	granter, ok := args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[1])
+	}
+
+	return contract, granter, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the allowance query
+func ParseAllowanceArgs(args []interface{}) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the allowances query
+func ParseAllowancesArgs(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseFeeGranterArgs parses the arguments for the feeGranter query
+func ParseFeeGranterArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	contract, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	return contract, nil
+}
+
+func (ao *AllowanceOutput) FromResponse(res *feegrant.QueryAllowanceResponse) (*AllowanceOutput, error) {
+	allowance, err := NewAllowance(res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	ao.Allowance = allowance
+	return ao, nil
+}
+
+func (ao *AllowancesOutput) FromResponse(res *feegrant.QueryAllowancesResponse) (*AllowancesOutput, error) {
+	ao.Allowances = make([]Allowance, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		allowance, err := NewAllowance(grant)
+		if err != nil {
+			return nil, err
+		}
+		ao.Allowances[i] = allowance
+	}
+	if res.Pagination != nil {
+		ao.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return ao, nil
+}
+
+// NewAllowance converts a fee grant into its ABI representation. Periodic and
+// allowed message allowances are represented by their underlying basic allowance.
+func NewAllowance(grant *feegrant.Grant) (Allowance, error) {
+	granter, err := cmn.HexAddressFromBech32String(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+	grantee, err := cmn.HexAddressFromBech32String(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	feeAllowance, err := grant.GetGrant()
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	allowance := Allowance{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: []cmn.Coin{},
+	}
+	basic, err := basicAllowance(feeAllowance)
+	if err != nil {
+		return Allowance{}, err
+	}
+	if basic != nil {
+		allowance.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+		if basic.Expiration != nil {
+			allowance.Expiration = basic.Expiration.Unix()
+		}
+	}
+
+	return allowance, nil
+}
+
+// basicAllowance returns the basic allowance underlying the given fee allowance.
+func basicAllowance(allowance feegrant.FeeAllowanceI) (*feegrant.BasicAllowance, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return a, nil
+	case *feegrant.PeriodicAllowance:
+		return &a.Basic, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		return basicAllowance(inner)
+	default:
+		return nil, nil
+	}
+}
+
+// parseGranterGrantee parses the granter and grantee addresses.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
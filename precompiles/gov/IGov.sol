@@ -70,6 +70,38 @@ struct ProposalData {
     address proposer;
 }
 
+/// @dev ProposalInput defines the proposal fields of submitProposalWithMessages
+struct ProposalInput {
+    string title;
+    string summary;
+    string metadata;
+    bool expedited;
+}
+
+/// @dev ProposalMessage defines a proposal message identified by its Cosmos type URL.
+/// The value of the following type URLs is ABI-encoded, with the authority set to the
+/// governance module account:
+///  - /cosmos.distribution.v1beta1.MsgCommunityPoolSpend:
+///    (address recipient, Coin[] amount)
+///  - /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade:
+///    (string name, int64 height, string info)
+///  - /cosmos.evm.erc20.v1.MsgUpdateParams:
+///    (bool enableErc20, address[] nativePrecompiles, address[] dynamicPrecompiles,
+///     bool permissionlessRegistration, bool autoRegisterDenoms)
+///  - /cosmos.evm.feemarket.v1.MsgUpdateParams:
+///    (bool noBaseFee, uint32 baseFeeChangeDenominator, uint32 elasticityMultiplier,
+///     int64 enableHeight, uint256 baseFee, uint256 minGasPrice, uint256 minGasMultiplier),
+///    with decimal values using 18 decimals of precision
+///  - /cosmos.evm.vm.v1.MsgUpdateParams:
+///    (string evmDenom, int64[] extraEips, bool allowUnprotectedTxs, string[] evmChannels,
+///     ((uint8, address[]), (uint8, address[])) accessControl, address[] activeStaticPrecompiles,
+///     string[] allowedCosmosMessages, string[] allowedStargateQueries)
+/// Any other type URL expects the protobuf encoding of the message.
+struct ProposalMessage {
+    string typeUrl;
+    bytes value;
+}
+
 /// @dev Params defines the governance parameters
 struct Params {
     int64 votingPeriod;
@@ -139,6 +171,21 @@ interface IGov {
         Coin[] calldata deposit
     ) external returns (uint64 proposalId);
 
+    /// @notice submitProposalWithMessages creates a new proposal from ABI-encoded messages.
+    /// @dev submitProposalWithMessages defines a method to submit a proposal without
+    /// building protoJSON on-chain. See ProposalMessage for the supported encodings.
+    /// @param proposer The proposer address
+    /// @param proposal The proposal title, summary, metadata and expedited flag
+    /// @param messages The proposal messages
+    /// @param deposit The deposit for the proposal
+    /// @return proposalId The proposal id
+    function submitProposalWithMessages(
+        address proposer,
+        ProposalInput calldata proposal,
+        ProposalMessage[] calldata messages,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
     /// @dev cancelProposal defines a method to cancel a proposal.
     /// @param proposalId The proposal id
     /// @return success Whether the transaction was successful or not
@@ -27,4 +27,8 @@ const (
 	ErrInvalidDepositor = "invalid depositor address: %s"
 	// ErrInvalidDeposits invalid deposits.
 	ErrInvalidDeposits = "invalid deposits %s "
+	// ErrInvalidProposalMessage invalid proposal message.
+	ErrInvalidProposalMessage = "invalid proposal message %s: %s"
+	// ErrEmptyProposalMessages is raised when a proposal is submitted without messages.
+	ErrEmptyProposalMessages = "proposal must contain at least one message"
 )
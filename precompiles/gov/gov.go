@@ -104,6 +104,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			bz, err = p.VoteWeighted(ctx, contract, stateDB, method, args)
 		case SubmitProposalMethod:
 			bz, err = p.SubmitProposal(ctx, contract, stateDB, method, args)
+		case SubmitProposalWithMessagesMethod:
+			bz, err = p.SubmitProposalWithMessages(ctx, contract, stateDB, method, args)
 		case DepositMethod:
 			bz, err = p.Deposit(ctx, contract, stateDB, method, args)
 		case CancelProposalMethod:
@@ -154,7 +156,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case VoteMethod, VoteWeightedMethod,
-		SubmitProposalMethod, DepositMethod, CancelProposalMethod:
+		SubmitProposalMethod, SubmitProposalWithMessagesMethod, DepositMethod, CancelProposalMethod:
 		return true
 	default:
 		return false
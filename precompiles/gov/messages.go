@@ -0,0 +1,319 @@
+package gov
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var (
+	// TypeURLCommunityPoolSpend is the type URL of the distribution MsgCommunityPoolSpend.
+	TypeURLCommunityPoolSpend = sdk.MsgTypeURL(&distrtypes.MsgCommunityPoolSpend{})
+	// TypeURLSoftwareUpgrade is the type URL of the upgrade MsgSoftwareUpgrade.
+	TypeURLSoftwareUpgrade = sdk.MsgTypeURL(&upgradetypes.MsgSoftwareUpgrade{})
+	// TypeURLERC20UpdateParams is the type URL of the erc20 MsgUpdateParams.
+	TypeURLERC20UpdateParams = sdk.MsgTypeURL(&erc20types.MsgUpdateParams{})
+	// TypeURLFeeMarketUpdateParams is the type URL of the feemarket MsgUpdateParams.
+	TypeURLFeeMarketUpdateParams = sdk.MsgTypeURL(&feemarkettypes.MsgUpdateParams{})
+	// TypeURLEVMUpdateParams is the type URL of the vm MsgUpdateParams.
+	TypeURLEVMUpdateParams = sdk.MsgTypeURL(&evmtypes.MsgUpdateParams{})
+)
+
+var (
+	coinType = mustNewType("tuple[]", []abi.ArgumentMarshaling{
+		{Name: "denom", Type: "string"},
+		{Name: "amount", Type: "uint256"},
+	})
+	accessControlType = mustNewType("tuple", []abi.ArgumentMarshaling{
+		{Name: "create", Type: "tuple", Components: accessControlTypeComponents},
+		{Name: "call", Type: "tuple", Components: accessControlTypeComponents},
+	})
+	accessControlTypeComponents = []abi.ArgumentMarshaling{
+		{Name: "accessType", Type: "uint8"},
+		{Name: "accessControlList", Type: "address[]"},
+	}
+
+	// communityPoolSpendArgs is the ABI encoding of a MsgCommunityPoolSpend:
+	// (address recipient, Coin[] amount).
+	communityPoolSpendArgs = abi.Arguments{
+		{Name: "recipient", Type: mustNewType("address", nil)},
+		{Name: "amount", Type: coinType},
+	}
+	// softwareUpgradeArgs is the ABI encoding of a MsgSoftwareUpgrade:
+	// (string name, int64 height, string info).
+	softwareUpgradeArgs = abi.Arguments{
+		{Name: "name", Type: mustNewType("string", nil)},
+		{Name: "height", Type: mustNewType("int64", nil)},
+		{Name: "info", Type: mustNewType("string", nil)},
+	}
+	// erc20ParamsArgs is the ABI encoding of the erc20 module parameters.
+	erc20ParamsArgs = abi.Arguments{
+		{Name: "enableErc20", Type: mustNewType("bool", nil)},
+		{Name: "nativePrecompiles", Type: mustNewType("address[]", nil)},
+		{Name: "dynamicPrecompiles", Type: mustNewType("address[]", nil)},
+		{Name: "permissionlessRegistration", Type: mustNewType("bool", nil)},
+		{Name: "autoRegisterDenoms", Type: mustNewType("bool", nil)},
+	}
+	// feeMarketParamsArgs is the ABI encoding of the feemarket module parameters.
+	// The decimal values are encoded as integers with 18 decimals of precision.
+	feeMarketParamsArgs = abi.Arguments{
+		{Name: "noBaseFee", Type: mustNewType("bool", nil)},
+		{Name: "baseFeeChangeDenominator", Type: mustNewType("uint32", nil)},
+		{Name: "elasticityMultiplier", Type: mustNewType("uint32", nil)},
+		{Name: "enableHeight", Type: mustNewType("int64", nil)},
+		{Name: "baseFee", Type: mustNewType("uint256", nil)},
+		{Name: "minGasPrice", Type: mustNewType("uint256", nil)},
+		{Name: "minGasMultiplier", Type: mustNewType("uint256", nil)},
+	}
+	// evmParamsArgs is the ABI encoding of the vm module parameters.
+	evmParamsArgs = abi.Arguments{
+		{Name: "evmDenom", Type: mustNewType("string", nil)},
+		{Name: "extraEips", Type: mustNewType("int64[]", nil)},
+		{Name: "allowUnprotectedTxs", Type: mustNewType("bool", nil)},
+		{Name: "evmChannels", Type: mustNewType("string[]", nil)},
+		{Name: "accessControl", Type: accessControlType},
+		{Name: "activeStaticPrecompiles", Type: mustNewType("address[]", nil)},
+		{Name: "allowedCosmosMessages", Type: mustNewType("string[]", nil)},
+		{Name: "allowedStargateQueries", Type: mustNewType("string[]", nil)},
+	}
+)
+
+// proposalMessageArgs maps the well-known type URLs to the ABI encoding of their messages.
+var proposalMessageArgs = map[string]abi.Arguments{
+	TypeURLCommunityPoolSpend:    communityPoolSpendArgs,
+	TypeURLSoftwareUpgrade:       softwareUpgradeArgs,
+	TypeURLERC20UpdateParams:     erc20ParamsArgs,
+	TypeURLFeeMarketUpdateParams: feeMarketParamsArgs,
+	TypeURLEVMUpdateParams:       evmParamsArgs,
+}
+
+// ProposalMessage defines a single proposal message as passed to the
+// submitProposalWithMessages method.
+type ProposalMessage struct {
+	TypeUrl string //nolint:revive
+	Value   []byte
+}
+
+// CommunityPoolSpendInput is the decoded form of communityPoolSpendArgs.
+type CommunityPoolSpendInput struct {
+	Recipient common.Address
+	Amount    []cmn.Coin
+}
+
+// SoftwareUpgradeInput is the decoded form of softwareUpgradeArgs.
+type SoftwareUpgradeInput struct {
+	Name   string
+	Height int64
+	Info   string
+}
+
+// ERC20ParamsInput is the decoded form of erc20ParamsArgs.
+type ERC20ParamsInput struct {
+	EnableErc20                bool
+	NativePrecompiles          []common.Address
+	DynamicPrecompiles         []common.Address
+	PermissionlessRegistration bool
+	AutoRegisterDenoms         bool
+}
+
+// FeeMarketParamsInput is the decoded form of feeMarketParamsArgs.
+type FeeMarketParamsInput struct {
+	NoBaseFee                bool
+	BaseFeeChangeDenominator uint32
+	ElasticityMultiplier     uint32
+	EnableHeight             int64
+	BaseFee                  *big.Int
+	MinGasPrice              *big.Int
+	MinGasMultiplier         *big.Int
+}
+
+// AccessControlTypeInput is the ABI representation of evmtypes.AccessControlType.
+type AccessControlTypeInput struct {
+	AccessType        uint8
+	AccessControlList []common.Address
+}
+
+// AccessControlInput is the ABI representation of evmtypes.AccessControl.
+type AccessControlInput struct {
+	Create AccessControlTypeInput
+	Call   AccessControlTypeInput
+}
+
+// EVMParamsInput is the decoded form of evmParamsArgs.
+type EVMParamsInput struct {
+	EvmDenom                string
+	ExtraEips               []int64
+	AllowUnprotectedTxs     bool
+	EvmChannels             []string
+	AccessControl           AccessControlInput
+	ActiveStaticPrecompiles []common.Address
+	AllowedCosmosMessages   []string
+	AllowedStargateQueries  []string
+}
+
+// NewProposalMsg decodes a proposal message into the corresponding Cosmos SDK message.
+// Messages of the well-known type URLs are expected to be ABI-encoded and have their
+// authority set to the governance module account. Any other registered message type is
+// decoded from its protobuf encoding.
+func NewProposalMsg(cdc codec.Codec, authority string, m ProposalMessage) (sdk.Msg, error) {
+	switch m.TypeUrl {
+	case TypeURLCommunityPoolSpend:
+		var input CommunityPoolSpendInput
+		if err := unpackMessage(communityPoolSpendArgs, &input, m); err != nil {
+			return nil, err
+		}
+		amount, err := cmn.NewSdkCoinsFromCoins(input.Amount)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidProposalMessage, m.TypeUrl, err)
+		}
+		return &distrtypes.MsgCommunityPoolSpend{
+			Authority: authority,
+			Recipient: sdk.AccAddress(input.Recipient.Bytes()).String(),
+			Amount:    amount,
+		}, nil
+
+	case TypeURLSoftwareUpgrade:
+		var input SoftwareUpgradeInput
+		if err := unpackMessage(softwareUpgradeArgs, &input, m); err != nil {
+			return nil, err
+		}
+		return &upgradetypes.MsgSoftwareUpgrade{
+			Authority: authority,
+			Plan: upgradetypes.Plan{
+				Name:   input.Name,
+				Height: input.Height,
+				Info:   input.Info,
+			},
+		}, nil
+
+	case TypeURLERC20UpdateParams:
+		var input ERC20ParamsInput
+		if err := unpackMessage(erc20ParamsArgs, &input, m); err != nil {
+			return nil, err
+		}
+		return &erc20types.MsgUpdateParams{
+			Authority: authority,
+			Params: erc20types.Params{
+				EnableErc20:                input.EnableErc20,
+				NativePrecompiles:          hexAddresses(input.NativePrecompiles),
+				DynamicPrecompiles:         hexAddresses(input.DynamicPrecompiles),
+				PermissionlessRegistration: input.PermissionlessRegistration,
+				AutoRegisterDenoms:         input.AutoRegisterDenoms,
+			},
+		}, nil
+
+	case TypeURLFeeMarketUpdateParams:
+		var input FeeMarketParamsInput
+		if err := unpackMessage(feeMarketParamsArgs, &input, m); err != nil {
+			return nil, err
+		}
+		return &feemarkettypes.MsgUpdateParams{
+			Authority: authority,
+			Params: feemarkettypes.Params{
+				NoBaseFee:                input.NoBaseFee,
+				BaseFeeChangeDenominator: input.BaseFeeChangeDenominator,
+				ElasticityMultiplier:     input.ElasticityMultiplier,
+				EnableHeight:             input.EnableHeight,
+				BaseFee:                  math.LegacyNewDecFromBigIntWithPrec(input.BaseFee, math.LegacyPrecision),
+				MinGasPrice:              math.LegacyNewDecFromBigIntWithPrec(input.MinGasPrice, math.LegacyPrecision),
+				MinGasMultiplier:         math.LegacyNewDecFromBigIntWithPrec(input.MinGasMultiplier, math.LegacyPrecision),
+			},
+		}, nil
+
+	case TypeURLEVMUpdateParams:
+		var input EVMParamsInput
+		if err := unpackMessage(evmParamsArgs, &input, m); err != nil {
+			return nil, err
+		}
+		return &evmtypes.MsgUpdateParams{
+			Authority: authority,
+			Params: evmtypes.Params{
+				EvmDenom:            input.EvmDenom,
+				ExtraEIPs:           input.ExtraEips,
+				AllowUnprotectedTxs: input.AllowUnprotectedTxs,
+				EVMChannels:         input.EvmChannels,
+				AccessControl: evmtypes.AccessControl{
+					Create: newAccessControlType(input.AccessControl.Create),
+					Call:   newAccessControlType(input.AccessControl.Call),
+				},
+				ActiveStaticPrecompiles: hexAddresses(input.ActiveStaticPrecompiles),
+				AllowedCosmosMessages:   input.AllowedCosmosMessages,
+				AllowedStargateQueries:  input.AllowedStargateQueries,
+			},
+		}, nil
+
+	default:
+		var msg sdk.Msg
+		if err := cdc.UnpackAny(&codectypes.Any{TypeUrl: m.TypeUrl, Value: m.Value}, &msg); err != nil {
+			return nil, fmt.Errorf(ErrInvalidProposalMessage, m.TypeUrl, err)
+		}
+		return msg, nil
+	}
+}
+
+// PackProposalMessage ABI-encodes the given values as a proposal message of one of the
+// well-known type URLs.
+func PackProposalMessage(typeURL string, values ...interface{}) (ProposalMessage, error) {
+	args, ok := proposalMessageArgs[typeURL]
+	if !ok {
+		return ProposalMessage{}, fmt.Errorf(ErrInvalidProposalMessage, typeURL, "no ABI encoding defined")
+	}
+	bz, err := args.Pack(values...)
+	if err != nil {
+		return ProposalMessage{}, fmt.Errorf(ErrInvalidProposalMessage, typeURL, err)
+	}
+	return ProposalMessage{TypeUrl: typeURL, Value: bz}, nil
+}
+
+// unpackMessage ABI-decodes the message value into the given struct.
+func unpackMessage(args abi.Arguments, v interface{}, m ProposalMessage) error {
+	values, err := args.Unpack(m.Value)
+	if err != nil {
+		return fmt.Errorf(ErrInvalidProposalMessage, m.TypeUrl, err)
+	}
+	if err := args.Copy(v, values); err != nil {
+		return fmt.Errorf(ErrInvalidProposalMessage, m.TypeUrl, err)
+	}
+	return nil
+}
+
+// newAccessControlType converts the ABI access control type into its x/vm representation.
+func newAccessControlType(input AccessControlTypeInput) evmtypes.AccessControlType {
+	return evmtypes.AccessControlType{
+		AccessType:        evmtypes.AccessType(input.AccessType),
+		AccessControlList: hexAddresses(input.AccessControlList),
+	}
+}
+
+// hexAddresses returns the hex representation of the given addresses.
+func hexAddresses(addrs []common.Address) []string {
+	res := make([]string, len(addrs))
+	for i, addr := range addrs {
+		res[i] = addr.Hex()
+	}
+	return res
+}
+
+// mustNewType creates a new ABI type and panics on failure.
+func mustNewType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
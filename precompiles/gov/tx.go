@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 
@@ -15,11 +16,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 const (
 	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
 	SubmitProposalMethod = "submitProposal"
+	// SubmitProposalWithMessagesMethod defines the ABI method name for the gov SubmitProposal
+	// transaction with ABI-encoded proposal messages.
+	SubmitProposalWithMessagesMethod = "submitProposalWithMessages"
 	// DepositMethod defines the ABI method name for the gov Deposit transaction.
 	DepositMethod = "deposit"
 	// DepositProposalMethod defines the ABI method name for the gov DepositProposal transaction.
@@ -43,6 +48,36 @@ func (p *Precompile) SubmitProposal(
 		return nil, err
 	}
 
+	return p.submitProposal(ctx, contract, stateDB, method, msg, proposerHexAddr)
+}
+
+// SubmitProposalWithMessages defines a method to submit a proposal whose messages
+// are ABI-encoded instead of protoJSON-encoded.
+func (p *Precompile) SubmitProposalWithMessages(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposalWithMessages(method, args, p.codec, p.govKeeper.GetAuthority())
+	if err != nil {
+		return nil, err
+	}
+
+	return p.submitProposal(ctx, contract, stateDB, method, msg, proposerHexAddr)
+}
+
+// submitProposal submits the proposal on behalf of the proposer, which must be the
+// caller of the precompile.
+func (p *Precompile) submitProposal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *govv1.MsgSubmitProposal,
+	proposerHexAddr common.Address,
+) ([]byte, error) {
 	msgSender := contract.Caller()
 	if msgSender != proposerHexAddr {
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
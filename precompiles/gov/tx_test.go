@@ -2,6 +2,7 @@ package gov_test
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -9,11 +10,18 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/gov"
 	"github.com/cosmos/evm/precompiles/testutil"
+	testconstants "github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func (s *PrecompileTestSuite) TestVote() {
@@ -277,3 +285,240 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSubmitProposalWithMessages() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalWithMessagesMethod]
+	proposal := gov.ProposalInput{Title: "Proposal", Summary: "testing proposal", Metadata: "metadata"}
+	deposit := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(100)}}
+	recipient := utiltx.GenerateAddress()
+
+	pack := func(typeURL string, values ...interface{}) gov.ProposalMessage {
+		m, err := gov.PackProposalMessage(typeURL, values...)
+		s.Require().NoError(err)
+		return m
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(msgs []sdk.Msg)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]sdk.Msg) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - different proposer",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					proposal,
+					[]gov.ProposalMessage{pack(gov.TypeURLSoftwareUpgrade, "v2", int64(1000), "")},
+					deposit,
+				}
+			},
+			func([]sdk.Msg) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), proposal, []gov.ProposalMessage{}, deposit}
+			},
+			func([]sdk.Msg) {},
+			true,
+			gov.ErrEmptyProposalMessages,
+		},
+		{
+			"fail - invalid message encoding",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposal,
+					[]gov.ProposalMessage{{TypeUrl: gov.TypeURLCommunityPoolSpend, Value: []byte{0x01}}},
+					deposit,
+				}
+			},
+			func([]sdk.Msg) {},
+			true,
+			"invalid proposal message",
+		},
+		{
+			"fail - unknown type url",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposal,
+					[]gov.ProposalMessage{{TypeUrl: "/cosmos.unknown.MsgUnknown", Value: []byte{}}},
+					deposit,
+				}
+			},
+			func([]sdk.Msg) {},
+			true,
+			"invalid proposal message",
+		},
+		{
+			"success - community pool spend",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposal,
+					[]gov.ProposalMessage{
+						pack(gov.TypeURLCommunityPoolSpend, recipient, []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(1000)}}),
+					},
+					deposit,
+				}
+			},
+			func(msgs []sdk.Msg) {
+				msg, ok := msgs[0].(*distrtypes.MsgCommunityPoolSpend)
+				s.Require().True(ok)
+				s.Require().Equal(s.network.App.GovKeeper.GetAuthority(), msg.Authority)
+				s.Require().Equal(sdk.AccAddress(recipient.Bytes()).String(), msg.Recipient)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, math.NewInt(1000))), msg.Amount)
+			},
+			false,
+			"",
+		},
+		{
+			"success - erc20 and feemarket params update",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposal,
+					[]gov.ProposalMessage{
+						pack(gov.TypeURLERC20UpdateParams, true, []common.Address{}, []common.Address{}, false, true),
+						pack(
+							gov.TypeURLFeeMarketUpdateParams,
+							false, uint32(8), uint32(2), int64(0),
+							big.NewInt(1e9), big.NewInt(5e17), big.NewInt(5e17),
+						),
+					},
+					deposit,
+				}
+			},
+			func(msgs []sdk.Msg) {
+				erc20Msg, ok := msgs[0].(*erc20types.MsgUpdateParams)
+				s.Require().True(ok)
+				s.Require().True(erc20Msg.Params.EnableErc20)
+				s.Require().False(erc20Msg.Params.PermissionlessRegistration)
+				s.Require().True(erc20Msg.Params.AutoRegisterDenoms)
+
+				feeMarketMsg, ok := msgs[1].(*feemarkettypes.MsgUpdateParams)
+				s.Require().True(ok)
+				s.Require().Equal(uint32(8), feeMarketMsg.Params.BaseFeeChangeDenominator)
+				s.Require().Equal(math.LegacyNewDecWithPrec(5, 1), feeMarketMsg.Params.MinGasPrice)
+				s.Require().Equal(math.LegacyNewDecWithPrec(1, 9), feeMarketMsg.Params.BaseFee)
+			},
+			false,
+			"",
+		},
+		{
+			"success - vm params update and software upgrade",
+			func() []interface{} {
+				accessControl := gov.AccessControlInput{
+					Create: gov.AccessControlTypeInput{AccessType: uint8(evmtypes.AccessTypeRestricted), AccessControlList: []common.Address{}},
+					Call:   gov.AccessControlTypeInput{AccessType: uint8(evmtypes.AccessTypePermissioned), AccessControlList: []common.Address{recipient}},
+				}
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposal,
+					[]gov.ProposalMessage{
+						pack(
+							gov.TypeURLEVMUpdateParams,
+							testconstants.ExampleAttoDenom, []int64{}, false, []string{"channel-0"},
+							accessControl, []common.Address{s.precompile.Address()},
+							[]string{"/cosmos.bank.v1beta1.MsgSend"},
+							[]string{"/cosmos.mint.v1beta1.Query/Inflation"},
+						),
+						pack(gov.TypeURLSoftwareUpgrade, "v2", int64(1000), "info"),
+					},
+					deposit,
+				}
+			},
+			func(msgs []sdk.Msg) {
+				evmMsg, ok := msgs[0].(*evmtypes.MsgUpdateParams)
+				s.Require().True(ok)
+				s.Require().Equal([]string{"channel-0"}, evmMsg.Params.EVMChannels)
+				s.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, evmMsg.Params.AllowedCosmosMessages)
+				s.Require().Equal([]string{"/cosmos.mint.v1beta1.Query/Inflation"}, evmMsg.Params.AllowedStargateQueries)
+				s.Require().Equal(evmtypes.AccessTypeRestricted, evmMsg.Params.AccessControl.Create.AccessType)
+				s.Require().Equal(evmtypes.AccessTypePermissioned, evmMsg.Params.AccessControl.Call.AccessType)
+				s.Require().Equal([]string{recipient.Hex()}, evmMsg.Params.AccessControl.Call.AccessControlList)
+				s.Require().Equal([]string{s.precompile.Address().Hex()}, evmMsg.Params.ActiveStaticPrecompiles)
+
+				upgradeMsg, ok := msgs[1].(*upgradetypes.MsgSoftwareUpgrade)
+				s.Require().True(ok)
+				s.Require().Equal("v2", upgradeMsg.Plan.Name)
+				s.Require().Equal(int64(1000), upgradeMsg.Plan.Height)
+			},
+			false,
+			"",
+		},
+		{
+			"success - protobuf encoded message",
+			func() []interface{} {
+				send := &banktypes.MsgSend{
+					FromAddress: s.network.App.GovKeeper.GetAuthority(),
+					ToAddress:   sdk.AccAddress(recipient.Bytes()).String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, math.NewInt(1))),
+				}
+				bz, err := send.Marshal()
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposal,
+					[]gov.ProposalMessage{{TypeUrl: sdk.MsgTypeURL(send), Value: bz}},
+					deposit,
+				}
+			},
+			func(msgs []sdk.Msg) {
+				msg, ok := msgs[0].(*banktypes.MsgSend)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.AccAddress(recipient.Bytes()).String(), msg.ToAddress)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 2_000_000)
+
+			bz, err := s.precompile.SubmitProposalWithMessages(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			proposalID, ok := out[0].(uint64)
+			s.Require().True(ok)
+
+			prop, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+			s.Require().NoError(err)
+			s.Require().Equal(proposal.Title, prop.Title)
+			s.Require().Equal(sdk.AccAddress(s.keyring.GetAddr(0).Bytes()).String(), prop.Proposer)
+
+			msgs, err := prop.GetMsgs()
+			s.Require().NoError(err)
+			tc.postCheck(msgs)
+		})
+	}
+}
@@ -168,28 +168,101 @@ func NewMsgSubmitProposal(args []interface{}, cdc codec.Codec) (*govv1.MsgSubmit
 		msgs[i] = msg
 	}
 
-	// 3. Pack into Any
+	// 3. Build MsgSubmitProposal
+	smsg, err := newMsgSubmitProposal(proposer, msgs, amt, ProposalInput{
+		Title:     prop.Title,
+		Summary:   prop.Summary,
+		Metadata:  prop.Metadata,
+		Expedited: prop.Expedited,
+	})
+	if err != nil {
+		return nil, emptyAddr, err
+	}
+
+	return smsg, proposer, nil
+}
+
+// ProposalInput defines the proposal fields of the submitProposalWithMessages method.
+type ProposalInput struct {
+	Title     string
+	Summary   string
+	Metadata  string
+	Expedited bool
+}
+
+// SubmitProposalWithMessagesInput defines the input for the submitProposalWithMessages method.
+type SubmitProposalWithMessagesInput struct {
+	Proposer common.Address
+	Proposal ProposalInput
+	Messages []ProposalMessage
+	Deposit  []cmn.Coin
+}
+
+// NewMsgSubmitProposalWithMessages constructs a MsgSubmitProposal from ABI-encoded
+// proposal messages. The authority of the well-known message types is set to the
+// given governance module address.
+// args: [proposer, ProposalInput proposal, []ProposalMessage messages, []cmn.Coin deposit]
+func NewMsgSubmitProposalWithMessages(method *abi.Method, args []interface{}, cdc codec.Codec, authority string) (*govv1.MsgSubmitProposal, common.Address, error) {
+	emptyAddr := common.Address{}
+	if len(args) != 4 {
+		return nil, emptyAddr, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input SubmitProposalWithMessagesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, emptyAddr, fmt.Errorf("error while unpacking args to SubmitProposalWithMessagesInput struct: %s", err)
+	}
+
+	if input.Proposer == emptyAddr {
+		return nil, emptyAddr, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	if len(input.Messages) == 0 {
+		return nil, emptyAddr, fmt.Errorf(ErrEmptyProposalMessages)
+	}
+
+	amt, err := cmn.NewSdkCoinsFromCoins(input.Deposit)
+	if err != nil {
+		return nil, emptyAddr, fmt.Errorf(ErrInvalidDeposits, "deposit arg")
+	}
+
+	msgs := make([]sdk.Msg, len(input.Messages))
+	for i, m := range input.Messages {
+		msg, err := NewProposalMsg(cdc, authority, m)
+		if err != nil {
+			return nil, emptyAddr, sdkerrors.Wrapf(err, "message %d", i)
+		}
+		msgs[i] = msg
+	}
+
+	smsg, err := newMsgSubmitProposal(input.Proposer, msgs, amt, input.Proposal)
+	if err != nil {
+		return nil, emptyAddr, err
+	}
+
+	return smsg, input.Proposer, nil
+}
+
+// newMsgSubmitProposal packs the messages into Any and builds the MsgSubmitProposal.
+func newMsgSubmitProposal(proposer common.Address, msgs []sdk.Msg, deposit sdk.Coins, prop ProposalInput) (*govv1.MsgSubmitProposal, error) {
 	anys := make([]*codectypes.Any, len(msgs))
 	for i, m := range msgs {
 		anyVal, err := codectypes.NewAnyWithValue(m)
 		if err != nil {
-			return nil, common.Address{}, err
+			return nil, err
 		}
 		anys[i] = anyVal
 	}
 
-	// 4. Build & dispatch MsgSubmitProposal
-	smsg := &govv1.MsgSubmitProposal{
+	return &govv1.MsgSubmitProposal{
 		Messages:       anys,
-		InitialDeposit: amt,
+		InitialDeposit: deposit,
 		Proposer:       sdk.AccAddress(proposer.Bytes()).String(),
 		Metadata:       prop.Metadata,
 		Title:          prop.Title,
 		Summary:        prop.Summary,
 		Expedited:      prop.Expedited,
-	}
-
-	return smsg, proposer, nil
+	}, nil
 }
 
 // NewMsgDeposit constructs a MsgDeposit.
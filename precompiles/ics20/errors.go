@@ -21,4 +21,6 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrDenomNotFound is raised when the denom for the specified request does not exist.
 	ErrDenomNotFound = "denomination not found"
+	// ErrNoERC20Precompile is raised when the denom of a transferFrom request is not a native coin with an ERC-20 precompile.
+	ErrNoERC20Precompile = "transferFrom is only supported for native coins with an ERC-20 precompile; got denom: %s"
 )
@@ -1,6 +1,8 @@
 package ics20
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -60,3 +62,45 @@ func EmitIBCTransferEvent(
 
 	return nil
 }
+
+// EmitApprovalEvent creates a new ERC-20 Approval event on behalf of the given
+// ERC-20 precompile, emitted when an allowance is spent on a TransferFrom transaction.
+func EmitApprovalEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	event abi.Event,
+	erc20Addr, owner, spender common.Address,
+	value *big.Int,
+) error {
+	// Prepare the event topics
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	// owner and spender are indexed
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(spender)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(value)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     erc20Addr,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
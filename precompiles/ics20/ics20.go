@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -36,6 +37,10 @@ type Precompile struct {
 	transferKeeper transferkeeper.Keeper
 	channelKeeper  *channelkeeper.Keeper
 	evmKeeper      *evmkeeper.Keeper
+	erc20Keeper    Erc20Keeper
+	// erc20ABI is used to emit the ERC-20 Approval event when an allowance
+	// is spent through the transferFrom method.
+	erc20ABI abi.ABI
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -45,12 +50,18 @@ func NewPrecompile(
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
+	erc20Keeper Erc20Keeper,
 ) (*Precompile, error) {
 	newAbi, err := cmn.LoadABI(f, "abi.json")
 	if err != nil {
 		return nil, err
 	}
 
+	erc20ABI, err := erc20.LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
 	p := &Precompile{
 		Precompile: cmn.Precompile{
 			ABI:                  newAbi,
@@ -61,6 +72,8 @@ func NewPrecompile(
 		channelKeeper:  channelKeeper,
 		stakingKeeper:  stakingKeeper,
 		evmKeeper:      evmKeeper,
+		erc20Keeper:    erc20Keeper,
+		erc20ABI:       erc20ABI,
 	}
 
 	// SetAddress defines the address of the ICS-20 compile contract.
@@ -103,6 +116,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		// ICS20 transactions
 		case TransferMethod:
 			bz, err = p.Transfer(ctx, contract, stateDB, method, args)
+		case TransferFromMethod:
+			bz, err = p.TransferFrom(ctx, contract, stateDB, method, args)
 		// ICS20 queries
 		case DenomMethod:
 			bz, err = p.Denom(ctx, contract, method, args)
@@ -136,9 +151,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferFrom
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case TransferMethod:
+	case TransferMethod, TransferFromMethod:
 		return true
 	default:
 		return false
@@ -2,13 +2,16 @@ package ics20
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
 	"github.com/cosmos/evm/utils"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -23,6 +26,9 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferFromMethod defines the ABI method name for the ICS20 TransferFrom
+	// transaction.
+	TransferFromMethod = "transferFrom"
 )
 
 // Transfer implements the ICS20 transfer transactions.
@@ -38,12 +44,100 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
+	if err := p.validateSourceChannel(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != sender {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
+	}
+
+	res, err := p.transfer(ctx, stateDB, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	p.registerPacketCallback(ctx, stateDB, msg, res.Sequence, msgSender)
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// TransferFrom implements the ICS20 transfer transaction on behalf of the given
+// sender. Instead of requiring the caller to be the sender, it spends the ERC-20
+// allowance that the sender has granted to the caller on the ERC-20 precompile
+// of the transferred denomination, in the same way as the ERC-20 transferFrom.
+func (p *Precompile) TransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, err := NewMsgTransfer(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.validateSourceChannel(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	spender := contract.Caller()
+	erc20Addr, newAllowance, err := p.spendAllowance(ctx, msg.Token, sender, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.transfer(ctx, stateDB, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	p.registerPacketCallback(ctx, stateDB, msg, res.Sequence, spender)
+
+	if err = EmitApprovalEvent(
+		ctx,
+		stateDB,
+		p.erc20ABI.Events[erc20.EventTypeApproval],
+		erc20Addr,
+		sender,
+		spender,
+		newAllowance,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// registerPacketCallback registers the caller to be called back on the
+// acknowledgement or timeout of the sent packet if the caller is a contract.
+// The source channel is the client ID of v2 packets, both are executed by the
+// erc20 IBC middleware of their version.
+func (p *Precompile) registerPacketCallback(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *transfertypes.MsgTransfer,
+	sequence uint64,
+	caller common.Address,
+) {
+	if stateDB.GetCodeSize(caller) == 0 {
+		return
+	}
+
+	p.erc20Keeper.SetPacketCallback(ctx, msg.SourcePort, msg.SourceChannel, sequence, caller)
+}
+
+// validateSourceChannel checks that the channel of a v1 packet exists or that
+// the client ID of a v2 packet is valid.
+func (p *Precompile) validateSourceChannel(ctx sdk.Context, msg *transfertypes.MsgTransfer) error {
 	// If the channel is in v1 format, check if channel exists and is open
 	if channeltypes.IsChannelIDFormat(msg.SourceChannel) {
 		// check if channel exists and is open
 		hasV1Channel := p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel)
 		if !hasV1Channel {
-			return nil, errorsmod.Wrapf(
+			return errorsmod.Wrapf(
 				channeltypes.ErrChannelNotFound,
 				"port ID (%s) channel ID (%s)",
 				msg.SourcePort,
@@ -52,18 +146,69 @@ func (p *Precompile) Transfer(
 		}
 		// otherwise, it’s a v2 packet, so perform client ID validation
 	} else if v2ClientIDErr := host.ClientIdentifierValidator(msg.SourceChannel); v2ClientIDErr != nil {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			channeltypes.ErrInvalidChannel,
 			"invalid channel ID (%s) on v2 packet",
 			msg.SourceChannel,
 		)
 	}
 
-	msgSender := contract.Caller()
-	if msgSender != sender {
-		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
+	return nil
+}
+
+// spendAllowance decreases the allowance of the spender over the sender's tokens
+// on the ERC-20 precompile of the given coin by the coin amount. It returns the
+// address of the ERC-20 precompile and the remaining allowance.
+func (p *Precompile) spendAllowance(
+	ctx sdk.Context,
+	token sdk.Coin,
+	owner, spender common.Address,
+) (common.Address, *big.Int, error) {
+	tokenPairID := p.erc20Keeper.GetTokenPairID(ctx, token.Denom)
+	tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, tokenPairID)
+	if !found {
+		return common.Address{}, nil, errorsmod.Wrapf(
+			erc20types.ErrTokenPairNotFound, "token pair for denom '%s' not registered", token.Denom,
+		)
+	}
+
+	// NOTE: allowances are only stored in x/erc20 for the ERC-20 precompiles of native coins.
+	// The allowances of native ERC-20 contracts are part of the contract storage instead.
+	if !tokenPair.IsNativeCoin() {
+		return common.Address{}, nil, fmt.Errorf(ErrNoERC20Precompile, token.Denom)
+	}
+
+	erc20Addr := tokenPair.GetERC20Contract()
+	allowance, err := p.erc20Keeper.GetAllowance(ctx, erc20Addr, owner, spender)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	newAllowance := new(big.Int).Sub(allowance, token.Amount.BigInt())
+	if newAllowance.Sign() < 0 {
+		return common.Address{}, nil, erc20.ErrInsufficientAllowance
 	}
 
+	if newAllowance.Sign() == 0 {
+		err = p.erc20Keeper.DeleteAllowance(ctx, erc20Addr, owner, spender)
+	} else {
+		err = p.erc20Keeper.SetAllowance(ctx, erc20Addr, owner, spender, newAllowance)
+	}
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return erc20Addr, newAllowance, nil
+}
+
+// transfer executes the ICS20 transfer of the given message and emits the
+// IBCTransfer event.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) (*transfertypes.MsgTransferResponse, error) {
 	res, err := p.transferKeeper.Transfer(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -103,5 +248,5 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
-	return method.Outputs.Pack(res.Sequence)
+	return res, nil
 }
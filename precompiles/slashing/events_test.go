@@ -1,6 +1,7 @@
 package slashing_test
 
 import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -9,6 +10,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/slashing"
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -99,3 +101,17 @@ func (s *PrecompileTestSuite) TestUnjailEvent() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSystemLogEvents() {
+	// the system logs emitted by the EVM module must be decodable with the slashing ABI
+	for _, event := range []abi.Event{
+		evmtypes.ValidatorSlashedEvent,
+		evmtypes.ValidatorJailedEvent,
+		evmtypes.UnbondingCompletedEvent,
+	} {
+		abiEvent, ok := s.precompile.Events[event.Name]
+		s.Require().True(ok, "event %s not found in the slashing ABI", event.Name)
+		s.Require().Equal(event.ID, abiEvent.ID, "event %s", event.Name)
+	}
+	s.Require().Equal(s.precompile.Address(), evmtypes.SystemLogsAddress)
+}
@@ -115,6 +115,13 @@ struct UnbondingDelegationOutput {
     UnbondingDelegationEntry[] entries;
 }
 
+/// @dev Represents a delegation of a delegator to a validator in the DelegatorDelegations query.
+struct DelegationResponse {
+    string validatorAddress;
+    uint256 shares;
+    Coin balance;
+}
+
 /// @dev The status of the validator.
 enum BondStatus {
     Unspecified,
@@ -216,6 +223,32 @@ interface StakingI {
         uint256 creationHeight
     ) external returns (bool success);
 
+    /// @dev Defines a method for performing delegations of coins from a delegator to multiple validators.
+    /// All the delegations are executed atomically and a batch holds at most 500 entries.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators
+    /// @param amounts The amounts of the bond denomination to be delegated to each validator.
+    /// These amounts should use the bond denomination precision stored in the bank metadata.
+    /// @return success Whether or not the delegations were successful
+    function delegateBatch(
+        address delegatorAddress,
+        string[] memory validatorAddresses,
+        uint256[] memory amounts
+    ) external returns (bool success);
+
+    /// @dev Defines a method for performing undelegations of a delegator from multiple validators.
+    /// All the undelegations are executed atomically and a batch holds at most 500 entries.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators
+    /// @param amounts The amounts of the bond denomination to be undelegated from each validator.
+    /// These amounts should use the bond denomination precision stored in the bank metadata.
+    /// @return completionTimes The times when each undelegation is completed
+    function undelegateBatch(
+        address delegatorAddress,
+        string[] memory validatorAddresses,
+        uint256[] memory amounts
+    ) external returns (int64[] memory completionTimes);
+
     /// @dev Queries the given amount of the bond denomination to a validator.
     /// @param delegatorAddress The address of the delegator.
     /// @param validatorAddress The address of the validator.
@@ -240,6 +273,36 @@ interface StakingI {
         view
         returns (UnbondingDelegationOutput calldata unbondingDelegation);
 
+    /// @dev Queries all the delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return response The delegations of the delegator.
+    function delegatorDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegationResponse[] calldata response,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries all the unbonding delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return response The unbonding delegations of the delegator.
+    function delegatorUnbondingDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            UnbondingDelegationOutput[] calldata response,
+            PageResponse calldata pageResponse
+        );
+
     /// @dev Queries validator info for a given validator address.
     /// @param validatorAddress The address of the validator.
     /// @return validator The validator info for the given validator address.
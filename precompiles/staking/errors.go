@@ -7,4 +7,12 @@ const (
 	ErrDifferentOriginFromValidator = "origin address %s is not the same as validator operator address %s"
 	// ErrCannotCallFromContract is raised when a function cannot be called from a smart contract.
 	ErrCannotCallFromContract = "this method can only be called directly to the precompile, not from a smart contract"
+	// ErrEmptyBatch is raised when a batch transaction contains no entries.
+	ErrEmptyBatch = "batch must contain at least one entry"
+	// ErrBatchLengthMismatch is raised when the validator addresses and amounts of a batch transaction have different lengths.
+	ErrBatchLengthMismatch = "mismatched batch lengths: %d validator addresses and %d amounts"
+	// ErrBatchTooLong is raised when a batch transaction contains more entries than allowed.
+	ErrBatchTooLong = "batch cannot contain more than %d entries, got %d"
+	// ErrBatchAmountOverflow is raised when the total amount of a batch transaction overflows.
+	ErrBatchAmountOverflow = "batch total amount overflows"
 )
@@ -33,6 +33,12 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegatorDelegationsMethod defines the ABI method name for the staking
+	// DelegatorDelegations query.
+	DelegatorDelegationsMethod = "delegatorDelegations"
+	// DelegatorUnbondingDelegationsMethod defines the ABI method name for the
+	// staking DelegatorUnbondingDelegations query.
+	DelegatorUnbondingDelegationsMethod = "delegatorUnbondingDelegations"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -98,6 +104,55 @@ func (p Precompile) UnbondingDelegation(
 	return method.Outputs.Pack(out.UnbondingDelegation)
 }
 
+// DelegatorDelegations returns all the delegations of a delegator with pagination.
+func (p Precompile) DelegatorDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.DelegatorDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegatorDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// DelegatorUnbondingDelegations returns all the unbonding delegations of a
+// delegator with pagination.
+func (p Precompile) DelegatorUnbondingDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorUnbondingDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.DelegatorUnbondingDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegatorUnbondingDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
 // Validator returns the validator information for a given validator address.
 func (p Precompile) Validator(
 	ctx sdk.Context,
@@ -701,3 +701,183 @@ func (s *PrecompileTestSuite) TestRedelegations() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegatorDelegations() {
+	method := s.precompile.Methods[staking.DelegatorDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid delegator address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}),
+		},
+		{
+			"success - no delegations",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{addr, query.PageRequest{}}
+			},
+			func(data []byte) {
+				var out staking.DelegatorDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(out.Response)
+			},
+			false,
+			"",
+		},
+		{
+			"success - all delegations",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}}
+			},
+			func(data []byte) {
+				var out staking.DelegatorDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Response, len(s.network.GetValidators()))
+				s.Require().Equal(len(s.network.GetValidators()), int(out.PageResponse.Total)) //nolint:gosec
+				for _, resp := range out.Response {
+					s.Require().Equal(big.NewInt(1e18), resp.Balance.Amount)
+					s.Require().Equal(s.bondDenom, resp.Balance.Denom)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - pagination",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Key: []byte{0}, Limit: 1, CountTotal: true}}
+			},
+			func(data []byte) {
+				var out staking.DelegatorDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Response, 1)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+			bz, err := s.precompile.DelegatorDelegations(s.network.GetContext(), &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelegatorUnbondingDelegations() {
+	method := s.precompile.Methods[staking.DelegatorUnbondingDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - no unbonding delegations",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{addr, query.PageRequest{}}
+			},
+			func(data []byte) {
+				var out staking.DelegatorUnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(out.Response)
+			},
+			false,
+			"",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}}
+			},
+			func(data []byte) {
+				var out staking.DelegatorUnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Response, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, ubd := range out.Response {
+					s.Require().Equal(s.keyring.GetAccAddr(0).String(), ubd.DelegatorAddress)
+					s.Require().Len(ubd.Entries, 1)
+					s.Require().Equal(big.NewInt(1e18), ubd.Entries[0].Balance)
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+			for _, val := range s.network.GetValidators()[:2] {
+				valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+				s.Require().NoError(err)
+				_, _, err = s.network.App.StakingKeeper.Undelegate(s.network.GetContext(), s.keyring.GetAddr(0).Bytes(), valAddr, math.LegacyNewDec(1))
+				s.Require().NoError(err)
+			}
+
+			bz, err := s.precompile.DelegatorUnbondingDelegations(s.network.GetContext(), &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
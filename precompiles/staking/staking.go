@@ -105,6 +105,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			bz, err = p.Redelegate(ctx, contract, stateDB, method, args)
 		case CancelUnbondingDelegationMethod:
 			bz, err = p.CancelUnbondingDelegation(ctx, contract, stateDB, method, args)
+		case DelegateBatchMethod:
+			bz, err = p.DelegateBatch(ctx, contract, stateDB, method, args)
+		case UndelegateBatchMethod:
+			bz, err = p.UndelegateBatch(ctx, contract, stateDB, method, args)
 		// Staking queries
 		case DelegationMethod:
 			bz, err = p.Delegation(ctx, contract, method, args)
@@ -118,6 +122,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			bz, err = p.Redelegation(ctx, method, contract, args)
 		case RedelegationsMethod:
 			bz, err = p.Redelegations(ctx, method, contract, args)
+		case DelegatorDelegationsMethod:
+			bz, err = p.DelegatorDelegations(ctx, method, contract, args)
+		case DelegatorUnbondingDelegationsMethod:
+			bz, err = p.DelegatorUnbondingDelegations(ctx, method, contract, args)
 		}
 
 		if err != nil {
@@ -147,6 +155,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - DelegateBatch
+//   - UndelegateBatch
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case CreateValidatorMethod,
@@ -154,7 +164,9 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		DelegateMethod,
 		UndelegateMethod,
 		RedelegateMethod,
-		CancelUnbondingDelegationMethod:
+		CancelUnbondingDelegationMethod,
+		DelegateBatchMethod,
+		UndelegateBatchMethod:
 		return true
 	default:
 		return false
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/utils"
@@ -13,6 +15,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -32,6 +35,12 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// DelegateBatchMethod defines the ABI method name for the staking
+	// DelegateBatch transaction.
+	DelegateBatchMethod = "delegateBatch"
+	// UndelegateBatchMethod defines the ABI method name for the staking
+	// UndelegateBatch transaction.
+	UndelegateBatchMethod = "undelegateBatch"
 )
 
 // CreateValidator performs create validator.
@@ -164,31 +173,47 @@ func (p *Precompile) Delegate(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	scaledAmt, err := p.delegate(ctx, stateDB, msg, delegatorHexAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !scaledAmt.IsZero() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+		// when calling the precompile from a smart contract
+		// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(delegatorHexAddr, scaledAmt, cmn.Sub))
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// delegate executes the given delegation message and emits the corresponding
+// event. It returns the amount delegated from the EVM balance of the delegator,
+// scaled to 18 decimals, which is zero if the delegated coin is not the EVM coin.
+func (p *Precompile) delegate(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *stakingtypes.MsgDelegate,
+	delegatorHexAddr common.Address,
+) (*uint256.Int, error) {
 	// Execute the transaction using the message server
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
-	if _, err = msgSrv.Delegate(ctx, msg); err != nil {
+	if _, err := msgSrv.Delegate(ctx, msg); err != nil {
 		return nil, err
 	}
 
 	// Emit the event for the delegate transaction
-	if err = p.EmitDelegateEvent(ctx, stateDB, msg, delegatorHexAddr); err != nil {
+	if err := p.EmitDelegateEvent(ctx, stateDB, msg, delegatorHexAddr); err != nil {
 		return nil, err
 	}
 
-	if msg.Amount.Denom == evmtypes.GetEVMCoinDenom() {
-		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
-		// when calling the precompile from a smart contract
-		// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
-
-		// Need to scale the amount to 18 decimals for the EVM balance change entry
-		scaledAmt, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(msg.Amount.Amount.BigInt()))
-		if err != nil {
-			return nil, err
-		}
-		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(delegatorHexAddr, scaledAmt, cmn.Sub))
+	if msg.Amount.Denom != evmtypes.GetEVMCoinDenom() {
+		return new(uint256.Int), nil
 	}
 
-	return method.Outputs.Pack(true)
+	// Need to scale the amount to 18 decimals for the EVM balance change entry
+	return utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(msg.Amount.Amount.BigInt()))
 }
 
 // Undelegate performs the undelegation of coins from a validator for a delegate.
@@ -225,19 +250,37 @@ func (p Precompile) Undelegate(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	completionTime, err := p.undelegate(ctx, stateDB, msg, delegatorHexAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// undelegate executes the given undelegation message and emits the
+// corresponding event. It returns the completion time of the undelegation.
+func (p Precompile) undelegate(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *stakingtypes.MsgUndelegate,
+	delegatorHexAddr common.Address,
+) (int64, error) {
 	// Execute the transaction using the message server
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	res, err := msgSrv.Undelegate(ctx, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
+	completionTime := res.CompletionTime.UTC().Unix()
+
 	// Emit the event for the undelegate transaction
-	if err = p.EmitUnbondEvent(ctx, stateDB, msg, delegatorHexAddr, res.CompletionTime.UTC().Unix()); err != nil {
-		return nil, err
+	if err = p.EmitUnbondEvent(ctx, stateDB, msg, delegatorHexAddr, completionTime); err != nil {
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.CompletionTime.UTC().Unix())
+	return completionTime, nil
 }
 
 // Redelegate performs a redelegation of coins for a delegate from a source validator
@@ -336,3 +379,102 @@ func (p Precompile) CancelUnbondingDelegation(
 
 	return method.Outputs.Pack(true)
 }
+
+// DelegateBatch performs the delegation of coins from a delegator to multiple
+// validators in a single transaction. The delegations are executed atomically,
+// so that the transaction fails if any of them fails.
+func (p *Precompile) DelegateBatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, delegatorHexAddr, err := NewMsgDelegateBatch(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, entries: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
+	}
+
+	// the balance change entries replace each other, so the delegated amounts
+	// are mirrored to the EVM stateDB as a single entry
+	totalAmt := new(uint256.Int)
+	for _, msg := range msgs {
+		scaledAmt, err := p.delegate(ctx, stateDB, msg, delegatorHexAddr)
+		if err != nil {
+			return nil, err
+		}
+		if _, overflow := totalAmt.AddOverflow(totalAmt, scaledAmt); overflow {
+			return nil, errors.New(ErrBatchAmountOverflow)
+		}
+	}
+
+	if !totalAmt.IsZero() {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(delegatorHexAddr, totalAmt, cmn.Sub))
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UndelegateBatch performs the undelegation of coins of a delegator from
+// multiple validators in a single transaction. It returns the completion time
+// of each undelegation.
+func (p Precompile) UndelegateBatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, delegatorHexAddr, err := NewMsgUndelegateBatch(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, entries: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
+	}
+
+	completionTimes := make([]int64, len(msgs))
+	for i, msg := range msgs {
+		completionTimes[i], err = p.undelegate(ctx, stateDB, msg, delegatorHexAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(completionTimes)
+}
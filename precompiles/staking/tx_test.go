@@ -15,6 +15,7 @@ import (
 	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
 	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
 
@@ -1322,3 +1323,275 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegateBatch() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[staking.DelegateBatchMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, operatorAddresses []string) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(testkeyring.Key, []string) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty batch",
+			func(delegator testkeyring.Key, _ []string) []interface{} {
+				return []interface{}{delegator.Addr, []string{}, []*big.Int{}}
+			},
+			true,
+			staking.ErrEmptyBatch,
+		},
+		{
+			"fail - mismatched lengths",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{delegator.Addr, operatorAddresses, []*big.Int{big.NewInt(1e18)}}
+			},
+			true,
+			fmt.Sprintf(staking.ErrBatchLengthMismatch, 2, 1),
+		},
+		{
+			"fail - batch too long",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				validators := make([]string, staking.MaxBatchLength+1)
+				amounts := make([]*big.Int, staking.MaxBatchLength+1)
+				for i := range validators {
+					validators[i] = operatorAddresses[i%len(operatorAddresses)]
+					amounts[i] = big.NewInt(1e18)
+				}
+				return []interface{}{delegator.Addr, validators, amounts}
+			},
+			true,
+			fmt.Sprintf(staking.ErrBatchTooLong, staking.MaxBatchLength, staking.MaxBatchLength+1),
+		},
+		{
+			"fail - different origin than delegator",
+			func(_ testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					cosmosevmutiltx.GenerateAddress(),
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), big.NewInt(1e18)},
+				}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - one of the delegations fails",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				amt, ok := math.NewIntFromString("1000000000000000000000000000")
+				s.Require().True(ok)
+				return []interface{}{
+					delegator.Addr,
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), amt.BigInt()},
+				}
+			},
+			true,
+			"insufficient funds",
+		},
+		{
+			"success",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), big.NewInt(2e18)},
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			validators := s.network.GetValidators()[:2]
+			operatorAddresses := []string{validators[0].OperatorAddress, validators[1].OperatorAddress}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 1_000_000)
+			// load the delegator balance into the stateDB before delegating
+			initialBalance := stDB.GetBalance(delegator.Addr)
+			s.Require().False(initialBalance.IsZero())
+
+			bz, err := s.precompile.DelegateBatch(ctx, contract, stDB, &method, tc.malleate(delegator, operatorAddresses))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			success, err := s.precompile.Unpack(staking.DelegateBatchMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// one event is emitted per delegation
+			s.Require().Len(stDB.Logs(), 2)
+			event := s.precompile.ABI.Events[staking.EventTypeDelegate]
+			for _, log := range stDB.Logs() {
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), log.Topics[0])
+			}
+
+			for i, expShares := range []int64{2, 3} {
+				valAddr, err := sdk.ValAddressFromBech32(validators[i].OperatorAddress)
+				s.Require().NoError(err)
+				delegation, err := s.network.App.StakingKeeper.Delegation(ctx, delegator.AccAddr, valAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(expShares), delegation.GetShares().TruncateInt())
+			}
+
+			// the EVM state balance reflects every delegation of the batch
+			s.Require().NoError(s.precompile.AddJournalEntries(stDB, cmn.Snapshot{MultiStore: ctx.MultiStore().CacheMultiStore()}))
+			bankBal := s.network.App.BankKeeper.GetBalance(ctx, delegator.AccAddr, evmtypes.GetEVMCoinDenom())
+			s.Require().Equal(bankBal.Amount.BigInt(), stDB.GetBalance(delegator.Addr).ToBig())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelegateBatchMaxLength() {
+	method := s.precompile.Methods[staking.DelegateBatchMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	stDB := s.network.GetStateDB()
+
+	delegator := s.keyring.GetKey(0)
+	validators := s.network.GetValidators()[:2]
+
+	operatorAddresses := make([]string, staking.MaxBatchLength)
+	amounts := make([]*big.Int, staking.MaxBatchLength)
+	for i := range operatorAddresses {
+		operatorAddresses[i] = validators[i%len(validators)].OperatorAddress
+		amounts[i] = big.NewInt(1e15)
+	}
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 1_000_000)
+	bz, err := s.precompile.DelegateBatch(ctx, contract, stDB, &method, []interface{}{delegator.Addr, operatorAddresses, amounts})
+	s.Require().NoError(err)
+	success, err := s.precompile.Unpack(staking.DelegateBatchMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(true, success[0])
+
+	// one event is emitted per delegation
+	s.Require().Len(stDB.Logs(), staking.MaxBatchLength)
+	for _, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		s.Require().NoError(err)
+		delegation, err := s.network.App.StakingKeeper.Delegation(ctx, delegator.AccAddr, valAddr)
+		s.Require().NoError(err)
+		// each validator receives half of the batch, on top of the share of the network setup
+		expShares := math.LegacyOneDec().Add(math.LegacyNewDecWithPrec(staking.MaxBatchLength/2, 3))
+		s.Require().Equal(expShares, delegation.GetShares())
+	}
+}
+
+func (s *PrecompileTestSuite) TestUndelegateBatch() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[staking.UndelegateBatchMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, operatorAddresses []string) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(testkeyring.Key, []string) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - mismatched lengths",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{delegator.Addr, operatorAddresses[:1], []*big.Int{big.NewInt(1), big.NewInt(1)}}
+			},
+			true,
+			fmt.Sprintf(staking.ErrBatchLengthMismatch, 1, 2),
+		},
+		{
+			"fail - different origin than delegator",
+			func(_ testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					cosmosevmutiltx.GenerateAddress(),
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), big.NewInt(1e18)},
+				}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), big.NewInt(5e17)},
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			validators := s.network.GetValidators()[:2]
+			operatorAddresses := []string{validators[0].OperatorAddress, validators[1].OperatorAddress}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 1_000_000)
+
+			bz, err := s.precompile.UndelegateBatch(ctx, contract, stDB, &method, tc.malleate(delegator, operatorAddresses))
+			undelegations, _ := s.network.App.StakingKeeper.GetAllUnbondingDelegations(ctx, delegator.AccAddr)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				s.Require().Empty(undelegations)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(staking.UndelegateBatchMethod, bz)
+			s.Require().NoError(err)
+			completionTimes, ok := out[0].([]int64)
+			s.Require().True(ok, "completion times type %T", out[0])
+
+			params, err := s.network.App.StakingKeeper.GetParams(ctx)
+			s.Require().NoError(err)
+			expCompletionTime := ctx.BlockTime().Add(params.UnbondingTime).UTC().Unix()
+			s.Require().Equal([]int64{expCompletionTime, expCompletionTime}, completionTimes)
+
+			// one event is emitted per undelegation
+			s.Require().Len(stDB.Logs(), 2)
+			s.Require().Len(undelegations, 2)
+		})
+	}
+}
@@ -27,6 +27,10 @@ const (
 	DoNotModifyCommissionRate = -1
 	// DoNotModifyMinSelfDelegation constant used in flags to indicate that min self delegation field should not be updated
 	DoNotModifyMinSelfDelegation = -1
+	// MaxBatchLength is the maximum number of entries of the batch delegation and undelegation transactions.
+	// It covers the active validator sets of the existing chains and only bounds the size of the decoded
+	// input, since every entry of a batch is charged as gas.
+	MaxBatchLength = 500
 )
 
 // EventCreateValidator defines the event data for the staking CreateValidator transaction.
@@ -265,6 +269,46 @@ func NewMsgUndelegate(args []interface{}, denom string) (*stakingtypes.MsgUndele
 	return msg, delegatorAddr, nil
 }
 
+// NewMsgDelegateBatch creates a MsgDelegate instance for each entry of a batch
+// delegation and does sanity checks on the given arguments before populating
+// the messages.
+func NewMsgDelegateBatch(args []interface{}, denom string) ([]*stakingtypes.MsgDelegate, common.Address, error) {
+	delegatorAddr, validatorAddresses, amounts, err := checkBatchArgs(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgs := make([]*stakingtypes.MsgDelegate, len(validatorAddresses))
+	for i := range validatorAddresses {
+		msgs[i], _, err = NewMsgDelegate([]interface{}{delegatorAddr, validatorAddresses[i], amounts[i]}, denom)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// NewMsgUndelegateBatch creates a MsgUndelegate instance for each entry of a
+// batch undelegation and does sanity checks on the given arguments before
+// populating the messages.
+func NewMsgUndelegateBatch(args []interface{}, denom string) ([]*stakingtypes.MsgUndelegate, common.Address, error) {
+	delegatorAddr, validatorAddresses, amounts, err := checkBatchArgs(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgs := make([]*stakingtypes.MsgUndelegate, len(validatorAddresses))
+	for i := range validatorAddresses {
+		msgs[i], _, err = NewMsgUndelegate([]interface{}{delegatorAddr, validatorAddresses[i], amounts[i]}, denom)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
 // NewMsgRedelegate creates a new MsgRedelegate instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgRedelegate(args []interface{}, denom string) (*stakingtypes.MsgBeginRedelegate, common.Address, error) {
@@ -368,6 +412,56 @@ func NewDelegationRequest(args []interface{}) (*stakingtypes.QueryDelegationRequ
 	}, nil
 }
 
+// NewDelegatorDelegationsRequest creates a new QueryDelegatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDelegatorDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorDelegationsRequest, error) {
+	input, err := parseDelegatorInput(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: sdk.AccAddress(input.DelegatorAddress.Bytes()).String(), // bech32 formatted
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// NewDelegatorUnbondingDelegationsRequest creates a new QueryDelegatorUnbondingDelegationsRequest instance and does
+// sanity checks on the given arguments before populating the request.
+func NewDelegatorUnbondingDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+	input, err := parseDelegatorInput(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: sdk.AccAddress(input.DelegatorAddress.Bytes()).String(), // bech32 formatted
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// parseDelegatorInput unpacks the arguments of the delegator-wide queries.
+func parseDelegatorInput(method *abi.Method, args []interface{}) (*DelegatorInput, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegatorInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DelegatorInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &input, nil
+}
+
 // NewValidatorRequest create a new QueryValidatorRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewValidatorRequest(args []interface{}) (*stakingtypes.QueryValidatorRequest, error) {
@@ -539,6 +633,36 @@ func (do *UnbondingDelegationOutput) FromResponse(res *stakingtypes.QueryUnbondi
 	return do
 }
 
+// DelegatorUnbondingDelegationsOutput is a struct to represent the key information from
+// a delegator unbonding delegations response.
+type DelegatorUnbondingDelegationsOutput struct {
+	Response     []UnbondingDelegationResponse
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DelegatorUnbondingDelegationsOutput from a QueryDelegatorUnbondingDelegationsResponse.
+func (do *DelegatorUnbondingDelegationsOutput) FromResponse(
+	res *stakingtypes.QueryDelegatorUnbondingDelegationsResponse,
+) *DelegatorUnbondingDelegationsOutput {
+	do.Response = make([]UnbondingDelegationResponse, len(res.UnbondingResponses))
+	for i, ubd := range res.UnbondingResponses {
+		out := new(UnbondingDelegationOutput).FromResponse(&stakingtypes.QueryUnbondingDelegationResponse{Unbond: ubd})
+		do.Response[i] = out.UnbondingDelegation
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegatorUnbondingDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Response, do.PageResponse)
+}
+
 // DelegationOutput is a struct to represent the key information from
 // a delegation response.
 type DelegationOutput struct {
@@ -561,6 +685,55 @@ func (do *DelegationOutput) Pack(args abi.Arguments) ([]byte, error) {
 	return args.Pack(do.Shares, do.Balance)
 }
 
+// DelegatorInput is a struct to represent the input information for
+// the delegator-wide queries. Needed to unpack arguments into the PageRequest struct.
+type DelegatorInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// DelegationResponse is a struct to represent the key information from
+// a delegation of a delegator to a validator.
+type DelegationResponse struct {
+	ValidatorAddress string
+	Shares           *big.Int
+	Balance          cmn.Coin
+}
+
+// DelegatorDelegationsOutput is a struct to represent the key information from
+// a delegator delegations response.
+type DelegatorDelegationsOutput struct {
+	Response     []DelegationResponse
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DelegatorDelegationsOutput from a QueryDelegatorDelegationsResponse.
+func (do *DelegatorDelegationsOutput) FromResponse(res *stakingtypes.QueryDelegatorDelegationsResponse) *DelegatorDelegationsOutput {
+	do.Response = make([]DelegationResponse, len(res.DelegationResponses))
+	for i, resp := range res.DelegationResponses {
+		do.Response[i] = DelegationResponse{
+			ValidatorAddress: resp.Delegation.ValidatorAddress,
+			Shares:           resp.Delegation.Shares.BigInt(),
+			Balance: cmn.Coin{
+				Denom:  resp.Balance.Denom,
+				Amount: resp.Balance.Amount.BigInt(),
+			},
+		}
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegatorDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Response, do.PageResponse)
+}
+
 // ValidatorInfo is a struct to represent the key information from
 // a validator response.
 type ValidatorInfo struct {
@@ -858,6 +1031,42 @@ func checkDelegationUndelegationArgs(args []interface{}) (common.Address, string
 	return delegatorAddr, validatorAddress, amount, nil
 }
 
+// checkBatchArgs checks the arguments for the batch delegation and undelegation functions.
+func checkBatchArgs(args []interface{}) (common.Address, []string, []*big.Int, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorAddresses, ok := args[1].([]string)
+	if !ok {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "validatorAddresses", []string{}, args[1])
+	}
+
+	amounts, ok := args[2].([]*big.Int)
+	if !ok {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "amounts", []*big.Int{}, args[2])
+	}
+
+	if len(validatorAddresses) == 0 {
+		return common.Address{}, nil, nil, errors.New(ErrEmptyBatch)
+	}
+
+	if len(validatorAddresses) > MaxBatchLength {
+		return common.Address{}, nil, nil, fmt.Errorf(ErrBatchTooLong, MaxBatchLength, len(validatorAddresses))
+	}
+
+	if len(validatorAddresses) != len(amounts) {
+		return common.Address{}, nil, nil, fmt.Errorf(ErrBatchLengthMismatch, len(validatorAddresses), len(amounts))
+	}
+
+	return delegatorAddr, validatorAddresses, amounts, nil
+}
+
 // FormatConsensusPubkey format ConsensusPubkey into a base64 string
 func FormatConsensusPubkey(consensusPubkey *codectypes.Any) string {
 	ed25519pk, ok := consensusPubkey.GetCachedValue().(cryptotypes.PubKey)
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IStargate contract's address.
+address constant STARGATE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IStargate contract's instance.
+IStargate constant STARGATE_CONTRACT = IStargate(STARGATE_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Stargate Precompiled Contract
+/// @dev The interface through which solidity contracts will query the state of the
+/// Cosmos SDK modules. Only the gRPC queries whose method path is allowed by the
+/// governance-controlled allowedStargateQueries parameter of the x/vm module can be executed.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IStargate {
+    /// @dev Query executes a Cosmos SDK gRPC query. The gas consumed by the query
+    /// is charged to the caller.
+    /// @param path The fully qualified gRPC method path of the query,
+    /// e.g. "/cosmos.mint.v1beta1.Query/Inflation"
+    /// @param request The protobuf encoding of the query request
+    /// @return response The protobuf encoding of the query response
+    function query(
+        string calldata path,
+        bytes calldata request
+    ) external view returns (bytes memory response);
+
+    /// @dev IsAllowed returns true if the query with the given method path can be executed.
+    /// @param path The fully qualified gRPC method path of the query
+    /// @return allowed true if the query is allowed
+    function isAllowed(string calldata path) external view returns (bool allowed);
+
+    /// @dev AllowedQueries returns the method paths of the queries that can be executed.
+    /// @return paths The method paths of the allowed queries
+    function allowedQueries() external view returns (string[] memory paths);
+}
@@ -0,0 +1,8 @@
+package stargate
+
+const (
+	// ErrQueryNotAllowed is raised when the query method path is not in the allowlist.
+	ErrQueryNotAllowed = "stargate query %s is not allowed"
+	// ErrUnroutableQuery is raised when no handler is registered for the query method path.
+	ErrUnroutableQuery = "no query handler found for %s"
+)
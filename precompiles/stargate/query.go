@@ -0,0 +1,90 @@
+package stargate
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// QueryMethod defines the ABI method name for the stargate Query.
+	QueryMethod = "query"
+	// IsAllowedMethod defines the ABI method name for the query checking
+	// whether a gRPC query can be executed.
+	IsAllowedMethod = "isAllowed"
+	// AllowedQueriesMethod defines the ABI method name for the query of all
+	// the gRPC queries that can be executed.
+	AllowedQueriesMethod = "allowedQueries"
+)
+
+// Query executes an allowlisted gRPC query through the app's query router and
+// returns the protobuf encoded response. The store reads of the query are
+// metered by the precompile gas meter, which is limited to the gas left after
+// RequiredGas, and the response is charged per byte like a store read.
+func (p *Precompile) Query(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	path, request, err := ParseQueryArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.evmKeeper.GetParams(ctx).IsAllowedStargateQuery(path) {
+		return nil, fmt.Errorf(ErrQueryNotAllowed, path)
+	}
+
+	handler := p.queryRouter.Route(path)
+	if handler == nil {
+		return nil, fmt.Errorf(ErrUnroutableQuery, path)
+	}
+
+	res, err := handler(ctx, &abci.RequestQuery{
+		Data:   request,
+		Path:   path,
+		Height: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(p.KvGasConfig.ReadCostPerByte*uint64(len(res.Value)), "stargate query response")
+
+	return method.Outputs.Pack(res.Value)
+}
+
+// IsAllowed returns whether the given gRPC query method path can be executed.
+func (p *Precompile) IsAllowed(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	path, err := ParseIsAllowedArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.evmKeeper.GetParams(ctx).IsAllowedStargateQuery(path))
+}
+
+// AllowedQueries returns all the gRPC query method paths that can be executed.
+func (p *Precompile) AllowedQueries(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	allowed := p.evmKeeper.GetParams(ctx).AllowedStargateQueries
+	if allowed == nil {
+		allowed = []string{}
+	}
+	return method.Outputs.Pack(allowed)
+}
@@ -0,0 +1,231 @@
+package stargate_test
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/stargate"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/os/network"
+
+	storetypes "cosmossdk.io/store/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	balancePath     = "/cosmos.bank.v1beta1.Query/Balance"
+	denomOwnersPath = "/cosmos.bank.v1beta1.Query/DenomOwners"
+)
+
+func (s *PrecompileTestSuite) TestQuery() {
+	method := s.precompile.Methods[stargate.QueryMethod]
+
+	// newBalanceRequest returns the protobuf encoded base denom balance request
+	// of the first keyring account.
+	newBalanceRequest := func() []byte {
+		req := &banktypes.QueryBalanceRequest{
+			Address: s.keyring.GetAccAddr(0).String(),
+			Denom:   s.network.GetBaseDenom(),
+		}
+		bz, err := req.Marshal()
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - query not allowed",
+			func() []interface{} {
+				return []interface{}{balancePath, newBalanceRequest()}
+			},
+			true,
+			fmt.Sprintf(stargate.ErrQueryNotAllowed, balancePath),
+		},
+		{
+			"fail - unroutable query",
+			func() []interface{} {
+				s.allowQueries("/cosmos.unknown.v1beta1.Query/Unknown")
+				return []interface{}{"/cosmos.unknown.v1beta1.Query/Unknown", []byte{}}
+			},
+			true,
+			"no query handler found",
+		},
+		{
+			"fail - invalid request",
+			func() []interface{} {
+				s.allowQueries(balancePath)
+				return []interface{}{balancePath, []byte{0xff}}
+			},
+			true,
+			"",
+		},
+		{
+			"success - balance queried",
+			func() []interface{} {
+				s.allowQueries(balancePath, denomOwnersPath)
+				return []interface{}{balancePath, newBalanceRequest()}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+
+			bz, err := s.precompile.Query(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+
+				var res banktypes.QueryBalanceResponse
+				s.Require().NoError(res.Unmarshal(out[0].([]byte)))
+				s.Require().Equal(network.PrefundedAccountInitialBalance, res.Balance.Amount)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestQueryGas() {
+	method := s.precompile.Methods[stargate.QueryMethod]
+	s.allowQueries(balancePath)
+
+	req := &banktypes.QueryBalanceRequest{
+		Address: s.keyring.GetAccAddr(0).String(),
+		Denom:   s.network.GetBaseDenom(),
+	}
+	bz, err := req.Marshal()
+	s.Require().NoError(err)
+	args := []interface{}{balancePath, bz}
+
+	contract, ctx := testutil.NewPrecompileContract(
+		s.T(),
+		s.network.GetContext(),
+		s.keyring.GetAddr(0),
+		s.precompile.Address(),
+		200000,
+	)
+	bz, err = s.precompile.Query(ctx, &method, contract, args)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	// the response is charged on top of the store reads
+	gasUsed := ctx.GasMeter().GasConsumed()
+	s.Require().Greater(gasUsed, s.precompile.KvGasConfig.ReadCostPerByte*uint64(len(out[0].([]byte))))
+
+	// the query fails when it consumes more gas than the limit
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasUsed - 1))
+	s.Require().PanicsWithValue(
+		storetypes.ErrorOutOfGas{Descriptor: "stargate query response"},
+		func() {
+			_, _ = s.precompile.Query(ctx, &method, contract, args)
+		},
+	)
+}
+
+func (s *PrecompileTestSuite) TestIsAllowed() {
+	method := s.precompile.Methods[stargate.IsAllowedMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAllowed  bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - query not allowed",
+			func() []interface{} {
+				return []interface{}{balancePath}
+			},
+			false,
+			false,
+			"",
+		},
+		{
+			"success - query allowed",
+			func() []interface{} {
+				s.allowQueries(balancePath)
+				return []interface{}{balancePath}
+			},
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.IsAllowed(s.network.GetContext(), &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAllowed, out[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowedQueries() {
+	method := s.precompile.Methods[stargate.AllowedQueriesMethod]
+	paths := []string{balancePath, denomOwnersPath}
+
+	bz, err := s.precompile.AllowedQueries(s.network.GetContext(), &method, nil, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Empty(out[0])
+
+	s.allowQueries(paths...)
+
+	bz, err = s.precompile.AllowedQueries(s.network.GetContext(), &method, nil, nil)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(paths, out[0])
+}
@@ -0,0 +1,59 @@
+package stargate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/stargate"
+	"github.com/cosmos/evm/testutil/integration/os/factory"
+	"github.com/cosmos/evm/testutil/integration/os/grpc"
+	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
+	"github.com/cosmos/evm/testutil/integration/os/network"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *stargate.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	var err error
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	if s.precompile, err = stargate.NewPrecompile(
+		s.network.App.GRPCQueryRouter(),
+		s.network.App.EVMKeeper,
+	); err != nil {
+		panic(err)
+	}
+}
+
+// allowQueries sets the gRPC queries that can be executed through the precompile.
+func (s *PrecompileTestSuite) allowQueries(paths ...string) {
+	ctx := s.network.GetContext()
+	params := s.network.App.EVMKeeper.GetParams(ctx)
+	params.AllowedStargateQueries = paths
+	s.Require().NoError(s.network.App.EVMKeeper.SetParams(ctx, params))
+}
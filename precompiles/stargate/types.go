@@ -0,0 +1,42 @@
+package stargate
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+// ParseQueryArgs parses the arguments of the query method.
+// args: [string path, bytes request]
+func ParseQueryArgs(args []interface{}) (string, []byte, error) {
+	if len(args) != 2 {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	path, ok := args[0].(string)
+	if !ok {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidType, "path", "", args[0])
+	}
+
+	request, ok := args[1].([]byte)
+	if !ok {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidType, "request", []byte{}, args[1])
+	}
+
+	return path, request, nil
+}
+
+// ParseIsAllowedArgs parses the arguments of the isAllowed method.
+// args: [string path]
+func ParseIsAllowedArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	path, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "path", "", args[0])
+	}
+
+	return path, nil
+}
@@ -8,10 +8,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -20,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GetCode returns the contract code at the given address and block number.
@@ -78,15 +82,17 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		stateKey := evmtypes.StateKey(address, hexKey.Bytes())
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, stateKey)
 		if err != nil {
 			return nil, err
 		}
 
 		storageProofs[i] = rpctypes.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+			Key:      key,
+			Value:    (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+			Proof:    GetHexProofs(proof),
+			ProofOps: rpctypes.NewProofOps(evmtypes.StoreKey, stateKey, valueBz, proof),
 		}
 	}
 
@@ -100,9 +106,53 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, err
 	}
 
+	// query the storage trie root of the account
+	var storageHash common.Hash
+	storageRootRes, err := b.queryClient.StorageRoot(ctx, &evmtypes.QueryStorageRootRequest{
+		Address: address.String(),
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		// the storage of the account is too large to be hashed, leave the hash
+		// empty as the storage proofs don't depend on it
+		if !ok || st.Code() != codes.ResourceExhausted {
+			return nil, err
+		}
+	} else {
+		storageHash = common.HexToHash(storageRootRes.StorageRoot)
+	}
+
 	// query account proofs
 	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountBz, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// query the balance proofs of the EVM coin, made of the x/bank integer balance
+	// and the x/precisebank fractional balance for coins with less than 18 decimals
+	balanceKey, err := rpctypes.BalanceStoreKey(address, evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		return nil, err
+	}
+	balanceBz, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var fractionalBalanceProofOps *rpctypes.ProofOps
+	if evmtypes.GetEVMCoinDecimals() != evmtypes.EighteenDecimals {
+		fractionalKey := rpctypes.FractionalBalanceStoreKey(address)
+		fractionalBz, fractionalProof, err := b.queryClient.GetProof(clientCtx, precisebanktypes.StoreKey, fractionalKey)
+		if err != nil {
+			return nil, err
+		}
+		fractionalBalanceProofOps = rpctypes.NewProofOps(precisebanktypes.StoreKey, fractionalKey, fractionalBz, fractionalProof)
+	}
+
+	// query the code hash proof
+	codeHashKey := rpctypes.CodeHashStoreKey(address)
+	codeHashBz, codeHashProof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, codeHashKey)
 	if err != nil {
 		return nil, err
 	}
@@ -113,13 +163,17 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	}
 
 	return &rpctypes.AccountResult{
-		Address:      address,
-		AccountProof: GetHexProofs(proof),
-		Balance:      (*hexutil.Big)(balance.BigInt()),
-		CodeHash:     common.HexToHash(res.CodeHash),
-		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
-		StorageProof: storageProofs,
+		Address:                   address,
+		AccountProof:              GetHexProofs(proof),
+		Balance:                   (*hexutil.Big)(balance.BigInt()),
+		CodeHash:                  common.HexToHash(res.CodeHash),
+		Nonce:                     hexutil.Uint64(res.Nonce),
+		StorageHash:               storageHash,
+		StorageProof:              storageProofs,
+		AccountProofOps:           rpctypes.NewProofOps(authtypes.StoreKey, accountKey, accountBz, proof),
+		BalanceProofOps:           rpctypes.NewProofOps(banktypes.StoreKey, balanceKey, balanceBz, balanceProof),
+		FractionalBalanceProofOps: fractionalBalanceProofOps,
+		CodeHashProofOps:          rpctypes.NewProofOps(evmtypes.StoreKey, codeHashKey, codeHashBz, codeHashProof),
 	}, nil
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/libs/bytes"
@@ -83,6 +84,21 @@ func (suite *BackendTestSuite) TestGetProof() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
 	address1 := utiltx.GenerateAddress()
 
+	// registerAccountProofs registers the x/auth account, balance and code hash
+	// proof queries of an address
+	registerAccountProofs := func(client *mocks.Client, height int64, addr common.Address) {
+		opts := cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true}
+		RegisterABCIQueryWithOptions(client, height, "store/acc/key", bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, addr.Bytes()...)), opts)
+
+		balanceKey, err := rpctypes.BalanceStoreKey(addr, evmtypes.GetEVMCoinDenom())
+		suite.Require().NoError(err)
+		RegisterABCIQueryWithOptions(client, height, "store/bank/key", balanceKey, opts)
+		if evmtypes.GetEVMCoinDecimals() != evmtypes.EighteenDecimals {
+			RegisterABCIQueryWithOptions(client, height, "store/precisebank/key", rpctypes.FractionalBalanceStoreKey(addr), opts)
+		}
+		RegisterABCIQueryWithOptions(client, height, "store/evm/key", rpctypes.CodeHashStoreKey(addr), opts)
+	}
+
 	testCases := []struct {
 		name          string
 		addr          common.Address
@@ -103,6 +119,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterAccount(queryClient, addr, blockNrInvalid.Int64())
+				RegisterStorageRoot(queryClient, addr, blockNrInvalid.Int64())
 			},
 			false,
 			&rpctypes.AccountResult{},
@@ -132,6 +149,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterAccount(queryClient, addr, bn.Int64())
+				RegisterStorageRoot(queryClient, addr, bn.Int64())
 
 				// Use the IAVL height if a valid tendermint height is passed in.
 				iavlHeight := bn.Int64()
@@ -142,13 +160,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
-				RegisterABCIQueryWithOptions(
-					client,
-					bn.Int64(),
-					"store/acc/key",
-					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
-					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
-				)
+				registerAccountProofs(client, bn.Int64(), address1)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -157,7 +169,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				StorageHash:  ethtypes.EmptyRootHash,
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
@@ -167,6 +179,52 @@ func (suite *BackendTestSuite) TestGetProof() {
 				},
 			},
 		},
+		{
+			"fail - query client errors on getting the storage root",
+			address1,
+			[]string{},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.ctx = rpctypes.ContextWithHeight(bn.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, addr, bn.Int64())
+				RegisterStorageRootError(queryClient, addr, bn.Int64())
+			},
+			false,
+			&rpctypes.AccountResult{},
+		},
+		{
+			"pass - storage too large to be hashed",
+			address1,
+			[]string{},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.ctx = rpctypes.ContextWithHeight(bn.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, addr, bn.Int64())
+				RegisterStorageRootExhausted(queryClient, addr, bn.Int64())
+
+				registerAccountProofs(client, bn.Int64(), address1)
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{""},
+				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:     common.HexToHash(""),
+				Nonce:        0x0,
+				StorageHash:  common.Hash{},
+				StorageProof: []rpctypes.StorageResult{},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
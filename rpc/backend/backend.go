@@ -16,6 +16,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	"github.com/cosmos/evm/rpc/signer"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	cosmosevmtypes "github.com/cosmos/evm/types"
@@ -101,6 +102,7 @@ type EVMBackend interface {
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
+	GetTransactionsByAddress(address common.Address, args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
@@ -115,6 +117,7 @@ type EVMBackend interface {
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	BlockLogs(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
@@ -135,15 +138,20 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             cosmosevmtypes.EVMTxIndexer
+	cache               *RPCCache
+	signer              signer.Signer
 }
 
-// NewBackend creates a new Backend instance for cosmos and ethereum namespaces
+// NewBackend creates a new Backend instance for cosmos and ethereum namespaces.
+// The backends of a node share the given cache, a nil cache creates a new one
+// for the backend.
 func NewBackend(
 	ctx *server.Context,
 	logger log.Logger,
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
 	indexer cosmosevmtypes.EVMTxIndexer,
+	cache *RPCCache,
 ) *Backend {
 	appConf, err := config.GetConfig(ctx.Viper)
 	if err != nil {
@@ -155,6 +163,18 @@ func NewBackend(
 		panic(fmt.Sprintf("invalid rpc client, expected: tmrpcclient.SignClient, got: %T", clientCtx.Client))
 	}
 
+	var accountSigner signer.Signer = signer.NewKeyringSigner(clientCtx.Keyring)
+	if appConf.JSONRPC.ExternalSigner != "" {
+		accountSigner, err = signer.NewExternalSigner(appConf.JSONRPC.ExternalSigner)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if cache == nil {
+		cache = NewRPCCache(appConf.JSONRPC)
+	}
+
 	return &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
@@ -165,5 +185,7 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               cache,
+		signer:              accountSigner,
 	}
 }
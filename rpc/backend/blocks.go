@@ -50,6 +50,7 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 		return 0, fmt.Errorf("block height %d is greater than max uint64", height)
 	}
 
+	b.cache.setLatest(int64(height)) //#nosec G115 -- checked for int overflow already
 	return hexutil.Uint64(height), nil
 }
 
@@ -57,6 +58,12 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum > 0 {
+		if res, found := b.cache.blocks.get(blockKey{height: blockNum.Int64(), fullTx: fullTx}); found {
+			return res, nil
+		}
+	}
+
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -79,12 +86,19 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, err
 	}
 
+	b.cache.addBlock(resBlock.Block.Height, common.BytesToHash(resBlock.Block.Hash()), fullTx, res)
 	return res, nil
 }
 
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	if height, found := b.cache.blockHeights.get(hash); found {
+		if res, found := b.cache.blocks.get(blockKey{height: height, fullTx: fullTx}); found {
+			return res, nil
+		}
+	}
+
 	resBlock, err := b.TendermintBlockByHash(hash)
 	if err != nil {
 		return nil, err
@@ -107,6 +121,7 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, err
 	}
 
+	b.cache.addBlock(resBlock.Block.Height, hash, fullTx, res)
 	return res, nil
 }
 
@@ -180,6 +195,7 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		return nil, nil
 	}
 
+	b.cache.setLatest(resBlock.Block.Height)
 	return resBlock, nil
 }
 
@@ -202,6 +218,7 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, nil
 	}
 
+	b.cache.setLatest(resBlock.Block.Height)
 	return resBlock, nil
 }
 
@@ -590,7 +607,7 @@ func (b *Backend) formatTxReceipt(ethMsg *evmtypes.MsgEthereumTx, blockMsgs []*e
 
 	// parse tx logs from events
 	msgIndex := int(txResult.MsgIndex) // #nosec G115 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[txResult.TxIndex].Events, msgIndex)
+	logs, err := rpctypes.TxLogsFromEvents(blockRes.TxsResults[txResult.TxIndex].Events, msgIndex)
 	if err != nil {
 		b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
 	}
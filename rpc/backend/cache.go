@@ -0,0 +1,164 @@
+package backend
+
+import (
+	"bytes"
+	"maps"
+	"slices"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+// blockKey identifies a decoded Ethereum block, which is formatted differently
+// with full transactions or hashes only.
+type blockKey struct {
+	height int64
+	fullTx bool
+}
+
+// cache is a size bounded LRU cache counting its hits and misses in the
+// rpc/cache/<name>/hit and rpc/cache/<name>/miss metrics. A nil cache is
+// disabled and never holds any entry.
+type cache[K comparable, V any] struct {
+	lru    *lru.Cache[K, V]
+	hits   *metrics.Counter
+	misses *metrics.Counter
+	// clone copies the values going in and out of the cache, so that the callers
+	// can't modify the cached entries. It is nil for immutable values.
+	clone func(V) V
+}
+
+// newCache returns the cache holding up to size entries, nil if size is 0.
+func newCache[K comparable, V any](name string, size int, clone func(V) V) *cache[K, V] {
+	if size <= 0 {
+		return nil
+	}
+
+	return &cache[K, V]{
+		lru:    lru.NewCache[K, V](size),
+		hits:   metrics.GetOrRegisterCounter("rpc/cache/"+name+"/hit", nil),
+		misses: metrics.GetOrRegisterCounter("rpc/cache/"+name+"/miss", nil),
+		clone:  clone,
+	}
+}
+
+func (c *cache[K, V]) get(key K) (value V, found bool) {
+	if c == nil {
+		return value, false
+	}
+
+	value, found = c.lru.Get(key)
+	if !found {
+		c.misses.Inc(1)
+		return value, false
+	}
+
+	c.hits.Inc(1)
+	if c.clone != nil {
+		value = c.clone(value)
+	}
+	return value, true
+}
+
+func (c *cache[K, V]) add(key K, value V) {
+	if c == nil {
+		return
+	}
+	if c.clone != nil {
+		value = c.clone(value)
+	}
+	c.lru.Add(key, value)
+}
+
+// cloneFields returns a copy of the fields of a block or receipt, along with
+// copies of its logs. The other nested values are shared and must not be
+// modified.
+func cloneFields(fields map[string]interface{}) map[string]interface{} {
+	fields = maps.Clone(fields)
+	if logs, ok := fields["logs"].([]*ethtypes.Log); ok {
+		fields["logs"] = cloneLogs(logs)
+	}
+	return fields
+}
+
+// cloneBlockLogs returns a copy of the logs of the txs of a block.
+func cloneBlockLogs(blockLogs [][]*ethtypes.Log) [][]*ethtypes.Log {
+	res := make([][]*ethtypes.Log, len(blockLogs))
+	for i, logs := range blockLogs {
+		res[i] = cloneLogs(logs)
+	}
+	return res
+}
+
+// cloneLogs returns a copy of the logs.
+func cloneLogs(logs []*ethtypes.Log) []*ethtypes.Log {
+	if logs == nil {
+		return nil
+	}
+
+	res := make([]*ethtypes.Log, len(logs))
+	for i, log := range logs {
+		cpy := *log
+		cpy.Topics = slices.Clone(log.Topics)
+		cpy.Data = bytes.Clone(log.Data)
+		res[i] = &cpy
+	}
+	return res
+}
+
+// RPCCache holds the decoded Ethereum blocks, receipts and logs of the finalized
+// heights. The data of a committed height is immutable, but the latest height
+// known to the backend may still be missing its block results or tx indexing,
+// so entries are only added for the heights below it. A node creates a single
+// RPCCache shared by the backends of its namespaces.
+type RPCCache struct {
+	// latest is the highest block height known to be in the block store, which
+	// implies that the blocks below it are committed
+	latest atomic.Int64
+
+	blocks       *cache[blockKey, map[string]interface{}]
+	blockHeights *cache[common.Hash, int64]
+	receipts     *cache[common.Hash, map[string]interface{}]
+	logs         *cache[int64, [][]*ethtypes.Log]
+}
+
+// NewRPCCache returns the caches with the sizes of the JSON-RPC configuration.
+func NewRPCCache(cfg config.JSONRPCConfig) *RPCCache {
+	return &RPCCache{
+		blocks:       newCache[blockKey]("blocks", cfg.BlockCacheSize, cloneFields),
+		blockHeights: newCache[common.Hash, int64]("blockhashes", cfg.BlockCacheSize, nil),
+		receipts:     newCache[common.Hash]("receipts", cfg.ReceiptCacheSize, cloneFields),
+		logs:         newCache[int64]("logs", cfg.LogCacheSize, cloneBlockLogs),
+	}
+}
+
+// setLatest records that the block at the given height is in the block store.
+func (c *RPCCache) setLatest(height int64) {
+	for {
+		latest := c.latest.Load()
+		if height <= latest || c.latest.CompareAndSwap(latest, height) {
+			return
+		}
+	}
+}
+
+// isFinalized returns true if the data of the height can be cached, i.e. if the
+// height is below the latest committed height.
+func (c *RPCCache) isFinalized(height int64) bool {
+	return height > 0 && height < c.latest.Load()
+}
+
+// addBlock caches the decoded block of a finalized height.
+func (c *RPCCache) addBlock(height int64, hash common.Hash, fullTx bool, block map[string]interface{}) {
+	if !c.isFinalized(height) {
+		return
+	}
+
+	c.blocks.add(blockKey{height: height, fullTx: fullTx}, block)
+	c.blockHeights.add(hash, height)
+}
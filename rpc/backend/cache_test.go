@@ -0,0 +1,152 @@
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	ethrpc "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *BackendTestSuite) TestRPCCache() {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.ReceiptCacheSize = 0
+	cache := NewRPCCache(cfg)
+
+	suite.Require().NotNil(cache.blocks)
+	suite.Require().Nil(cache.receipts)
+
+	// disabled caches never hold entries
+	cache.receipts.add(common.Hash{1}, map[string]interface{}{})
+	_, found := cache.receipts.get(common.Hash{1})
+	suite.Require().False(found)
+
+	// the latest height never decreases
+	cache.setLatest(10)
+	cache.setLatest(5)
+	suite.Require().Equal(int64(10), cache.latest.Load())
+
+	// only the heights below the latest one are finalized
+	suite.Require().True(cache.isFinalized(9))
+	suite.Require().False(cache.isFinalized(10))
+	suite.Require().False(cache.isFinalized(0))
+
+	block := map[string]interface{}{"number": 9}
+	cache.addBlock(10, common.Hash{10}, false, block)
+	_, found = cache.blockHeights.get(common.Hash{10})
+	suite.Require().False(found)
+
+	cache.addBlock(9, common.Hash{9}, false, block)
+	height, found := cache.blockHeights.get(common.Hash{9})
+	suite.Require().True(found)
+	suite.Require().Equal(int64(9), height)
+	res, found := cache.blocks.get(blockKey{height: 9, fullTx: false})
+	suite.Require().True(found)
+	suite.Require().Equal(block, res)
+	_, found = cache.blocks.get(blockKey{height: 9, fullTx: true})
+	suite.Require().False(found)
+
+	// the cached entries can't be modified by the callers
+	res["number"] = 10
+	res, _ = cache.blocks.get(blockKey{height: 9, fullTx: false})
+	suite.Require().Equal(9, res["number"])
+
+	logs := [][]*ethtypes.Log{{{Topics: []common.Hash{{1}}, Data: []byte{1}}}}
+	cache.logs.add(9, logs)
+	logs[0][0].Data[0] = 2
+	cachedLogs, found := cache.logs.get(9)
+	suite.Require().True(found)
+	cachedLogs[0][0].Topics[0] = common.Hash{2}
+	cachedLogs, _ = cache.logs.get(9)
+	suite.Require().Equal(common.Hash{1}, cachedLogs[0][0].Topics[0])
+	suite.Require().Equal([]byte{1}, cachedLogs[0][0].Data)
+}
+
+func (suite *BackendTestSuite) TestNewBackendCache() {
+	ctx := server.NewDefaultContext()
+	ctx.Viper.Set("telemetry.global-labels", []interface{}{})
+	clientCtx := suite.backend.clientCtx
+
+	// the backends of a node share the given cache
+	cache := NewRPCCache(*config.DefaultJSONRPCConfig())
+	backend := NewBackend(ctx, ctx.Logger, clientCtx, false, suite.backend.indexer, cache)
+	suite.Require().Same(cache, backend.cache)
+
+	// a backend without cache gets its own
+	backend = NewBackend(ctx, ctx.Logger, clientCtx, false, suite.backend.indexer, nil)
+	suite.Require().NotNil(backend.cache)
+	suite.Require().NotSame(cache, backend.cache)
+}
+
+func (suite *BackendTestSuite) TestGetLogsByHeightCache() {
+	testCases := []struct {
+		name     string
+		latest   int64
+		expCalls int
+	}{
+		{"latest height is not cached", 1, 2},
+		{"finalized height is cached", 2, 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			_, err := RegisterBlockResultsWithEventLog(client, 1)
+			suite.Require().NoError(err)
+			suite.backend.cache.setLatest(tc.latest)
+
+			height := int64(1)
+			logs, err := suite.backend.GetLogsByHeight(&height)
+			suite.Require().NoError(err)
+
+			cachedLogs, err := suite.backend.GetLogsByHeight(&height)
+			suite.Require().NoError(err)
+			suite.Require().Equal(logs, cachedLogs)
+
+			client.AssertNumberOfCalls(suite.T(), "BlockResults", tc.expCalls)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetBlockByNumberCache() {
+	baseFee := math.NewInt(1)
+	validator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	resBlock, err := RegisterBlock(client, 1, nil)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, 1)
+	suite.Require().NoError(err)
+	RegisterConsensusParams(client, 1)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFee(queryClient, baseFee)
+	RegisterValidatorAccount(queryClient, validator)
+
+	// the block is in the block store, so the height is finalized once the next one is
+	suite.backend.cache.setLatest(2)
+
+	block, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(1), false)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(block)
+
+	cachedBlock, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(1), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, cachedBlock)
+
+	// the block hash resolves to the cached block without querying the node
+	cachedBlock, err = suite.backend.GetBlockByHash(common.BytesToHash(resBlock.Block.Hash()), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, cachedBlock)
+
+	client.AssertNumberOfCalls(suite.T(), "Block", 1)
+	client.AssertNumberOfCalls(suite.T(), "BlockResults", 1)
+}
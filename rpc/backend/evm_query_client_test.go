@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -258,6 +259,21 @@ func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, hei
 		)
 }
 
+func RegisterStorageRoot(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
+	queryClient.On("StorageRoot", rpc.ContextWithHeight(height), &evmtypes.QueryStorageRootRequest{Address: addr.String()}).
+		Return(&evmtypes.QueryStorageRootResponse{StorageRoot: ethtypes.EmptyRootHash.Hex()}, nil)
+}
+
+func RegisterStorageRootExhausted(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
+	queryClient.On("StorageRoot", rpc.ContextWithHeight(height), &evmtypes.QueryStorageRootRequest{Address: addr.String()}).
+		Return(nil, status.Error(codes.ResourceExhausted, "too many storage slots"))
+}
+
+func RegisterStorageRootError(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
+	queryClient.On("StorageRoot", rpc.ContextWithHeight(height), &evmtypes.QueryStorageRootRequest{Address: addr.String()}).
+		Return(nil, status.Error(codes.Internal, "internal error"))
+}
+
 // Balance
 func RegisterBalance(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
@@ -4,10 +4,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
+	if height, found := b.cache.blockHeights.get(hash); found {
+		return b.GetLogsByHeight(&height)
+	}
+
 	resBlock, err := b.TendermintBlockByHash(hash)
 	if err != nil {
 		return nil, err
@@ -15,18 +21,46 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 	if resBlock == nil {
 		return nil, errors.Errorf("block not found for hash %s", hash)
 	}
+
+	if b.cache.isFinalized(resBlock.Block.Header.Height) {
+		b.cache.blockHeights.add(hash, resBlock.Block.Header.Height)
+	}
 	return b.GetLogsByHeight(&resBlock.Block.Header.Height)
 }
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if height != nil {
+		if logs, found := b.cache.logs.get(*height); found {
+			return logs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.rpcClient.BlockResults(b.ctx, height)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetLogsFromBlockResults(blockRes)
+	return b.BlockLogs(blockRes)
+}
+
+// BlockLogs returns all the logs from all the ethereum transactions in the block
+// results, using the cached logs of the height when they are available.
+func (b *Backend) BlockLogs(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
+	if logs, found := b.cache.logs.get(blockRes.Height); found {
+		return logs, nil
+	}
+
+	logs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.cache.isFinalized(blockRes.Height) {
+		b.cache.logs.add(blockRes.Height, logs)
+	}
+	return logs, nil
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
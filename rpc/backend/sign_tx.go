@@ -9,7 +9,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -18,28 +17,33 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
-// SendTransaction sends transaction based on received args using Node's key to sign it
+// SendTransaction sends transaction based on received args using the Node's key, or the
+// external signer when configured, to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
+	// The external signer signs on behalf of the accounts it manages, so the signing
+	// requests are gated as the keyring accounts are
 	if !b.cfg.JSONRPC.AllowInsecureUnlock {
 		b.logger.Debug("account unlock with HTTP access is forbidden")
 		return common.Hash{}, fmt.Errorf("account unlock with HTTP access is forbidden")
 	}
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	// Look up the wallet containing the requested signer, the keys of the external
+	// signer don't reside on the node
+	if b.cfg.JSONRPC.ExternalSigner == "" {
+		_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+		if err != nil {
+			b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
+			return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+		}
 	}
 
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
 		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
 	}
 
-	args, err = b.SetTxDefaults(args)
+	args, err := b.SetTxDefaults(args)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -55,15 +59,21 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), header.Time)
+	ethSigner := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), header.Time)
 
 	// LegacyTx derives chainID from the signature. To make sure the msg.ValidateBasic makes
 	// the corresponding chainID validation, we need to sign the transaction before calling it
 
 	// Sign transaction
-	msg := args.ToTransaction()
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
-		b.logger.Debug("failed to sign tx", "error", err.Error())
+	signedTx, err := b.signer.SignTransaction(b.ctx, args, ethSigner)
+	if err != nil {
+		b.logger.Error("failed to sign tx", "address", args.GetFrom(), "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	msg := &evmtypes.MsgEthereumTx{}
+	if err := msg.FromEthereumTx(signedTx); err != nil {
+		b.logger.Debug("failed to convert signed tx", "error", err.Error())
 		return common.Hash{}, err
 	}
 
@@ -117,47 +127,20 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
-	from := sdk.AccAddress(address.Bytes())
-
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	signature, err := b.signer.Sign(b.ctx, address, data)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
-	}
-
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
-	if err != nil {
-		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
+		b.logger.Error("failed to sign data", "address", address.Hex(), "error", err.Error())
 		return nil, err
 	}
-
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 	return signature, nil
 }
 
 // SignTypedData signs EIP-712 conformant typed data
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
-	from := sdk.AccAddress(address.Bytes())
-
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
-	}
-
-	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	signature, err := b.signer.SignTypedData(b.ctx, address, typedData)
 	if err != nil {
+		b.logger.Error("failed to sign typed data", "address", address.Hex(), "error", err.Error())
 		return nil, err
 	}
-
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, sigHash, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
-	if err != nil {
-		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
-		return nil, err
-	}
-
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 	return signature, nil
 }
@@ -3,6 +3,7 @@ package backend
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -48,6 +49,16 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 		expHash      common.Hash
 		expPass      bool
 	}{
+		{
+			"fail - external signer with insecure unlock disabled",
+			func() {
+				suite.backend.cfg.JSONRPC.ExternalSigner = "http://127.0.0.1:8550"
+				suite.backend.cfg.JSONRPC.AllowInsecureUnlock = false
+			},
+			callArgsDefault,
+			hash,
+			false,
+		},
 		{
 			"fail - Can't find account in Keyring",
 			func() {},
@@ -180,7 +191,7 @@ func (suite *BackendTestSuite) TestSign() {
 
 			responseBz, err := suite.backend.Sign(tc.fromAddr, tc.inputBz)
 			if tc.expPass {
-				signature, _, err := suite.backend.clientCtx.Keyring.SignByAddress((sdk.AccAddress)(from.Bytes()), tc.inputBz, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+				signature, _, err := suite.backend.clientCtx.Keyring.SignByAddress((sdk.AccAddress)(from.Bytes()), accounts.TextHash(tc.inputBz), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 				signature[goethcrypto.RecoveryIDOffset] += 27
 				suite.Require().NoError(err)
 				suite.Require().Equal((hexutil.Bytes)(signature), responseBz)
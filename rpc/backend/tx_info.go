@@ -144,6 +144,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, found := b.cache.receipts.get(hash); found {
+		return receipt, nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -202,7 +206,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 	// parse tx logs from events
 	msgIndex := int(res.MsgIndex) // #nosec G115 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+	logs, err := rpctypes.TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
 	if err != nil {
 		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
 	}
@@ -278,6 +282,9 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		}
 	}
 
+	if b.cache.isFinalized(res.Height) {
+		b.cache.receipts.add(hash, receipt)
+	}
 	return receipt, nil
 }
 
@@ -304,7 +311,73 @@ func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 
 	// parse tx logs from events
 	index := int(res.MsgIndex) // #nosec G701
-	return TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, index)
+	return rpctypes.TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, index)
+}
+
+const (
+	// defaultAddressTxsPageSize is the page size of eth_getTransactionsByAddress when
+	// it's not set
+	defaultAddressTxsPageSize = 100
+	// maxAddressTxsPageSize caps the page size of eth_getTransactionsByAddress
+	maxAddressTxsPageSize = 1000
+)
+
+// GetTransactionsByAddress returns a page of the transactions the address appears in
+// as sender, recipient, created contract or log emitter, read from the indexer.
+func (b *Backend) GetTransactionsByAddress(address common.Address, args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error) {
+	if b.indexer == nil {
+		return nil, errors.New("the transactions by address require the EVM indexer, enable it with the json-rpc.enable-indexer flag")
+	}
+
+	if args.PageSize > maxAddressTxsPageSize {
+		return nil, fmt.Errorf("page size %d exceeds the maximum of %d", uint64(args.PageSize), maxAddressTxsPageSize)
+	}
+	pageSize := int(args.PageSize) // #nosec G115 -- page size is capped to maxAddressTxsPageSize above
+	if pageSize == 0 {
+		pageSize = defaultAddressTxsPageSize
+	}
+
+	var cursor *types.AddressTx
+	if args.Cursor != nil {
+		if args.Cursor.BlockNumber > math.MaxInt64 || args.Cursor.TransactionIndex > math.MaxInt32 {
+			return nil, errors.New("cursor is out of range")
+		}
+		cursor = &types.AddressTx{
+			Height:     int64(args.Cursor.BlockNumber),      // #nosec G115 -- checked against math.MaxInt64 above
+			EthTxIndex: int32(args.Cursor.TransactionIndex), // #nosec G115 -- checked against math.MaxInt32 above
+		}
+	}
+
+	// load one more tx to know if there is a next page
+	txs, err := b.indexer.GetByAddress(address, cursor, !args.Ascending, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpctypes.AddressTxsResult{
+		Transactions: make([]*rpctypes.RPCTransaction, 0, len(txs)),
+	}
+	if len(txs) > pageSize {
+		txs = txs[:pageSize]
+		last := txs[pageSize-1]
+		res.Cursor = &rpctypes.AddressTxCursor{
+			BlockNumber:      hexutil.Uint64(last.Height),   // #nosec G115 -- block number is not negative
+			TransactionIndex: hexutil.Uint(last.EthTxIndex), // #nosec G115 -- index is not negative
+		}
+	}
+
+	for _, tx := range txs {
+		rpcTx, err := b.GetTransactionByHash(tx.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		if rpcTx == nil {
+			b.logger.Debug("indexed tx not found", "hash", tx.TxHash.Hex())
+			continue
+		}
+		res.Transactions = append(res.Transactions, rpcTx)
+	}
+	return res, nil
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
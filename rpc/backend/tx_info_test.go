@@ -130,6 +130,150 @@ func (suite *BackendTestSuite) TestGetTransactionByHash() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetTransactionsByAddress() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	sender := common.HexToAddress(msgEthereumTx.From)
+	signedHash := common.HexToHash(msgEthereumTx.Hash)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		address      common.Address
+		args         rpctypes.AddressTxsArgs
+		noIndexer    bool
+		expHashes    []common.Hash
+		expCursor    *rpctypes.AddressTxCursor
+		expPass      bool
+	}{
+		{
+			"fail - indexer disabled",
+			func() {},
+			sender,
+			rpctypes.AddressTxsArgs{},
+			true,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - page size exceeds the maximum",
+			func() {},
+			sender,
+			rpctypes.AddressTxsArgs{PageSize: maxAddressTxsPageSize + 1},
+			false,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - page size overflowing int",
+			func() {},
+			sender,
+			rpctypes.AddressTxsArgs{PageSize: hexutil.Uint64(^uint64(0))},
+			false,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - cursor index overflowing int32",
+			func() {},
+			sender,
+			rpctypes.AddressTxsArgs{Cursor: &rpctypes.AddressTxCursor{BlockNumber: 1, TransactionIndex: 1 << 31}},
+			false,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - no transactions",
+			func() {},
+			common.BigToAddress(big.NewInt(1)),
+			rpctypes.AddressTxsArgs{},
+			false,
+			[]common.Hash{},
+			nil,
+			true,
+		},
+		{
+			"pass - sender transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+			},
+			sender,
+			rpctypes.AddressTxsArgs{PageSize: 1},
+			false,
+			[]common.Hash{signedHash},
+			nil,
+			true,
+		},
+		{
+			"pass - recipient transactions after the cursor",
+			func() {},
+			common.Address{},
+			rpctypes.AddressTxsArgs{Ascending: true, Cursor: &rpctypes.AddressTxCursor{BlockNumber: 1}},
+			false,
+			[]common.Hash{},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+			if tc.noIndexer {
+				suite.backend.indexer = nil
+			}
+
+			res, err := suite.backend.GetTransactionsByAddress(tc.address, tc.args)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				hashes := make([]common.Hash, 0, len(res.Transactions))
+				for _, tx := range res.Transactions {
+					hashes = append(hashes, tx.Hash)
+				}
+				suite.Require().Equal(tc.expHashes, hashes)
+				suite.Require().Equal(tc.expCursor, res.Cursor)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 	rpcTransaction, _ := rpctypes.NewRPCTransaction(msgEthereumTx.AsTransaction(), common.Hash{}, 0, 0, big.NewInt(1), suite.backend.chainID)
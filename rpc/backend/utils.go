@@ -1,7 +1,6 @@
 package backend
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"sort"
@@ -217,7 +216,7 @@ func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
 			continue
 		}
 
-		logs, err := ParseTxLogsFromEvent(event)
+		logs, err := types.ParseTxLogsFromEvent(event)
 		if err != nil {
 			return nil, err
 		}
@@ -228,39 +227,17 @@ func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
 }
 
 // TxLogsFromEvents parses ethereum logs from cosmos events for specific msg index
+//
+// Deprecated: use types.TxLogsFromEvents instead.
 func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error) {
-	for _, event := range events {
-		if event.Type != evmtypes.EventTypeTxLog {
-			continue
-		}
-
-		if msgIndex > 0 {
-			// not the eth tx we want
-			msgIndex--
-			continue
-		}
-
-		return ParseTxLogsFromEvent(event)
-	}
-	return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
+	return types.TxLogsFromEvents(events, msgIndex)
 }
 
 // ParseTxLogsFromEvent parse tx logs from one event
+//
+// Deprecated: use types.ParseTxLogsFromEvent instead.
 func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
-	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
-	for _, attr := range event.Attributes {
-		if attr.Key != evmtypes.AttributeKeyTxLog {
-			continue
-		}
-
-		var txLog evmtypes.Log
-		if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
-			return nil, err
-		}
-
-		logs = append(logs, &txLog)
-	}
-	return evmtypes.LogsToEthereum(logs), nil
+	return types.ParseTxLogsFromEvent(event)
 }
 
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
@@ -269,7 +246,8 @@ func ShouldIgnoreGasUsed(res *abci.ExecTxResult) bool {
 	return res.GetCode() == 11 && strings.Contains(res.GetLog(), "no block gas left to run tx: out of gas")
 }
 
-// GetLogsFromBlockResults returns the list of event logs from the tendermint block result response
+// GetLogsFromBlockResults returns the list of event logs from the tendermint block result response.
+// The system logs emitted by the EVM module at the end of the block are returned last.
 func GetLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
 	blockLogs := [][]*ethtypes.Log{}
 	for _, txResult := range blockRes.TxsResults {
@@ -280,9 +258,36 @@ func GetLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*eth
 
 		blockLogs = append(blockLogs, logs...)
 	}
+
+	systemLogs, err := SystemLogsFromEvents(blockRes.FinalizeBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+	if len(systemLogs) > 0 {
+		blockLogs = append(blockLogs, systemLogs)
+	}
 	return blockLogs, nil
 }
 
+// SystemLogsFromEvents parses the system logs emitted by the EVM module from the
+// finalize block events
+func SystemLogsFromEvents(events []abci.Event) ([]*ethtypes.Log, error) {
+	systemLogs := []*ethtypes.Log{}
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeSystemLog {
+			continue
+		}
+
+		logs, err := types.ParseTxLogsFromEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		systemLogs = append(systemLogs, logs...)
+	}
+	return systemLogs, nil
+}
+
 // GetHexProofs returns list of hex data of proof op
 func GetHexProofs(proof *crypto.ProofOps) []string {
 	if proof == nil {
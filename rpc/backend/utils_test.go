@@ -1,9 +1,16 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +57,46 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetLogsFromBlockResults() {
+	txLog := &evmtypes.Log{Address: "0x0000000000000000000000000000000000000001", Index: 0}
+	systemLog := &evmtypes.Log{Address: evmtypes.SystemLogsAddress.Hex(), Index: 1}
+
+	logEvent := func(eventType string, log *evmtypes.Log) abci.Event {
+		value, err := json.Marshal(log)
+		suite.Require().NoError(err)
+		return abci.Event{
+			Type:       eventType,
+			Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(value)}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		blockRes *cmtrpctypes.ResultBlockResults
+		expLogs  [][]*ethtypes.Log
+	}{
+		{
+			"tx logs only",
+			&cmtrpctypes.ResultBlockResults{
+				TxsResults: []*abci.ExecTxResult{{Events: []abci.Event{logEvent(evmtypes.EventTypeTxLog, txLog)}}},
+			},
+			[][]*ethtypes.Log{{txLog.ToEthereum()}},
+		},
+		{
+			"system logs are returned after the tx logs",
+			&cmtrpctypes.ResultBlockResults{
+				TxsResults:          []*abci.ExecTxResult{{Events: []abci.Event{logEvent(evmtypes.EventTypeTxLog, txLog)}}},
+				FinalizeBlockEvents: []abci.Event{logEvent(evmtypes.EventTypeSystemLog, systemLog)},
+			},
+			[][]*ethtypes.Log{{txLog.ToEthereum()}, {systemLog.ToEthereum()}},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			logs, err := GetLogsFromBlockResults(tc.blockRes)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLogs, logs)
+		})
+	}
+}
@@ -0,0 +1,1198 @@
+// Package graphql implements the EIP-1767 GraphQL interface on top of the EVM
+// JSON-RPC backend, following the schema of the go-ethereum GraphQL service.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	errBlockInvariant    = errors.New("only one of number or hash must be specified")
+	errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+)
+
+// Backend defines the EVM backend methods used to resolve the GraphQL queries,
+// including the ones used by the log filters.
+type Backend interface {
+	backend.EVMBackend
+	filters.Backend
+}
+
+// Long is a 64 bit integer, accepted as a JSON number or as a decimal or 0x-prefixed
+// hexadecimal string.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) //nolint:gosec // G115 // block numbers won't exceed int64
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// BlockNumberArgs are the arguments of the fields resolving an account at an
+// optional block number.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// numberOr returns the block number of the arguments, or the given one if unset.
+func (a BlockNumberArgs) numberOr(current rpctypes.BlockNumber) rpctypes.BlockNumber {
+	if a.Block != nil {
+		return rpctypes.BlockNumber(*a.Block)
+	}
+	return current
+}
+
+// Account represents an Ethereum account at a particular block.
+type Account struct {
+	r           *Resolver
+	address     common.Address
+	blockNumber rpctypes.BlockNumber
+}
+
+func (a *Account) blockNrOrHash() rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &a.blockNumber}
+}
+
+func (a *Account) Address() common.Address {
+	return a.address
+}
+
+func (a *Account) Balance() (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(a.address, a.blockNrOrHash())
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount() (hexutil.Uint64, error) {
+	nonce, err := a.r.backend.GetTransactionCount(a.address, a.blockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code() (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(a.address, a.blockNrOrHash())
+}
+
+func (a *Account) Storage(args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(a.address, args.Slot.Hex(), a.blockNrOrHash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log represents an individual log message.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction() *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(args BlockNumberArgs) *Account {
+	return &Account{
+		r:           l.r,
+		address:     l.log.Address,
+		blockNumber: args.numberOr(rpctypes.BlockNumber(l.log.BlockNumber)), //nolint:gosec // G115 // won't exceed int64
+	}
+}
+
+func (l *Log) Index() hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics() []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data() hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple represents EIP-2930
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address() common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys() []common.Hash {
+	return at.storageKeys
+}
+
+// Withdrawal represents a withdrawal of value from the beacon chain. Withdrawals
+// do not exist on Cosmos EVM chains, but the type is part of the schema.
+type Withdrawal struct {
+	withdrawal *ethtypes.Withdrawal
+}
+
+func (w *Withdrawal) Index() hexutil.Uint64 {
+	return hexutil.Uint64(w.withdrawal.Index)
+}
+
+func (w *Withdrawal) Validator() hexutil.Uint64 {
+	return hexutil.Uint64(w.withdrawal.Validator)
+}
+
+func (w *Withdrawal) Address() common.Address {
+	return w.withdrawal.Address
+}
+
+func (w *Withdrawal) Amount() hexutil.Uint64 {
+	return hexutil.Uint64(w.withdrawal.Amount)
+}
+
+// Transaction represents an Ethereum transaction. The transaction and its receipt
+// are fetched from the backend the first time they are needed.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash
+
+	mu      sync.Mutex
+	tx      *rpctypes.RPCTransaction
+	receipt *ethtypes.Receipt
+}
+
+// resolve returns the transaction, nil if it is not found.
+func (t *Transaction) resolve() (*rpctypes.RPCTransaction, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.tx != nil {
+		return t.tx, nil
+	}
+
+	tx, err := t.r.backend.GetTransactionByHash(t.hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %s not found", t.hash.Hex())
+	}
+	t.tx = tx
+	return tx, nil
+}
+
+// getReceipt returns the receipt of the transaction, nil if it is still pending.
+func (t *Transaction) getReceipt() (*ethtypes.Receipt, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.receipt != nil {
+		return t.receipt, nil
+	}
+
+	res, err := t.r.backend.GetTransactionReceipt(t.hash)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	receipt, err := decodeReceipt(res)
+	if err != nil {
+		return nil, err
+	}
+	t.receipt = receipt
+	return receipt, nil
+}
+
+// getBlock returns the block including the transaction, nil if it is still pending.
+func (t *Transaction) getBlock() (*Block, error) {
+	tx, err := t.resolve()
+	if err != nil || tx.BlockNumber == nil {
+		return nil, err
+	}
+	return t.r.getBlockByNumber(rpctypes.BlockNumber(tx.BlockNumber.ToInt().Int64()))
+}
+
+// blockNumber returns the number of the block including the transaction, or the
+// pending block number if it is still pending.
+func (t *Transaction) blockNumber() rpctypes.BlockNumber {
+	tx, err := t.resolve()
+	if err != nil || tx.BlockNumber == nil {
+		return rpctypes.EthPendingBlockNumber
+	}
+	return rpctypes.BlockNumber(tx.BlockNumber.ToInt().Int64())
+}
+
+func (t *Transaction) Hash() common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) InputData() (hexutil.Bytes, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return tx.Input, nil
+}
+
+func (t *Transaction) Gas() (hexutil.Uint64, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return 0, err
+	}
+	return tx.Gas, nil
+}
+
+func (t *Transaction) GasPrice() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.GasPrice, nil
+}
+
+func (t *Transaction) EffectiveGasPrice() (*hexutil.Big, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.EffectiveGasPrice == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.EffectiveGasPrice), nil
+}
+
+func (t *Transaction) MaxFeePerGas() (*hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return tx.GasFeeCap, nil
+}
+
+func (t *Transaction) MaxPriorityFeePerGas() (*hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return tx.GasTipCap, nil
+}
+
+func (t *Transaction) MaxFeePerBlobGas() *hexutil.Big {
+	return nil
+}
+
+func (t *Transaction) BlobVersionedHashes() *[]common.Hash {
+	return nil
+}
+
+// EffectiveTip returns the price per gas above the base fee of the block paid by
+// the transaction, nil if it is still pending.
+func (t *Transaction) EffectiveTip() (*hexutil.Big, error) {
+	block, err := t.getBlock()
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	// the gas price of the mined transactions is the effective gas price
+	if block.block.BaseFee == nil {
+		return tx.GasPrice, nil
+	}
+	tip := new(big.Int).Sub(tx.GasPrice.ToInt(), block.block.BaseFee.ToInt())
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Value() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.Value, nil
+}
+
+func (t *Transaction) Nonce() (hexutil.Uint64, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return 0, err
+	}
+	return tx.Nonce, nil
+}
+
+func (t *Transaction) To(args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve()
+	if err != nil || tx.To == nil {
+		return nil, err
+	}
+	return &Account{
+		r:           t.r,
+		address:     *tx.To,
+		blockNumber: args.numberOr(t.blockNumber()),
+	}, nil
+}
+
+func (t *Transaction) From(args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:           t.r,
+		address:     tx.From,
+		blockNumber: args.numberOr(t.blockNumber()),
+	}, nil
+}
+
+func (t *Transaction) Block() (*Block, error) {
+	return t.getBlock()
+}
+
+func (t *Transaction) Index() (*hexutil.Uint64, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return tx.TransactionIndex, nil
+}
+
+func (t *Transaction) Status() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(receipt.Status)
+	return &status, nil
+}
+
+func (t *Transaction) GasUsed() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := hexutil.Uint64(receipt.GasUsed)
+	return &gasUsed, nil
+}
+
+func (t *Transaction) CumulativeGasUsed() (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &gasUsed, nil
+}
+
+func (t *Transaction) BlobGasUsed() *hexutil.Uint64 {
+	return nil
+}
+
+func (t *Transaction) BlobGasPrice() *hexutil.Big {
+	return nil
+}
+
+func (t *Transaction) CreatedContract(args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	return &Account{
+		r:           t.r,
+		address:     receipt.ContractAddress,
+		blockNumber: args.numberOr(t.blockNumber()),
+	}, nil
+}
+
+func (t *Transaction) Logs() (*[]*Log, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	logs := make([]*Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		logs = append(logs, &Log{r: t.r, transaction: t, log: log})
+	}
+	return &logs, nil
+}
+
+func (t *Transaction) Type() (*hexutil.Uint64, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return &tx.Type, nil
+}
+
+func (t *Transaction) AccessList() (*[]*AccessTuple, error) {
+	tx, err := t.resolve()
+	if err != nil || tx.Accesses == nil {
+		return nil, err
+	}
+
+	accessList := make([]*AccessTuple, 0, len(*tx.Accesses))
+	for _, tuple := range *tx.Accesses {
+		accessList = append(accessList, &AccessTuple{
+			address:     tuple.Address,
+			storageKeys: tuple.StorageKeys,
+		})
+	}
+	return &accessList, nil
+}
+
+func (t *Transaction) R() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.R, nil
+}
+
+func (t *Transaction) S() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.S, nil
+}
+
+func (t *Transaction) V() (hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.V, nil
+}
+
+// YParity returns the signature parity of the typed transactions, nil for the
+// legacy ones.
+func (t *Transaction) YParity() (*hexutil.Big, error) {
+	tx, err := t.resolve()
+	if err != nil || tx.Type == ethtypes.LegacyTxType {
+		return nil, err
+	}
+	return tx.V, nil
+}
+
+// Raw returns the canonical encoding of the transaction, which is decoded from
+// its JSON-RPC representation.
+func (t *Transaction) Raw() (hexutil.Bytes, error) {
+	tx, err := t.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ethTx ethtypes.Transaction
+	if err := ethTx.UnmarshalJSON(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", t.hash.Hex(), err)
+	}
+	return ethTx.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt() (hexutil.Bytes, error) {
+	receipt, err := t.getReceipt()
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, fmt.Errorf("receipt of transaction %s not found", t.hash.Hex())
+	}
+	return receipt.MarshalBinary()
+}
+
+// rpcBlock is the JSON-RPC representation of a block with full transactions.
+type rpcBlock struct {
+	Number           hexutil.Uint64             `json:"number"`
+	Hash             common.Hash                `json:"hash"`
+	ParentHash       common.Hash                `json:"parentHash"`
+	Nonce            ethtypes.BlockNonce        `json:"nonce"`
+	UncleHash        common.Hash                `json:"sha3Uncles"`
+	Bloom            ethtypes.Bloom             `json:"logsBloom"`
+	StateRoot        hexutil.Bytes              `json:"stateRoot"`
+	Miner            common.Address             `json:"miner"`
+	MixHash          common.Hash                `json:"mixHash"`
+	Difficulty       hexutil.Big                `json:"difficulty"`
+	ExtraData        hexutil.Bytes              `json:"extraData"`
+	GasLimit         hexutil.Uint64             `json:"gasLimit"`
+	GasUsed          hexutil.Uint64             `json:"gasUsed"`
+	Timestamp        hexutil.Uint64             `json:"timestamp"`
+	TransactionsRoot common.Hash                `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash                `json:"receiptsRoot"`
+	BaseFee          *hexutil.Big               `json:"baseFeePerGas"`
+	Transactions     []*rpctypes.RPCTransaction `json:"transactions"`
+}
+
+// decodeBlock decodes the block returned by the backend, nil if it is not found.
+func decodeBlock(res map[string]interface{}) (*rpcBlock, error) {
+	if res == nil {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var block rpcBlock
+	if err := json.Unmarshal(bz, &block); err != nil {
+		return nil, fmt.Errorf("failed to decode block: %w", err)
+	}
+	return &block, nil
+}
+
+// decodeReceipt decodes the transaction receipt returned by the backend.
+func decodeReceipt(res map[string]interface{}) (*ethtypes.Receipt, error) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipt ethtypes.Receipt
+	if err := json.Unmarshal(bz, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to decode receipt: %w", err)
+	}
+	return &receipt, nil
+}
+
+// Block represents an Ethereum block, in the format of the JSON-RPC responses.
+type Block struct {
+	r     *Resolver
+	block *rpcBlock
+}
+
+func (b *Block) number() rpctypes.BlockNumber {
+	return rpctypes.BlockNumber(b.block.Number) //nolint:gosec // G115 // won't exceed int64
+}
+
+func (b *Block) Number() hexutil.Uint64 {
+	return b.block.Number
+}
+
+func (b *Block) Hash() common.Hash {
+	return b.block.Hash
+}
+
+func (b *Block) GasLimit() hexutil.Uint64 {
+	return b.block.GasLimit
+}
+
+func (b *Block) GasUsed() hexutil.Uint64 {
+	return b.block.GasUsed
+}
+
+func (b *Block) BaseFeePerGas() *hexutil.Big {
+	return b.block.BaseFee
+}
+
+// NextBaseFeePerGas returns the base fee of the next block, nil if it is not
+// committed yet.
+func (b *Block) NextBaseFeePerGas() (*hexutil.Big, error) {
+	next, err := b.r.getBlockByNumber(b.number() + 1)
+	if err != nil || next == nil {
+		return nil, err
+	}
+	return next.block.BaseFee, nil
+}
+
+// Parent returns the parent block, nil for the initial block of the chain.
+func (b *Block) Parent() (*Block, error) {
+	if b.block.Number == 0 {
+		return nil, nil
+	}
+	return b.r.getBlockByNumber(b.number() - 1)
+}
+
+func (b *Block) Difficulty() hexutil.Big {
+	return b.block.Difficulty
+}
+
+func (b *Block) Timestamp() hexutil.Uint64 {
+	return b.block.Timestamp
+}
+
+func (b *Block) Nonce() hexutil.Bytes {
+	return b.block.Nonce[:]
+}
+
+func (b *Block) MixHash() common.Hash {
+	return b.block.MixHash
+}
+
+func (b *Block) TransactionsRoot() common.Hash {
+	return b.block.TransactionsRoot
+}
+
+func (b *Block) StateRoot() common.Hash {
+	return common.BytesToHash(b.block.StateRoot)
+}
+
+func (b *Block) ReceiptsRoot() common.Hash {
+	return b.block.ReceiptsRoot
+}
+
+func (b *Block) OmmerHash() common.Hash {
+	return b.block.UncleHash
+}
+
+func (b *Block) OmmerCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(0)
+	return &count
+}
+
+func (b *Block) Ommers() *[]*Block {
+	ommers := []*Block{}
+	return &ommers
+}
+
+func (b *Block) OmmerAt(_ struct{ Index Long }) *Block {
+	return nil
+}
+
+func (b *Block) ExtraData() hexutil.Bytes {
+	return b.block.ExtraData
+}
+
+func (b *Block) LogsBloom() hexutil.Bytes {
+	return b.block.Bloom.Bytes()
+}
+
+// RawHeader returns the RLP encoding of the Ethereum header built from the
+// CometBFT header of the block.
+func (b *Block) RawHeader() (hexutil.Bytes, error) {
+	block, err := b.r.backend.EthBlockByNumber(b.number())
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block.Header())
+}
+
+// Raw returns the RLP encoding of the Ethereum block built from the CometBFT
+// block.
+func (b *Block) Raw() (hexutil.Bytes, error) {
+	block, err := b.r.backend.EthBlockByNumber(b.number())
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block)
+}
+
+func (b *Block) Miner(args BlockNumberArgs) *Account {
+	return &Account{
+		r:           b.r,
+		address:     b.block.Miner,
+		blockNumber: args.numberOr(b.number()),
+	}
+}
+
+func (b *Block) TransactionCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(len(b.block.Transactions))
+	return &count
+}
+
+func (b *Block) Transactions() *[]*Transaction {
+	txs := make([]*Transaction, 0, len(b.block.Transactions))
+	for _, tx := range b.block.Transactions {
+		txs = append(txs, &Transaction{r: b.r, hash: tx.Hash, tx: tx})
+	}
+	return &txs
+}
+
+func (b *Block) TransactionAt(args struct{ Index Long }) *Transaction {
+	if args.Index < 0 || int(args.Index) >= len(b.block.Transactions) {
+		return nil
+	}
+	tx := b.block.Transactions[args.Index]
+	return &Transaction{r: b.r, hash: tx.Hash, tx: tx}
+}
+
+func (b *Block) WithdrawalsRoot() *common.Hash {
+	return nil
+}
+
+func (b *Block) Withdrawals() *[]*Withdrawal {
+	return nil
+}
+
+func (b *Block) BlobGasUsed() *hexutil.Uint64 {
+	return nil
+}
+
+func (b *Block) ExcessBlobGas() *hexutil.Uint64 {
+	return nil
+}
+
+// BlockFilterCriteria encapsulates criteria passed to a `logs` accessor inside
+// a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	criteria := ethfilters.FilterCriteria{BlockHash: &b.block.Hash}
+	if args.Filter.Addresses != nil {
+		criteria.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		criteria.Topics = *args.Filter.Topics
+	}
+
+	filter := filters.NewBlockFilter(b.r.logger, b.r.backend, criteria)
+	return b.r.runFilter(ctx, filter)
+}
+
+func (b *Block) Account(args struct{ Address common.Address }) *Account {
+	return &Account{
+		r:           b.r,
+		address:     args.Address,
+		blockNumber: b.number(),
+	}
+}
+
+func (b *Block) Call(args struct{ Data CallData }) (*CallResult, error) {
+	return b.r.call(args.Data, b.number())
+}
+
+func (b *Block) EstimateGas(args struct{ Data CallData }) (hexutil.Uint64, error) {
+	blockNumber := b.number()
+	return b.r.backend.EstimateGas(args.Data.toTransactionArgs(), &blockNumber)
+}
+
+// CallData encapsulates the arguments of a local call or of a gas estimation.
+type CallData struct {
+	From                 *common.Address // The Ethereum address the call is from.
+	To                   *common.Address // The Ethereum address the call is to.
+	Gas                  *Long           // The amount of gas provided for the call.
+	GasPrice             *hexutil.Big    // The price of each unit of gas, in wei.
+	MaxFeePerGas         *hexutil.Big    // The max price of each unit of gas, in wei (1559).
+	MaxPriorityFeePerGas *hexutil.Big    // The max tip of each unit of gas, in wei (1559).
+	Value                *hexutil.Big    // The value sent along with the call.
+	Data                 *hexutil.Bytes  // Any data sent with the call.
+}
+
+func (c CallData) toTransactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             c.GasPrice,
+		MaxFeePerGas:         c.MaxFeePerGas,
+		MaxPriorityFeePerGas: c.MaxPriorityFeePerGas,
+		Value:                c.Value,
+		Data:                 c.Data,
+	}
+	if c.Gas != nil {
+		gas := hexutil.Uint64(*c.Gas) //nolint:gosec // G115 // negative gas is rejected by the call
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult encapsulates the result of an invocation of the `call` accessor.
+type CallResult struct {
+	data    hexutil.Bytes  // The return data from the call
+	gasUsed hexutil.Uint64 // The amount of gas used
+	status  hexutil.Uint64 // The return status of the call - 0 for failure or 1 for success.
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// Pending represents the current pending state.
+type Pending struct {
+	r *Resolver
+}
+
+// pendingTransactions returns the Ethereum transactions of the mempool.
+func (p *Pending) pendingTransactions() ([]*rpctypes.RPCTransaction, error) {
+	pendingTxs, err := p.r.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := p.r.backend.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*rpctypes.RPCTransaction, 0, len(pendingTxs))
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				continue
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, 0, 0, nil, chainID.ToInt())
+			if err != nil {
+				return nil, err
+			}
+			txs = append(txs, rpcTx)
+		}
+	}
+	return txs, nil
+}
+
+func (p *Pending) TransactionCount() (hexutil.Uint64, error) {
+	txs, err := p.pendingTransactions()
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(len(txs)), nil
+}
+
+func (p *Pending) Transactions() (*[]*Transaction, error) {
+	txs, err := p.pendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		res = append(res, &Transaction{r: p.r, hash: tx.Hash, tx: tx})
+	}
+	return &res, nil
+}
+
+func (p *Pending) Account(args struct{ Address common.Address }) *Account {
+	return &Account{
+		r:           p.r,
+		address:     args.Address,
+		blockNumber: rpctypes.EthPendingBlockNumber,
+	}
+}
+
+func (p *Pending) Call(args struct{ Data CallData }) (*CallResult, error) {
+	return p.r.call(args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(args struct{ Data CallData }) (hexutil.Uint64, error) {
+	blockNumber := rpctypes.EthPendingBlockNumber
+	return p.r.backend.EstimateGas(args.Data.toTransactionArgs(), &blockNumber)
+}
+
+// Resolver is the top-level object in the GraphQL hierarchy.
+type Resolver struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewResolver creates the root resolver of the GraphQL queries.
+func NewResolver(logger log.Logger, backend Backend) *Resolver {
+	return &Resolver{
+		logger:  logger.With("api", "graphql"),
+		backend: backend,
+	}
+}
+
+// getBlockByNumber returns the block at the given height, nil if it is not found.
+func (r *Resolver) getBlockByNumber(number rpctypes.BlockNumber) (*Block, error) {
+	res, err := r.backend.GetBlockByNumber(number, true)
+	if err != nil {
+		return nil, err
+	}
+	return r.newBlock(res)
+}
+
+// newBlock returns the block resolver of the block returned by the backend.
+func (r *Resolver) newBlock(res map[string]interface{}) (*Block, error) {
+	block, err := decodeBlock(res)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return &Block{r: r, block: block}, nil
+}
+
+// call executes a local call with the gas cap of the backend.
+func (r *Resolver) call(data CallData, blockNumber rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(data.toTransactionArgs(), blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	status := hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)
+	if res.Failed() {
+		status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	}
+
+	return &CallResult{
+		data:    res.Ret,
+		gasUsed: hexutil.Uint64(res.GasUsed),
+		status:  status,
+	}, nil
+}
+
+// runFilter returns the logs matching the filter, within the block range and
+// logs caps of the backend.
+func (r *Resolver) runFilter(ctx context.Context, filter *filters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil || logs == nil {
+		return nil, err
+	}
+
+	res := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		res = append(res, &Log{
+			r:           r,
+			transaction: &Transaction{r: r, hash: log.TxHash},
+			log:         log,
+		})
+	}
+	return res, nil
+}
+
+func (r *Resolver) Block(args struct {
+	Number *Long
+	Hash   *common.Hash
+},
+) (*Block, error) {
+	switch {
+	case args.Number != nil && args.Hash != nil:
+		return nil, errBlockInvariant
+	case args.Hash != nil:
+		res, err := r.backend.GetBlockByHash(*args.Hash, true)
+		if err != nil {
+			return nil, err
+		}
+		return r.newBlock(res)
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		return r.getBlockByNumber(rpctypes.BlockNumber(*args.Number))
+	default:
+		return r.getBlockByNumber(rpctypes.EthLatestBlockNumber)
+	}
+}
+
+// Blocks returns the blocks between the two numbers, inclusive, within the block
+// range cap of the backend.
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+},
+) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	from := rpctypes.BlockNumber(*args.From)
+
+	var to rpctypes.BlockNumber
+	if args.To != nil {
+		to = rpctypes.BlockNumber(*args.To)
+	} else {
+		latest, err := r.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		to = rpctypes.BlockNumber(latest) //nolint:gosec // G115 // won't exceed int64
+	}
+
+	if to < from {
+		return nil, errInvalidBlockRange
+	}
+	if blockLimit := int64(r.backend.RPCBlockRangeCap()); int64(to-from) > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var blocks []*Block
+	for number := from; number <= to; number++ {
+		block, err := r.getBlockByNumber(number)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			// the next blocks are not committed either
+			break
+		}
+		blocks = append(blocks, block)
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (r *Resolver) Pending() *Pending {
+	return &Pending{r}
+}
+
+func (r *Resolver) Transaction(args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx, err := r.backend.GetTransactionByHash(args.Hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &Transaction{r: r, hash: args.Hash, tx: tx}, nil
+}
+
+func (r *Resolver) SendRawTransaction(args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(args.Data)
+}
+
+// FilterCriteria encapsulates the arguments to `logs` on the root resolver object.
+type FilterCriteria struct {
+	FromBlock *Long             // beginning of the queried range, nil means latest block
+	ToBlock   *Long             // end of the range, nil means latest block
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	begin := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+
+	filter := filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics)
+	return r.runFilter(ctx, filter)
+}
+
+func (r *Resolver) GasPrice() (hexutil.Big, error) {
+	gasPrice, err := r.backend.GasPrice()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *gasPrice, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas() (hexutil.Big, error) {
+	head, err := r.backend.CurrentHeader()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+
+	tipCap, err := r.backend.SuggestGasTipCap(head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tipCap), nil
+}
+
+func (r *Resolver) ChainID() (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState represents the synchronisation status returned from the `syncing` accessor.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.startingBlock
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// HighestBlock returns the current block, as the highest block known to the peers
+// is not tracked by the node.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// Syncing returns nil if the node is not catching up with the network.
+func (r *Resolver) Syncing() (*SyncState, error) {
+	res, err := r.backend.Syncing()
+	if err != nil {
+		return nil, err
+	}
+
+	progress, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var state SyncState
+	state.startingBlock, _ = progress["startingBlock"].(hexutil.Uint64)
+	state.currentBlock, _ = progress["currentBlock"].(hexutil.Uint64)
+	return &state, nil
+}
@@ -0,0 +1,455 @@
+package graphql
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	chainID      = big.NewInt(9001)
+	testAddress  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testContract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// testBackend serves the blocks, transactions and receipts of a test chain. The
+// methods that are not overridden panic through the nil embedded backend.
+type testBackend struct {
+	Backend
+
+	blocks   []map[string]interface{}
+	txs      map[common.Hash]*rpctypes.RPCTransaction
+	receipts map[common.Hash]map[string]interface{}
+	logs     map[int64][][]*ethtypes.Log
+}
+
+// newTestBackend returns the backend of a chain of the given number of blocks,
+// each holding a signed transaction emitting a log.
+func newTestBackend(t *testing.T, numBlocks int) *testBackend {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+
+	b := &testBackend{
+		txs:      make(map[common.Hash]*rpctypes.RPCTransaction),
+		receipts: make(map[common.Hash]map[string]interface{}),
+		logs:     make(map[int64][][]*ethtypes.Log),
+	}
+
+	for height := int64(1); height <= int64(numBlocks); height++ {
+		tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     uint64(height - 1), //nolint:gosec // G115 // test heights are positive
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(10),
+			Gas:       21000,
+			To:        &testContract,
+			Value:     big.NewInt(height),
+		})
+		require.NoError(t, err)
+
+		header := cmttypes.Header{
+			Height:         height,
+			Time:           time.Unix(height, 0),
+			AppHash:        make([]byte, 32),
+			ValidatorsHash: make([]byte, 32),
+		}
+		blockHash := common.BytesToHash(header.Hash())
+		rpcTx, err := rpctypes.NewRPCTransaction(tx, blockHash, uint64(height), 0, big.NewInt(2), chainID) //nolint:gosec // G115
+		require.NoError(t, err)
+
+		log := &ethtypes.Log{
+			Address:     testContract,
+			Topics:      []common.Hash{{byte(height)}},
+			Data:        []byte{byte(height)},
+			BlockNumber: uint64(height), //nolint:gosec // G115 // test heights are positive
+			TxHash:      tx.Hash(),
+			BlockHash:   blockHash,
+		}
+		b.logs[height] = [][]*ethtypes.Log{{log}}
+
+		block := rpctypes.FormatBlock(header, 100, 1000000, big.NewInt(21000), []interface{}{rpcTx},
+			ethtypes.Bloom{}, testAddress, big.NewInt(2))
+		b.blocks = append(b.blocks, block)
+		b.txs[tx.Hash()] = rpcTx
+		b.receipts[tx.Hash()] = map[string]interface{}{
+			"status":            hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+			"cumulativeGasUsed": hexutil.Uint64(21000),
+			"logsBloom":         ethtypes.Bloom{},
+			"logs":              []*ethtypes.Log{log},
+			"transactionHash":   tx.Hash(),
+			"contractAddress":   nil,
+			"gasUsed":           hexutil.Uint64(21000),
+			"blockHash":         blockHash.Hex(),
+			"blockNumber":       hexutil.Uint64(height), //nolint:gosec // G115 // test heights are positive
+			"transactionIndex":  hexutil.Uint64(0),
+			"effectiveGasPrice": (*hexutil.Big)(big.NewInt(3)),
+			"from":              crypto.PubkeyToAddress(key.PublicKey),
+			"to":                &testContract,
+			"type":              hexutil.Uint(tx.Type()),
+		}
+	}
+	return b
+}
+
+func (b *testBackend) GetBlockByNumber(blockNum rpctypes.BlockNumber, _ bool) (map[string]interface{}, error) {
+	if blockNum < 0 {
+		blockNum = rpctypes.BlockNumber(len(b.blocks))
+	}
+	if blockNum == 0 || int(blockNum) > len(b.blocks) {
+		return nil, nil
+	}
+	return b.blocks[blockNum-1], nil
+}
+
+func (b *testBackend) GetBlockByHash(hash common.Hash, _ bool) (map[string]interface{}, error) {
+	for _, block := range b.blocks {
+		if common.BytesToHash(block["hash"].(hexutil.Bytes)) == hash {
+			return block, nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *testBackend) HeaderByNumber(rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(int64(len(b.blocks)))}, nil
+}
+
+func (b *testBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(len(b.blocks)), nil
+}
+
+func (b *testBackend) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	return b.txs[hash], nil
+}
+
+func (b *testBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	return b.receipts[hash], nil
+}
+
+func (b *testBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	return b.logs[*height], nil
+}
+
+func (b *testBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	return &coretypes.ResultBlockResults{Height: *height}, nil
+}
+
+func (b *testBackend) BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	for _, logs := range b.logs[blockRes.Height] {
+		for _, log := range logs {
+			bloom.Add(log.Address.Bytes())
+			for _, topic := range log.Topics {
+				bloom.Add(topic[:])
+			}
+		}
+	}
+	return bloom, nil
+}
+
+func (b *testBackend) BlockLogs(blockRes *coretypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
+	return b.logs[blockRes.Height], nil
+}
+
+func (b *testBackend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	// the balance is the block number to check the queried height
+	balance := big.NewInt(blockNrOrHash.BlockNumber.Int64())
+	if address != testAddress {
+		balance.SetInt64(0)
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+func (b *testBackend) DoCall(args evmtypes.TransactionArgs, _ rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error) {
+	res := &evmtypes.MsgEthereumTxResponse{Ret: []byte{1, 2}, GasUsed: 100}
+	if args.To == nil {
+		res.VmError = "out of gas"
+	}
+	return res, nil
+}
+
+func (b *testBackend) ChainID() (*hexutil.Big, error) {
+	return (*hexutil.Big)(chainID), nil
+}
+
+func (b *testBackend) RPCLogsCap() int32 {
+	return 2
+}
+
+func (b *testBackend) RPCBlockRangeCap() int32 {
+	return 2
+}
+
+// query executes the query against the handler and returns the decoded response.
+func query(t *testing.T, b Backend, q string) (data map[string]interface{}, errs []string) {
+	t.Helper()
+
+	handler, err := NewHandler(log.NewNopLogger(), b, 1<<20)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]string{"query": q})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var res struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res), rec.Body.String())
+	for _, err := range res.Errors {
+		errs = append(errs, err.Message)
+	}
+
+	if len(errs) > 0 {
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	} else {
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	return res.Data, errs
+}
+
+func TestBlock(t *testing.T) {
+	b := newTestBackend(t, 3)
+	hash := common.BytesToHash(b.blocks[1]["hash"].(hexutil.Bytes))
+
+	testCases := []struct {
+		name    string
+		query   string
+		expData string
+		expErr  string
+	}{
+		{
+			"latest block",
+			`{ block { number } }`,
+			`{"block":{"number":"0x3"}}`,
+			"",
+		},
+		{
+			"block by number",
+			`{ block(number: 2) { number parent { number } transactionCount baseFeePerGas ommerCount } }`,
+			`{"block":{"number":"0x2","parent":{"number":"0x1"},"transactionCount":"0x1","baseFeePerGas":"0x2","ommerCount":"0x0"}}`,
+			"",
+		},
+		{
+			"block by hash",
+			`{ block(hash: "` + hash.Hex() + `") { number hash } }`,
+			`{"block":{"number":"0x2","hash":"` + hash.Hex() + `"}}`,
+			"",
+		},
+		{
+			"block not found",
+			`{ block(number: 4) { number } }`,
+			`{"block":null}`,
+			"",
+		},
+		{
+			"number and hash",
+			`{ block(number: 2, hash: "` + hash.Hex() + `") { number } }`,
+			`{"block":null}`,
+			errBlockInvariant.Error(),
+		},
+		{
+			"next base fee of the latest block",
+			`{ block(number: 3) { nextBaseFeePerGas } }`,
+			`{"block":{"nextBaseFeePerGas":null}}`,
+			"",
+		},
+		{
+			"account at block",
+			`{ block(number: 2) { account(address: "` + testAddress.Hex() + `") { balance } miner(block: 1) { balance } } }`,
+			`{"block":{"account":{"balance":"0x2"},"miner":{"balance":"0x1"}}}`,
+			"",
+		},
+		{
+			"call",
+			`{ block { call(data: {to: "` + testContract.Hex() + `"}) { data gasUsed status } } }`,
+			`{"block":{"call":{"data":"0x0102","gasUsed":"0x64","status":"0x1"}}}`,
+			"",
+		},
+		{
+			"failed call",
+			`{ pending { call(data: {}) { status } } }`,
+			`{"pending":{"call":{"status":"0x0"}}}`,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, errs := query(t, b, tc.query)
+			if tc.expErr != "" {
+				require.Len(t, errs, 1)
+				require.Contains(t, errs[0], tc.expErr)
+			} else {
+				require.Empty(t, errs)
+			}
+
+			bz, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expData, string(bz))
+		})
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	b := newTestBackend(t, 4)
+
+	data, errs := query(t, b, `{ blocks(from: 2) { number } }`)
+	require.Empty(t, errs)
+	require.Len(t, data["blocks"], 3)
+
+	// the blocks that are not committed are not returned
+	data, errs = query(t, b, `{ blocks(from: 3, to: 5) { number } }`)
+	require.Empty(t, errs)
+	require.Len(t, data["blocks"], 2)
+
+	_, errs = query(t, b, `{ blocks(from: 1, to: 4) { number } }`)
+	require.Equal(t, []string{"maximum [from, to] blocks distance: 2"}, errs)
+
+	_, errs = query(t, b, `{ blocks(from: 2, to: 1) { number } }`)
+	require.Equal(t, []string{errInvalidBlockRange.Error()}, errs)
+}
+
+func TestTransaction(t *testing.T) {
+	b := newTestBackend(t, 2)
+	block, err := b.GetBlockByNumber(2, true)
+	require.NoError(t, err)
+	rpcTx := block["transactions"].([]interface{})[0].(*rpctypes.RPCTransaction)
+
+	data, errs := query(t, b, `{ transaction(hash: "`+rpcTx.Hash.Hex()+`") {
+		hash index value effectiveTip effectiveGasPrice status gasUsed raw rawReceipt
+		block { number }
+		to { address }
+		logs { index topics account { address } transaction { hash } }
+	} }`)
+	require.Empty(t, errs)
+
+	tx := data["transaction"].(map[string]interface{})
+	require.Equal(t, rpcTx.Hash.Hex(), tx["hash"])
+	require.Equal(t, "0x0", tx["index"])
+	require.Equal(t, "0x2", tx["value"])
+	require.Equal(t, "0x1", tx["effectiveTip"])
+	require.Equal(t, "0x3", tx["effectiveGasPrice"])
+	require.Equal(t, "0x1", tx["status"])
+	require.Equal(t, "0x5208", tx["gasUsed"])
+	require.Equal(t, map[string]interface{}{"number": "0x2"}, tx["block"])
+	require.Equal(t, map[string]interface{}{"address": strings.ToLower(testContract.Hex())}, tx["to"])
+
+	// the raw transaction is the canonical encoding of the signed transaction
+	var ethTx ethtypes.Transaction
+	require.NoError(t, ethTx.UnmarshalBinary(hexutil.MustDecode(tx["raw"].(string))))
+	require.Equal(t, rpcTx.Hash, ethTx.Hash())
+
+	var receipt ethtypes.Receipt
+	require.NoError(t, receipt.UnmarshalBinary(hexutil.MustDecode(tx["rawReceipt"].(string))))
+	require.Equal(t, uint64(21000), receipt.CumulativeGasUsed)
+	require.Len(t, receipt.Logs, 1)
+
+	logs := tx["logs"].([]interface{})
+	require.Len(t, logs, 1)
+	require.Equal(t, map[string]interface{}{"hash": rpcTx.Hash.Hex()}, logs[0].(map[string]interface{})["transaction"])
+
+	data, errs = query(t, b, `{ transaction(hash: "`+common.Hash{}.Hex()+`") { hash } }`)
+	require.Empty(t, errs)
+	require.Nil(t, data["transaction"])
+}
+
+func TestLogs(t *testing.T) {
+	b := newTestBackend(t, 3)
+
+	data, errs := query(t, b, `{ logs(filter: {fromBlock: 1, toBlock: 2}) { data transaction { hash } } }`)
+	require.Empty(t, errs)
+	require.Len(t, data["logs"], 2)
+
+	// topics filter
+	topic := common.Hash{2}
+	data, errs = query(t, b, `{ logs(filter: {fromBlock: 1, toBlock: 3, topics: [["`+topic.Hex()+`"]]}) { data } }`)
+	require.Empty(t, errs)
+	require.Equal(t, []interface{}{map[string]interface{}{"data": "0x02"}}, data["logs"])
+
+	// block range cap
+	_, errs = query(t, b, `{ logs(filter: {fromBlock: 1, toBlock: 4}) { data } }`)
+	require.Equal(t, []string{"maximum [from, to] blocks distance: 2"}, errs)
+
+	// logs cap
+	_, errs = query(t, b, `{ logs(filter: {fromBlock: 1, toBlock: 3}) { data } }`)
+	require.Equal(t, []string{"query returned more than 2 results"}, errs)
+
+	_, errs = query(t, b, `{ logs(filter: {fromBlock: 2, toBlock: 1}) { data } }`)
+	require.Equal(t, []string{errInvalidBlockRange.Error()}, errs)
+}
+
+func TestHandlerInvalidBody(t *testing.T) {
+	handler, err := NewHandler(log.NewNopLogger(), newTestBackend(t, 1), 16)
+	require.NoError(t, err)
+
+	for _, body := range []string{"{", `{"query": "{ block { number } }"}`} {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	b := newTestBackend(t, 3)
+
+	// depth cap
+	q := "number"
+	for i := 0; i < maxQueryDepth; i++ {
+		q = "parent { " + q + " }"
+	}
+	_, errs := query(t, b, "{ block { "+q+" } }")
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "exceeds max depth")
+
+	// complexity cap, each block resolves the block and its account balance
+	handler, err := NewHandler(log.NewNopLogger(), b, 1<<20)
+	require.NoError(t, err)
+
+	body := `{"query": "{ blocks(from: 1, to: 3) { account(address: \"` + testAddress.Hex() + `\") { balance } } }"}`
+	for _, tc := range []struct {
+		complexity int64
+		expErr     string
+	}{
+		{10, ""},
+		{5, "query is too complex, it resolves more than 5 fields"},
+	} {
+		handler.maxComplexity = tc.complexity
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if tc.expErr == "" {
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		} else {
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			require.JSONEq(t, `{"errors": [{"message": "`+tc.expErr+`"}]}`, rec.Body.String())
+		}
+	}
+}
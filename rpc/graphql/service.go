@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/trace"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// maxQueryDepth caps the nesting depth of the query selections
+	maxQueryDepth = 10
+	// maxQueryComplexity caps the number of fields resolved by a query through
+	// resolver methods, such as the blocks, transactions and logs loaded from the
+	// backend
+	maxQueryComplexity = 10_000
+	// maxParallelism caps the number of fields of a query resolved concurrently
+	maxParallelism = 10
+)
+
+var errQueryTooComplex = errors.New("query is too complex")
+
+// Handler is the HTTP handler answering the GraphQL queries of the POST requests
+// with a JSON body holding the query, operation name and variables.
+type Handler struct {
+	schema        *graphql.Schema
+	bodyLimit     int64
+	maxComplexity int64
+}
+
+// NewHandler returns the handler resolving the EIP-1767 schema queries with the
+// backend. The request bodies are limited to bodyLimit bytes, and the queries to
+// maxQueryDepth nested selections and maxQueryComplexity resolved fields.
+func NewHandler(logger log.Logger, backend Backend, bodyLimit int) (*Handler, error) {
+	schema, err := graphql.ParseSchema(
+		schema,
+		NewResolver(logger, backend),
+		graphql.MaxDepth(maxQueryDepth),
+		graphql.MaxParallelism(maxParallelism),
+		graphql.Tracer(complexityTracer{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		schema:        schema,
+		bodyLimit:     int64(bodyLimit),
+		maxComplexity: maxQueryComplexity,
+	}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+
+	body := http.MaxBytesReader(w, r.Body, h.bodyLimit)
+	if err := json.NewDecoder(body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithCancelCause(r.Context())
+	defer cancel(nil)
+	ctx = context.WithValue(ctx, complexityKey{}, &complexity{limit: h.maxComplexity, cancel: cancel})
+
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	if cause := context.Cause(ctx); errors.Is(cause, errQueryTooComplex) {
+		response = &graphql.Response{Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("%s", cause)}}
+	}
+
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
+
+type complexityKey struct{}
+
+// complexity counts the fields resolved by a query through resolver methods.
+type complexity struct {
+	fields atomic.Int64
+	limit  int64
+	cancel context.CancelCauseFunc
+}
+
+// complexityTracer cancels the queries once they resolved more fields than their
+// complexity limit, so that their remaining fields are not resolved.
+type complexityTracer struct {
+	trace.OpenTracingTracer
+}
+
+// TraceField implements trace.Tracer.
+func (t complexityTracer) TraceField(
+	ctx context.Context,
+	label, typeName, fieldName string,
+	trivial bool,
+	args map[string]interface{},
+) (context.Context, trace.TraceFieldFinishFunc) {
+	if c, ok := ctx.Value(complexityKey{}).(*complexity); ok && !trivial {
+		if c.fields.Add(1) > c.limit {
+			c.cancel(fmt.Errorf("%w, it resolves more than %d fields", errQueryTooComplex, c.limit))
+		}
+	}
+	return t.OpenTracingTracer.TraceField(ctx, label, typeName, fieldName, trivial, args)
+}
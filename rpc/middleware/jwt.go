@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// jwtExpiryTimeout is the maximum drift allowed between the issued-at claim of a
+// token and the local time, which bounds the replay window of a token.
+const jwtExpiryTimeout = 60 * time.Second
+
+// JWTSecretLength is the length in bytes of the JWT secret.
+const JWTSecretLength = 32
+
+// JWTHandler is an HTTP middleware that only forwards the requests bearing a valid
+// HS256 JWT signed with the shared secret to the next handler, following the
+// authentication scheme of the go-ethereum authenticated RPC. The token must have
+// an issued-at (iat) claim within 60 seconds of the local time.
+type JWTHandler struct {
+	keyFunc jwt.Keyfunc
+	next    http.Handler
+}
+
+// NewJWTHandler returns the handler authenticating the requests with the secret
+// in front of the next handler.
+func NewJWTHandler(secret []byte, next http.Handler) *JWTHandler {
+	return &JWTHandler{
+		keyFunc: func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		},
+		next: next,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *JWTHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var strToken string
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		strToken = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if strToken == "" {
+		http.Error(w, "missing token", http.StatusUnauthorized)
+		return
+	}
+
+	// the issued-at claim is checked below to allow for some clock drift
+	var claims jwt.RegisteredClaims
+	token, err := jwt.ParseWithClaims(strToken, &claims, h.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithoutClaimsValidation(),
+	)
+
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case !token.Valid:
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		http.Error(w, "token is expired", http.StatusUnauthorized)
+	case claims.IssuedAt == nil:
+		http.Error(w, "missing issued-at", http.StatusUnauthorized)
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "stale token", http.StatusUnauthorized)
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "future token", http.StatusUnauthorized)
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
+
+// LoadJWTSecret reads the hex encoded JWT secret from the file. If the file does
+// not exist, a new random secret is generated and written to it.
+func LoadJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != JWTSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret length %d, expected %d bytes", len(secret), JWTSecretLength)
+		}
+		return secret, nil
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	secret := make([]byte, JWTSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTHandler(t *testing.T) {
+	secret := make([]byte, JWTSecretLength)
+	secret[0] = 1
+	otherSecret := make([]byte, JWTSecretLength)
+
+	sign := func(key []byte, method jwt.SigningMethod, claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + token
+	}
+
+	now := time.Now()
+	testCases := []struct {
+		name    string
+		auth    string
+		expPass bool
+	}{
+		{"valid token", sign(secret, jwt.SigningMethodHS256, jwt.MapClaims{"iat": now.Unix()}), true},
+		{"valid token with drift", sign(secret, jwt.SigningMethodHS256, jwt.MapClaims{"iat": now.Add(30 * time.Second).Unix()}), true},
+		{"missing token", "", false},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", false},
+		{"wrong secret", sign(otherSecret, jwt.SigningMethodHS256, jwt.MapClaims{"iat": now.Unix()}), false},
+		{"wrong signing method", sign(secret, jwt.SigningMethodHS512, jwt.MapClaims{"iat": now.Unix()}), false},
+		{"missing issued-at", sign(secret, jwt.SigningMethodHS256, jwt.MapClaims{}), false},
+		{"stale token", sign(secret, jwt.SigningMethodHS256, jwt.MapClaims{"iat": now.Add(-2 * time.Minute).Unix()}), false},
+		{"future token", sign(secret, jwt.SigningMethodHS256, jwt.MapClaims{"iat": now.Add(2 * time.Minute).Unix()}), false},
+		{"expired token", sign(secret, jwt.SigningMethodHS256, jwt.MapClaims{"iat": now.Unix(), "exp": now.Add(-time.Second).Unix()}), false},
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := NewJWTHandler(secret, next)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if tc.expPass {
+				require.Equal(t, http.StatusOK, rec.Code)
+			} else {
+				require.Equal(t, http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
+
+func TestLoadJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+
+	// a new secret is written if the file does not exist
+	path := filepath.Join(dir, "jwt.hex")
+	secret, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, JWTSecretLength)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	// invalid secret length
+	invalidPath := filepath.Join(dir, "invalid.hex")
+	require.NoError(t, os.WriteFile(invalidPath, []byte("0x1234\n"), 0o600))
+	_, err = LoadJWTSecret(invalidPath)
+	require.Error(t, err)
+
+	// missing directory
+	_, err = LoadJWTSecret(filepath.Join(dir, "missing", "jwt.hex"))
+	require.Error(t, err)
+}
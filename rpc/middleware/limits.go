@@ -0,0 +1,418 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// ErrCodeMethodDenied is the JSON-RPC error code returned for calls to methods
+	// that are denied or not allowed (EIP-1474 "method not supported").
+	ErrCodeMethodDenied = -32004
+	// ErrCodeRateLimited is the JSON-RPC error code returned for calls rejected by
+	// a rate limit (EIP-1474 "limit exceeded").
+	ErrCodeRateLimited = -32005
+
+	// sweepInterval is the interval at which the buckets that are full again
+	// are removed.
+	sweepInterval = time.Minute
+
+	// GraphQLMethod is the method the GraphQL requests are checked as, so that the
+	// limits of the eth namespace, whose data GraphQL serves, apply to them.
+	GraphQLMethod = "eth_graphql"
+)
+
+var (
+	deniedCounter     = metrics.NewRegisteredCounter("rpc/rejected/denied", nil)
+	rateLimitCounter  = metrics.NewRegisteredCounter("rpc/rejected/ratelimit", nil)
+	batchLimitCounter = metrics.NewRegisteredCounter("rpc/rejected/batchlimit", nil)
+)
+
+// jsonrpcMessage defines the fields of a JSON-RPC request that are needed to
+// filter it.
+type jsonrpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// jsonrpcError defines a JSON-RPC error response.
+type jsonrpcError struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// bucket is the token bucket of a client IP for a rate limited target.
+type bucket struct {
+	limiter *rate.Limiter
+	burst   float64
+}
+
+// LimitsHandler is an HTTP middleware that rejects the JSON-RPC calls to denied
+// methods and the calls exceeding the per client IP rate limits before they
+// reach the JSON-RPC server. Rejected calls are answered with JSON-RPC errors,
+// so a batch request only fails for the calls that are rejected.
+type LimitsHandler struct {
+	next   http.Handler
+	logger log.Logger
+
+	allowed    map[string]bool
+	denied     map[string]bool
+	rateLimits map[string]config.RateLimit
+	counters   map[string]*metrics.Counter
+
+	batchLimit int
+	bodyLimit  int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewLimitsHandler returns the handler enforcing the method allow and deny lists,
+// the rate limits and the batch request limit of the JSON-RPC configuration in
+// front of the next handler. The next handler is returned as is when no method
+// restriction nor rate limit is configured.
+func NewLimitsHandler(next http.Handler, cfg config.JSONRPCConfig, logger log.Logger) (http.Handler, error) {
+	h, err := newLimitsHandler(next, cfg, logger)
+	if err != nil || h == nil {
+		return next, err
+	}
+	return h, nil
+}
+
+// NewGraphQLLimitsHandler returns the handler enforcing the method allow and deny
+// lists and the rate limits of the JSON-RPC configuration on the GraphQL requests,
+// which are checked as calls to GraphQLMethod. The next handler is returned as is
+// when no method restriction nor rate limit is configured.
+func NewGraphQLLimitsHandler(next http.Handler, cfg config.JSONRPCConfig, logger log.Logger) (http.Handler, error) {
+	h, err := newLimitsHandler(next, cfg, logger)
+	if err != nil || h == nil {
+		return next, err
+	}
+	return &graphQLLimitsHandler{h}, nil
+}
+
+// newLimitsHandler returns the limits handler of the configuration, nil if no
+// method restriction nor rate limit is configured.
+func newLimitsHandler(next http.Handler, cfg config.JSONRPCConfig, logger log.Logger) (*LimitsHandler, error) {
+	limits, err := config.ParseRateLimits(cfg.RateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.AllowedMethods) == 0 && len(cfg.DeniedMethods) == 0 && len(limits) == 0 {
+		return nil, nil
+	}
+
+	h := &LimitsHandler{
+		next:       next,
+		logger:     logger.With("module", "json-rpc-limits"),
+		allowed:    make(map[string]bool, len(cfg.AllowedMethods)),
+		denied:     make(map[string]bool, len(cfg.DeniedMethods)),
+		rateLimits: make(map[string]config.RateLimit, len(limits)),
+		counters:   make(map[string]*metrics.Counter, len(limits)),
+		batchLimit: cfg.BatchRequestLimit,
+		bodyLimit:  cfg.HTTPBodyLimit,
+		buckets:    make(map[string]*bucket),
+		lastSweep:  time.Now(),
+	}
+
+	for _, method := range cfg.AllowedMethods {
+		h.allowed[method] = true
+	}
+	for _, method := range cfg.DeniedMethods {
+		h.denied[method] = true
+	}
+	for _, limit := range limits {
+		h.rateLimits[limit.Target] = limit
+		h.counters[limit.Target] = metrics.GetOrRegisterCounter("rpc/rejected/ratelimit/"+limit.Target, nil)
+	}
+
+	return h, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *LimitsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// requests above the body limit are rejected by the JSON-RPC server
+	body, err := io.ReadAll(io.LimitReader(r.Body, int64(h.bodyLimit)+1))
+	if err != nil || len(body) > h.bodyLimit {
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	raw := bytes.TrimLeft(body, " \t\r\n")
+	isBatch := len(raw) > 0 && raw[0] == '['
+
+	var msgs []jsonrpcMessage
+	var rawMsgs []json.RawMessage
+	if isBatch {
+		if err := json.Unmarshal(raw, &rawMsgs); err == nil {
+			msgs = make([]jsonrpcMessage, len(rawMsgs))
+			for i, rawMsg := range rawMsgs {
+				// invalid calls are left to the JSON-RPC server
+				_ = json.Unmarshal(rawMsg, &msgs[i])
+			}
+		}
+	} else {
+		var msg jsonrpcMessage
+		if err := json.Unmarshal(raw, &msg); err == nil {
+			msgs = []jsonrpcMessage{msg}
+			rawMsgs = []json.RawMessage{raw}
+		}
+	}
+
+	// batches above the limit are rejected as a whole by the JSON-RPC server
+	if isBatch && h.batchLimit > 0 && len(msgs) > h.batchLimit {
+		batchLimitCounter.Inc(1)
+		h.serveNext(w, r, body)
+		return
+	}
+
+	if len(msgs) == 0 {
+		h.serveNext(w, r, body)
+		return
+	}
+
+	ip := clientIP(r)
+	rejected := make([]*jsonrpcError, len(msgs))
+	accepted := make([]json.RawMessage, 0, len(msgs))
+	for i, msg := range msgs {
+		rejected[i] = h.check(ip, msg)
+		if rejected[i] == nil {
+			accepted = append(accepted, rawMsgs[i])
+		}
+	}
+
+	switch {
+	case len(accepted) == len(msgs):
+		h.serveNext(w, r, body)
+	case !isBatch:
+		writeJSON(w, rejected[0])
+	case len(accepted) == 0:
+		writeJSON(w, rejected)
+	default:
+		h.serveBatch(w, r, accepted, rejected)
+	}
+}
+
+// check returns the error response of the call if it is denied or rate limited,
+// nil otherwise.
+func (h *LimitsHandler) check(clientIP string, msg jsonrpcMessage) *jsonrpcError {
+	if msg.Method == "" {
+		// invalid call, the JSON-RPC server returns the error
+		return nil
+	}
+
+	namespace, _, _ := strings.Cut(msg.Method, "_")
+
+	if h.denied[msg.Method] || h.denied[namespace] ||
+		(len(h.allowed) > 0 && !h.allowed[msg.Method] && !h.allowed[namespace]) {
+		deniedCounter.Inc(1)
+		return newError(msg.ID, ErrCodeMethodDenied, fmt.Sprintf("method %s is not allowed", msg.Method))
+	}
+
+	target := msg.Method
+	limit, found := h.rateLimits[target]
+	if !found {
+		target = namespace
+		if limit, found = h.rateLimits[target]; !found {
+			return nil
+		}
+	}
+
+	if !h.allow(clientIP, limit) {
+		rateLimitCounter.Inc(1)
+		h.counters[target].Inc(1)
+		h.logger.Debug("rate limited JSON-RPC call", "method", msg.Method, "client", clientIP)
+		return newError(msg.ID, ErrCodeRateLimited, fmt.Sprintf("rate limit exceeded for %s", target))
+	}
+
+	return nil
+}
+
+// allow takes a token from the bucket of the client IP for the rate limit.
+func (h *LimitsHandler) allow(clientIP string, limit config.RateLimit) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(h.lastSweep) > sweepInterval {
+		// full buckets behave as new ones, so removing them is lossless
+		for key, b := range h.buckets {
+			if b.limiter.TokensAt(now) >= b.burst {
+				delete(h.buckets, key)
+			}
+		}
+		h.lastSweep = now
+	}
+
+	key := clientIP + "|" + limit.Target
+	b, found := h.buckets[key]
+	if !found {
+		b = &bucket{
+			limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst),
+			burst:   float64(limit.Burst),
+		}
+		h.buckets[key] = b
+	}
+
+	return b.limiter.AllowN(now, 1)
+}
+
+// graphQLLimitsHandler rejects the GraphQL requests to GraphQLMethod that are denied
+// or rate limited with a GraphQL error response.
+type graphQLLimitsHandler struct {
+	*LimitsHandler
+}
+
+// ServeHTTP implements http.Handler.
+func (h *graphQLLimitsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rejected := h.check(clientIP(r), jsonrpcMessage{Method: GraphQLMethod})
+	if rejected == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	status := http.StatusForbidden
+	if rejected.Error.Code == ErrCodeRateLimited {
+		status = http.StatusTooManyRequests
+	}
+
+	bz, err := json.Marshal(map[string]interface{}{
+		"errors": []map[string]string{{"message": rejected.Error.Message}},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(bz)
+}
+
+// serveNext forwards the request with the already read body to the next handler.
+func (h *LimitsHandler) serveNext(w http.ResponseWriter, r *http.Request, body []byte) {
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	h.next.ServeHTTP(w, r)
+}
+
+// serveBatch forwards the accepted calls of a batch to the next handler and merges
+// its responses with the errors of the rejected calls.
+func (h *LimitsHandler) serveBatch(w http.ResponseWriter, r *http.Request, accepted []json.RawMessage, rejected []*jsonrpcError) {
+	body, err := json.Marshal(accepted)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rec := &responseRecorder{header: make(http.Header), status: http.StatusOK}
+	h.serveNext(rec, r, body)
+
+	var responses []json.RawMessage
+	if rec.status != http.StatusOK || json.Unmarshal(rec.body.Bytes(), &responses) != nil {
+		// not a batch response (e.g. batch response too large), return it as is
+		copyHeader(w.Header(), rec.header)
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(rec.body.Bytes())
+		return
+	}
+
+	for _, errResp := range rejected {
+		if errResp == nil {
+			continue
+		}
+		bz, err := json.Marshal(errResp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		responses = append(responses, bz)
+	}
+
+	copyHeader(w.Header(), rec.header)
+	writeJSON(w, responses)
+}
+
+// clientIP returns the IP address of the client that sent the request. The
+// X-Forwarded-For header is only trusted from loopback addresses, which is the
+// case of the requests forwarded by the WebSocket server or by a local reverse
+// proxy. Only its rightmost entry is used, as it is the one appended by the
+// proxy while the previous ones are set by the client and can be spoofed.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			forwarded := values[len(values)-1]
+			if i := strings.LastIndex(forwarded, ","); i >= 0 {
+				forwarded = forwarded[i+1:]
+			}
+			if forwarded = strings.TrimSpace(forwarded); forwarded != "" {
+				return forwarded
+			}
+		}
+	}
+
+	return host
+}
+
+func newError(id json.RawMessage, code int, message string) *jsonrpcError {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	resp := &jsonrpcError{Version: "2.0", ID: id}
+	resp.Error.Code = code
+	resp.Error.Message = message
+	return resp
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func copyHeader(dst, src http.Header) {
+	for key, values := range src {
+		dst[key] = values
+	}
+	dst.Del("Content-Length")
+}
+
+// responseRecorder is an http.ResponseWriter that keeps the response in memory.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header { return r.header }
+
+func (r *responseRecorder) Write(bz []byte) (int, error) { return r.body.Write(bz) }
+
+func (r *responseRecorder) WriteHeader(status int) { r.status = status }
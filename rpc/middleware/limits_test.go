@@ -0,0 +1,277 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log"
+)
+
+type testService struct{}
+
+func (testService) Echo(s string) string { return s }
+
+type response struct {
+	ID     int    `json:"id"`
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newTestHandler(t *testing.T, cfg config.JSONRPCConfig) http.Handler {
+	t.Helper()
+
+	server := ethrpc.NewServer()
+	server.SetBatchLimits(cfg.BatchRequestLimit, cfg.BatchResponseMaxSize)
+	require.NoError(t, server.RegisterName("eth", testService{}))
+	require.NoError(t, server.RegisterName("debug", testService{}))
+
+	handler, err := NewLimitsHandler(server, cfg, log.NewNopLogger())
+	require.NoError(t, err)
+	return handler
+}
+
+func call(t *testing.T, handler http.Handler, remoteAddr, body string, header http.Header) []response {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.RemoteAddr = remoteAddr
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var responses []response
+	if strings.HasPrefix(strings.TrimSpace(body), "[") {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responses), rec.Body.String())
+	} else {
+		var resp response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func request(id int, method string) string {
+	bz, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  method,
+		"params":  []string{"ok"},
+	})
+	return string(bz)
+}
+
+func TestNewLimitsHandler(t *testing.T) {
+	next := http.NotFoundHandler()
+
+	handler, err := NewLimitsHandler(next, *config.DefaultJSONRPCConfig(), log.NewNopLogger())
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+	_, ok := handler.(*LimitsHandler)
+	require.False(t, ok, "expected the next handler without limits")
+
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.RateLimits = []string{"debug:1"}
+	_, err = NewLimitsHandler(next, cfg, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestLimitsHandlerMethods(t *testing.T) {
+	testCases := []struct {
+		name    string
+		allowed []string
+		denied  []string
+		method  string
+		expPass bool
+	}{
+		{"allowed namespace", []string{"eth"}, nil, "eth_echo", true},
+		{"allowed method", []string{"debug_echo"}, nil, "debug_echo", true},
+		{"not in allow list", []string{"eth"}, nil, "debug_echo", false},
+		{"denied namespace", nil, []string{"debug"}, "debug_echo", false},
+		{"denied method", nil, []string{"debug_echo"}, "debug_echo", false},
+		{"not in deny list", nil, []string{"debug"}, "eth_echo", true},
+		{"deny takes precedence over allow", []string{"debug"}, []string{"debug_echo"}, "debug_echo", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *config.DefaultJSONRPCConfig()
+			cfg.AllowedMethods = tc.allowed
+			cfg.DeniedMethods = tc.denied
+			handler := newTestHandler(t, cfg)
+
+			responses := call(t, handler, "10.0.0.1:1234", request(1, tc.method), nil)
+			require.Len(t, responses, 1)
+			require.Equal(t, 1, responses[0].ID)
+			if tc.expPass {
+				require.Nil(t, responses[0].Error)
+				require.Equal(t, "ok", responses[0].Result)
+			} else {
+				require.NotNil(t, responses[0].Error)
+				require.Equal(t, ErrCodeMethodDenied, responses[0].Error.Code)
+			}
+		})
+	}
+}
+
+func TestLimitsHandlerRateLimits(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.RateLimits = []string{"debug:0.001:2", "eth_echo:0.001:1"}
+	handler := newTestHandler(t, cfg)
+
+	// namespace limit
+	for i := 0; i < 2; i++ {
+		responses := call(t, handler, "10.0.0.1:1234", request(i, "debug_echo"), nil)
+		require.Nil(t, responses[0].Error)
+	}
+	responses := call(t, handler, "10.0.0.1:1234", request(3, "debug_echo"), nil)
+	require.NotNil(t, responses[0].Error)
+	require.Equal(t, ErrCodeRateLimited, responses[0].Error.Code)
+	require.Equal(t, 3, responses[0].ID)
+
+	// method limit takes precedence over the namespace
+	responses = call(t, handler, "10.0.0.1:1234", request(4, "eth_echo"), nil)
+	require.Nil(t, responses[0].Error)
+	responses = call(t, handler, "10.0.0.1:1234", request(5, "eth_echo"), nil)
+	require.NotNil(t, responses[0].Error)
+
+	// buckets are per client IP
+	responses = call(t, handler, "10.0.0.2:1234", request(6, "debug_echo"), nil)
+	require.Nil(t, responses[0].Error)
+
+	// forwarded address is only trusted from loopback
+	header := http.Header{"X-Forwarded-For": []string{"10.0.0.3"}}
+	responses = call(t, handler, "10.0.0.1:1234", request(7, "eth_echo"), header)
+	require.NotNil(t, responses[0].Error)
+	responses = call(t, handler, "127.0.0.1:1234", request(8, "eth_echo"), header)
+	require.Nil(t, responses[0].Error)
+	responses = call(t, handler, "127.0.0.1:1234", request(9, "eth_echo"), header)
+	require.NotNil(t, responses[0].Error)
+
+	// spoofed forwarded entries set by the client don't reset the bucket of the
+	// address appended by the proxy
+	spoofed := http.Header{"X-Forwarded-For": []string{"10.0.0.4, 10.0.0.3"}}
+	responses = call(t, handler, "127.0.0.1:1234", request(10, "eth_echo"), spoofed)
+	require.NotNil(t, responses[0].Error)
+}
+
+func TestGraphQLLimitsHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	})
+
+	testCases := []struct {
+		name      string
+		allowed   []string
+		denied    []string
+		limits    []string
+		expStatus []int
+	}{
+		{"no limits", nil, nil, nil, []int{http.StatusOK, http.StatusOK}},
+		{"eth namespace allowed", []string{"eth"}, nil, nil, []int{http.StatusOK}},
+		{"not in allow list", []string{"eth_blockNumber"}, nil, nil, []int{http.StatusForbidden}},
+		{"eth namespace denied", nil, []string{"eth"}, nil, []int{http.StatusForbidden}},
+		{"graphql denied", nil, []string{GraphQLMethod}, nil, []int{http.StatusForbidden}},
+		{"eth namespace rate limit", nil, nil, []string{"eth:0.001:1"}, []int{http.StatusOK, http.StatusTooManyRequests}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *config.DefaultJSONRPCConfig()
+			cfg.AllowedMethods = tc.allowed
+			cfg.DeniedMethods = tc.denied
+			cfg.RateLimits = tc.limits
+			handler, err := NewGraphQLLimitsHandler(next, cfg, log.NewNopLogger())
+			require.NoError(t, err)
+
+			for _, expStatus := range tc.expStatus {
+				req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ chainID }"}`))
+				req.RemoteAddr = "10.0.0.1:1234"
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, req)
+				require.Equal(t, expStatus, rec.Code, rec.Body.String())
+				if expStatus != http.StatusOK {
+					require.Contains(t, rec.Body.String(), `"errors"`)
+				}
+			}
+		})
+	}
+}
+
+func TestLimitsHandlerBatch(t *testing.T) {
+	cfg := *config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"debug"}
+	cfg.BatchRequestLimit = 3
+	handler := newTestHandler(t, cfg)
+
+	// only the denied calls fail
+	body := "[" + request(1, "eth_echo") + "," + request(2, "debug_echo") + "," + request(3, "eth_echo") + "]"
+	responses := call(t, handler, "10.0.0.1:1234", body, nil)
+	require.Len(t, responses, 3)
+	for _, resp := range responses {
+		if resp.ID == 2 {
+			require.NotNil(t, resp.Error)
+			require.Equal(t, ErrCodeMethodDenied, resp.Error.Code)
+		} else {
+			require.Nil(t, resp.Error)
+			require.Equal(t, "ok", resp.Result)
+		}
+	}
+
+	// all calls denied
+	body = "[" + request(1, "debug_echo") + "," + request(2, "debug_echo") + "]"
+	responses = call(t, handler, "10.0.0.1:1234", body, nil)
+	require.Len(t, responses, 2)
+	for _, resp := range responses {
+		require.NotNil(t, resp.Error)
+	}
+
+	// batch above the limit is rejected by the server
+	body = "[" + strings.Repeat(request(1, "eth_echo")+",", 3) + request(2, "eth_echo") + "]"
+	before := batchLimitCounter.Snapshot().Count()
+	responses = call(t, handler, "10.0.0.1:1234", body, nil)
+	require.NotNil(t, responses[0].Error)
+	require.Equal(t, before+1, batchLimitCounter.Snapshot().Count())
+}
+
+func TestClientIP(t *testing.T) {
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		expIP      string
+	}{
+		{"remote address", "10.0.0.1:1234", "", "10.0.0.1"},
+		{"forwarded from remote is ignored", "10.0.0.1:1234", "10.0.0.2", "10.0.0.1"},
+		{"forwarded from loopback", "127.0.0.1:1234", "10.0.0.2", "10.0.0.2"},
+		{"spoofed forwarded entries are ignored", "127.0.0.1:1234", "1.2.3.4, 10.0.0.3", "10.0.0.3"},
+		{"empty rightmost forwarded entry", "127.0.0.1:1234", "10.0.0.2, ", "127.0.0.1"},
+		{"loopback without forwarded", "[::1]:1234", "", "::1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tc.forwarded)
+			}
+			require.Equal(t, tc.expIP, clientIP(req))
+		})
+	}
+}
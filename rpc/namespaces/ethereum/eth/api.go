@@ -95,6 +95,7 @@ type EthereumAPI interface {
 	Coinbase() (string, error)
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
+	GetTransactionsByAddress(address common.Address, args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
@@ -420,6 +421,15 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 	return e.backend.GetTransactionLogs(txHash)
 }
 
+// GetTransactionsByAddress returns a page of the transactions sent by or to the
+// address, deploying a contract at it or emitting logs from it. The next page is
+// requested with the cursor of the result.
+func (e *PublicAPI) GetTransactionsByAddress(address common.Address, args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error) {
+	e.logger.Debug("eth_getTransactionsByAddress", "address", address.Hex(), "args", args)
+
+	return e.backend.GetTransactionsByAddress(address, args)
+}
+
 // SignTypedData signs EIP-712 conformant typed data
 func (e *PublicAPI) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_signTypedData", "address", address.Hex(), "data", typedData)
@@ -0,0 +1,226 @@
+package ots
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/types"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// apiLevel is the Otterscan API level implemented by the namespace
+	apiLevel = 8
+
+	// maxPageSize caps the number of txs returned by a search page
+	maxPageSize = 1000
+)
+
+var errIndexerDisabled = errors.New("the ots namespace requires the EVM indexer, enable it with the json-rpc.enable-indexer flag")
+
+// API is the Otterscan API, exposing the methods the block explorer needs on top
+// of the standard eth namespace.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+	indexer types.EVMTxIndexer
+}
+
+// NewAPI creates an instance of the Otterscan API. The indexer is nil when the
+// EVM indexer is disabled, the methods relying on it fail then.
+func NewAPI(logger log.Logger, backend backend.EVMBackend, indexer types.EVMTxIndexer) *API {
+	return &API{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+		indexer: indexer,
+	}
+}
+
+// TransactionsWithReceipts is a page of the txs an address appears in, ordered from
+// the newest to the oldest.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// ContractCreator is the tx deploying a contract and its sender.
+type ContractCreator struct {
+	Tx      common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (api *API) GetApiLevel() uint64 { //nolint:revive,stylecheck // method name is part of the Otterscan API
+	api.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// SearchTransactionsBefore returns the txs the address appears in, in the blocks
+// before blockNumber, starting from the latest block when it's zero. Blocks are not
+// split across pages, so a page can hold more than pageSize txs.
+func (api *API) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address, "block", blockNumber, "page-size", pageSize)
+	hashes, more, err := api.searchTransactions(address, blockNumber, true, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.transactionsWithReceipts(hashes, blockNumber == 0, !more)
+}
+
+// SearchTransactionsAfter returns the txs the address appears in, in the blocks
+// after blockNumber, starting from the genesis when it's zero. Like the other
+// search, the txs are ordered from the newest to the oldest.
+func (api *API) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address, "block", blockNumber, "page-size", pageSize)
+	hashes, more, err := api.searchTransactions(address, blockNumber, false, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+		hashes[i], hashes[j] = hashes[j], hashes[i]
+	}
+	return api.transactionsWithReceipts(hashes, !more, blockNumber == 0)
+}
+
+// GetContractCreator returns the tx deploying the contract and its creator, or nil
+// if the address is not a contract or its creation is not found. The creator is
+// the tx sender for the contracts deployed by a tx, and the contract running the
+// CREATE or CREATE2 operation for the contracts deployed by other contracts.
+func (api *API) GetContractCreator(address common.Address) (*ContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", address)
+	if api.indexer == nil {
+		return nil, errIndexerDisabled
+	}
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	code, err := api.backend.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	creation, err := api.indexer.GetContractCreation(address)
+	if err != nil {
+		return nil, err
+	}
+	if creation == nil {
+		// the indexer only records the contracts deployed by a tx
+		return api.internalContractCreator(address)
+	}
+
+	return &ContractCreator{
+		Tx:      creation.TxHash,
+		Creator: creation.Creator,
+	}, nil
+}
+
+// internalContractCreator traces the txs of the first block the address appears in,
+// looking for the CREATE or CREATE2 operation deploying it. It returns nil if it's
+// not found, e.g. when the creation tx is not indexed for the address because the
+// contract constructor doesn't emit logs.
+func (api *API) internalContractCreator(address common.Address) (*ContractCreator, error) {
+	hashes, _, err := api.indexer.GetTxHashesByAddress(address, 0, false, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hash := range hashes {
+		frame, err := api.traceCalls(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if creation := findCreation(frame, address); creation != nil {
+			return &ContractCreator{
+				Tx:      hash,
+				Creator: creation.From,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the tx sent by the address with
+// the nonce, or nil if it is not found.
+func (api *API) GetTransactionBySenderAndNonce(address common.Address, nonce hexutil.Uint64) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "address", address, "nonce", nonce)
+	if api.indexer == nil {
+		return nil, errIndexerDisabled
+	}
+
+	hash, err := api.indexer.GetTxHashBySenderAndNonce(address, uint64(nonce))
+	if err != nil || hash == (common.Hash{}) {
+		return nil, err
+	}
+	return &hash, nil
+}
+
+// searchTransactions returns the hashes of a search page in the index order, and
+// whether more txs are left past it.
+func (api *API) searchTransactions(address common.Address, blockNumber uint64, reverse bool, pageSize uint16) ([]common.Hash, bool, error) {
+	if api.indexer == nil {
+		return nil, false, errIndexerDisabled
+	}
+	if pageSize == 0 || pageSize > maxPageSize {
+		return nil, false, fmt.Errorf("page size must be between 1 and %d, got %d", maxPageSize, pageSize)
+	}
+
+	return api.indexer.GetTxHashesByAddress(address, int64(blockNumber), reverse, int(pageSize)) //#nosec G115 -- block number won't exceed int64
+}
+
+// transactionsWithReceipts loads the txs and receipts of a search page, setting the
+// block timestamp on the receipts.
+func (api *API) transactionsWithReceipts(hashes []common.Hash, firstPage, lastPage bool) (*TransactionsWithReceipts, error) {
+	res := &TransactionsWithReceipts{
+		Txs:       make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts:  make([]map[string]interface{}, 0, len(hashes)),
+		FirstPage: firstPage,
+		LastPage:  lastPage,
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := api.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := api.backend.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil || tx.BlockNumber == nil {
+			return nil, fmt.Errorf("indexed tx %s not found", hash.Hex())
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			header, err := api.backend.HeaderByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			timestamp = hexutil.Uint64(header.Time)
+			timestamps[height] = timestamp
+		}
+		// the receipt may be shared with the backend cache, so it is copied before adding the timestamp
+		receipt = maps.Clone(receipt)
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
@@ -0,0 +1,265 @@
+package ots_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/ots"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// traceBackend returns the decoded callTracer output of a trace
+type traceBackend struct {
+	backend.EVMBackend
+	trace string
+}
+
+func (b *traceBackend) TraceTransaction(_ common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	if config.Tracer != "callTracer" {
+		return nil, nil
+	}
+	var res interface{}
+	if err := json.Unmarshal([]byte(b.trace), &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+const trace = `{
+	"type": "CALL",
+	"from": "0x0000000000000000000000000000000000000001",
+	"to": "0x0000000000000000000000000000000000000002",
+	"value": "0x10",
+	"input": "0x01",
+	"output": "0x08c379a0",
+	"error": "execution reverted",
+	"calls": [
+		{
+			"type": "CREATE2",
+			"from": "0x0000000000000000000000000000000000000002",
+			"to": "0x0000000000000000000000000000000000000003",
+			"value": "0x0",
+			"input": "0x02",
+			"calls": [
+				{
+					"type": "SELFDESTRUCT",
+					"from": "0x0000000000000000000000000000000000000003",
+					"to": "0x0000000000000000000000000000000000000001",
+					"value": "0x5"
+				}
+			]
+		},
+		{
+			"type": "STATICCALL",
+			"from": "0x0000000000000000000000000000000000000002",
+			"to": "0x0000000000000000000000000000000000000004",
+			"input": "0x03",
+			"output": "0x04"
+		},
+		{
+			"type": "CALL",
+			"from": "0x0000000000000000000000000000000000000002",
+			"to": "0x0000000000000000000000000000000000000004",
+			"value": "0x0",
+			"input": "0x"
+		},
+		{
+			"type": "CALL",
+			"from": "0x0000000000000000000000000000000000000002",
+			"to": "0x0000000000000000000000000000000000000005",
+			"value": "0x7",
+			"input": "0x"
+		}
+	]
+}`
+
+func newAPI(trace string) *ots.API {
+	return ots.NewAPI(log.NewNopLogger(), &traceBackend{trace: trace}, nil)
+}
+
+func TestGetInternalOperations(t *testing.T) {
+	ops, err := newAPI(trace).GetInternalOperations(common.Hash{})
+	require.NoError(t, err)
+	bz, err := json.Marshal(ops)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"type": 3, "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000003", "value": "0x0"},
+		{"type": 1, "from": "0x0000000000000000000000000000000000000003", "to": "0x0000000000000000000000000000000000000001", "value": "0x5"},
+		{"type": 0, "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000005", "value": "0x7"}
+	]`, string(bz))
+}
+
+func TestTraceTransaction(t *testing.T) {
+	entries, err := newAPI(trace).TraceTransaction(common.Hash{})
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+
+	types := make([]string, len(entries))
+	depths := make([]int, len(entries))
+	for i, entry := range entries {
+		types[i] = entry.Type
+		depths[i] = entry.Depth
+	}
+	require.Equal(t, []string{"CALL", "CREATE2", "SELFDESTRUCT", "STATICCALL", "CALL", "CALL"}, types)
+	require.Equal(t, []int{0, 1, 2, 1, 1, 1}, depths)
+	require.Nil(t, entries[3].Value)
+	require.Equal(t, hexutil.Bytes{0x04}, entries[3].Output)
+}
+
+func TestGetTransactionError(t *testing.T) {
+	testCases := []struct {
+		name   string
+		trace  string
+		expErr hexutil.Bytes
+	}{
+		{"reverted", trace, hexutil.Bytes{0x08, 0xc3, 0x79, 0xa0}},
+		{"success", `{"type": "CALL", "output": "0x01"}`, hexutil.Bytes{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := newAPI(tc.trace).GetTransactionError(common.Hash{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expErr, res)
+		})
+	}
+}
+
+func TestIndexerDisabled(t *testing.T) {
+	api := newAPI(trace)
+
+	_, err := api.SearchTransactionsBefore(common.Address{}, 0, 25)
+	require.ErrorContains(t, err, "json-rpc.enable-indexer")
+	_, err = api.SearchTransactionsAfter(common.Address{}, 0, 25)
+	require.ErrorContains(t, err, "json-rpc.enable-indexer")
+	_, err = api.GetContractCreator(common.Address{})
+	require.ErrorContains(t, err, "json-rpc.enable-indexer")
+	_, err = api.GetTransactionBySenderAndNonce(common.Address{}, 0)
+	require.ErrorContains(t, err, "json-rpc.enable-indexer")
+}
+
+// searchBackend returns the same receipt for every tx, like a cached receipt
+type searchBackend struct {
+	backend.EVMBackend
+	receipt map[string]interface{}
+}
+
+func (b *searchBackend) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	return &rpctypes.RPCTransaction{Hash: hash, BlockNumber: (*hexutil.Big)(common.Big1)}, nil
+}
+
+func (b *searchBackend) GetTransactionReceipt(common.Hash) (map[string]interface{}, error) {
+	return b.receipt, nil
+}
+
+func (b *searchBackend) HeaderByNumber(rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Time: 10}, nil
+}
+
+// searchIndexer returns a single tx hash for every search
+type searchIndexer struct {
+	types.EVMTxIndexer
+}
+
+func (searchIndexer) GetTxHashesByAddress(common.Address, int64, bool, int) ([]common.Hash, bool, error) {
+	return []common.Hash{{0x01}}, false, nil
+}
+
+func TestSearchTransactionsReceiptTimestamp(t *testing.T) {
+	receipt := map[string]interface{}{"status": hexutil.Uint(1)}
+	api := ots.NewAPI(log.NewNopLogger(), &searchBackend{receipt: receipt}, searchIndexer{})
+
+	res, err := api.SearchTransactionsBefore(common.Address{}, 0, 25)
+	require.NoError(t, err)
+	require.Len(t, res.Receipts, 1)
+	require.Equal(t, hexutil.Uint64(10), res.Receipts[0]["timestamp"])
+	require.Equal(t, hexutil.Uint(1), res.Receipts[0]["status"])
+
+	// the backend receipt is left untouched
+	require.NotContains(t, receipt, "timestamp")
+}
+
+// creatorBackend is a trace backend returning the code of every address
+type creatorBackend struct {
+	traceBackend
+}
+
+func (b *creatorBackend) GetCode(common.Address, rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return hexutil.Bytes{0x01}, nil
+}
+
+// creatorIndexer returns the indexed contract creation, and a single tx hash for
+// every search
+type creatorIndexer struct {
+	types.EVMTxIndexer
+	creation *types.ContractCreation
+}
+
+func (i creatorIndexer) GetContractCreation(common.Address) (*types.ContractCreation, error) {
+	return i.creation, nil
+}
+
+func (creatorIndexer) GetTxHashesByAddress(common.Address, int64, bool, int) ([]common.Hash, bool, error) {
+	return []common.Hash{{0x01}}, false, nil
+}
+
+func TestGetContractCreator(t *testing.T) {
+	created := common.HexToAddress("0x0000000000000000000000000000000000000003")
+
+	testCases := []struct {
+		name       string
+		trace      string
+		creation   *types.ContractCreation
+		address    common.Address
+		expCreator *ots.ContractCreator
+	}{
+		{
+			"contract deployed by a tx",
+			trace,
+			&types.ContractCreation{TxHash: common.Hash{0x02}, Creator: common.HexToAddress("0x01")},
+			created,
+			&ots.ContractCreator{Tx: common.Hash{0x02}, Creator: common.HexToAddress("0x01")},
+		},
+		{
+			"contract deployed by a contract",
+			strings.Replace(trace, `"error": "execution reverted",`, "", 1),
+			nil,
+			created,
+			&ots.ContractCreator{Tx: common.Hash{0x01}, Creator: common.HexToAddress("0x02")},
+		},
+		{
+			"contract deployment reverted by the parent call",
+			trace,
+			nil,
+			created,
+			nil,
+		},
+		{
+			"contract deployment not found in the first tx",
+			strings.Replace(trace, `"error": "execution reverted",`, "", 1),
+			nil,
+			common.HexToAddress("0x04"),
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &creatorBackend{traceBackend{trace: tc.trace}}
+			api := ots.NewAPI(log.NewNopLogger(), backend, creatorIndexer{creation: tc.creation})
+
+			creator, err := api.GetContractCreator(tc.address)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCreator, creator)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package ots
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// callTracer is the native tracer the ots methods run through Keeper.TraceTx
+const callTracer = "callTracer"
+
+// Internal operation types, as defined by the Otterscan API.
+const (
+	OpTransfer = iota
+	OpSelfDestruct
+	OpCreate
+	OpCreate2
+)
+
+// InternalOperation is an ether transfer, contract creation or self-destruct
+// happening inside a tx.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call frame of a tx, flattened in execution order.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// callFrame is the output of the callTracer.
+type callFrame struct {
+	Type   string         `json:"type"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+	Error  string         `json:"error"`
+	Calls  []callFrame    `json:"calls"`
+}
+
+// GetInternalOperations returns the ether transfers, contract creations and
+// self-destructs performed by the contracts the tx calls.
+func (api *API) GetInternalOperations(hash common.Hash) ([]*InternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+	frame, err := api.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]*InternalOperation, 0)
+	walkCalls(frame, 0, func(frame *callFrame, depth int) {
+		if depth == 0 {
+			return
+		}
+
+		op := &InternalOperation{From: frame.From, To: frame.To, Value: frame.Value}
+		switch frame.Type {
+		case "CALL", "CALLCODE":
+			if frame.Value == nil || frame.Value.ToInt().Sign() == 0 {
+				return
+			}
+			op.Type = OpTransfer
+		case "CREATE":
+			op.Type = OpCreate
+		case "CREATE2":
+			op.Type = OpCreate2
+		case "SELFDESTRUCT":
+			op.Type = OpSelfDestruct
+		default:
+			return
+		}
+		if op.Value == nil {
+			op.Value = (*hexutil.Big)(new(big.Int))
+		}
+		ops = append(ops, op)
+	})
+	return ops, nil
+}
+
+// TraceTransaction returns the call frames of the tx, flattened in execution order.
+func (api *API) TraceTransaction(hash common.Hash) ([]*TraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+	frame, err := api.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*TraceEntry, 0)
+	walkCalls(frame, 0, func(frame *callFrame, depth int) {
+		entry := &TraceEntry{
+			Type:   frame.Type,
+			Depth:  depth,
+			From:   frame.From,
+			To:     frame.To,
+			Value:  frame.Value,
+			Input:  frame.Input,
+			Output: frame.Output,
+		}
+		// the value of delegate and static calls is inherited, not transferred
+		if frame.Type == "DELEGATECALL" || frame.Type == "STATICCALL" {
+			entry.Value = nil
+		}
+		entries = append(entries, entry)
+	})
+	return entries, nil
+}
+
+// GetTransactionError returns the revert data of the tx, empty if the tx succeeded
+// or failed without it.
+func (api *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	api.logger.Debug("ots_getTransactionError", "hash", hash)
+	frame, err := api.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// traceCalls runs the callTracer over the tx and decodes its call frames.
+func (api *API) traceCalls(hash common.Hash) (*callFrame, error) {
+	res, err := api.backend.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// findCreation returns the sub call of the frame deploying the address with a
+// CREATE or CREATE2 operation, skipping the calls reverted by their parent.
+func findCreation(frame *callFrame, address common.Address) *callFrame {
+	if frame.Error != "" {
+		return nil
+	}
+
+	for i := range frame.Calls {
+		call := &frame.Calls[i]
+		switch strings.ToUpper(call.Type) {
+		case "CREATE", "CREATE2":
+			if call.To == address && call.Error == "" {
+				return call
+			}
+		}
+		if creation := findCreation(call, address); creation != nil {
+			return creation
+		}
+	}
+	return nil
+}
+
+// walkCalls visits the frame and its sub calls in execution order.
+func walkCalls(frame *callFrame, depth int, visit func(*callFrame, int)) {
+	frame.Type = strings.ToUpper(frame.Type)
+	visit(frame, depth)
+	for i := range frame.Calls {
+		walkCalls(&frame.Calls[i], depth+1, visit)
+	}
+}
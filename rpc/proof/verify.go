@@ -0,0 +1,269 @@
+// Package proof verifies the eth_getProof responses of the JSON-RPC server
+// against the app hash committed in a CometBFT header.
+//
+// The account, balance, code hash and storage proofs are ICS23 proofs of the
+// x/auth account, x/bank and x/precisebank balance, and x/vm code hash and
+// storage store keys, returned in the Cosmos EVM extension fields of the
+// eth_getProof response. The state queried at height H is committed in the app
+// hash of the header at height H+1.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// VerifyAccountResultWithHeader verifies the proofs of an eth_getProof response
+// against the app hash of the given header. The header must be the one of the
+// block following the queried height.
+func VerifyAccountResultWithHeader(
+	cdc codec.Codec,
+	res *rpctypes.AccountResult,
+	coinInfo evmtypes.EvmCoinInfo,
+	header *cmttypes.Header,
+) error {
+	if header == nil {
+		return fmt.Errorf("header cannot be nil")
+	}
+	return VerifyAccountResult(cdc, res, coinInfo, header.AppHash)
+}
+
+// VerifyAccountResult verifies the proofs of an eth_getProof response against
+// the given app hash. It checks that the account nonce, the balance of the EVM
+// coin described by coinInfo, the code hash and the storage values match the
+// proven store values.
+//
+// NOTE: the storage hash of the response is computed by the node and is not
+// committed in the app hash, so it is not verified.
+func VerifyAccountResult(
+	cdc codec.Codec,
+	res *rpctypes.AccountResult,
+	coinInfo evmtypes.EvmCoinInfo,
+	appHash []byte,
+) error {
+	if res == nil {
+		return fmt.Errorf("account result cannot be nil")
+	}
+
+	exists, err := verifyAccount(cdc, res, appHash)
+	if err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	if exists {
+		if err := verifyBalance(res, coinInfo, appHash); err != nil {
+			return fmt.Errorf("invalid balance proof: %w", err)
+		}
+
+		if err := verifyCodeHash(res, appHash); err != nil {
+			return fmt.Errorf("invalid code hash proof: %w", err)
+		}
+	} else {
+		// the account query returns an empty account for addresses without an
+		// x/auth account
+		if res.Balance == nil || res.Balance.ToInt().Sign() != 0 {
+			return fmt.Errorf("balance mismatch: expected 0, got %v", res.Balance)
+		}
+		if res.CodeHash != common.BytesToHash(evmtypes.EmptyCodeHash) {
+			return fmt.Errorf("code hash mismatch: expected %s, got %s", common.BytesToHash(evmtypes.EmptyCodeHash), res.CodeHash)
+		}
+	}
+
+	for _, storage := range res.StorageProof {
+		if err := verifyStorage(res.Address, storage, appHash); err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", storage.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// verifyAccount verifies the x/auth store proof of the account. It returns
+// whether the account exists.
+func verifyAccount(cdc codec.Codec, res *rpctypes.AccountResult, appHash []byte) (bool, error) {
+	proofOps := res.AccountProofOps
+	if proofOps == nil {
+		return false, fmt.Errorf("missing proof ops")
+	}
+
+	key := append(authtypes.AddressStoreKeyPrefix.Bytes(), res.Address.Bytes()...)
+	if err := verifyProofOps(proofOps, authtypes.StoreKey, key, appHash); err != nil {
+		return false, err
+	}
+
+	if len(proofOps.Value) == 0 {
+		if res.Nonce != 0 {
+			return false, fmt.Errorf("nonce mismatch: expected 0, got %d", res.Nonce)
+		}
+		return false, nil
+	}
+
+	var acc sdk.AccountI
+	if err := cdc.UnmarshalInterface(proofOps.Value, &acc); err != nil {
+		return false, fmt.Errorf("failed to decode account: %w", err)
+	}
+
+	if !bytes.Equal(acc.GetAddress(), res.Address.Bytes()) {
+		return false, fmt.Errorf("address mismatch: expected %s, got %s", res.Address, common.BytesToAddress(acc.GetAddress()))
+	}
+
+	if acc.GetSequence() != uint64(res.Nonce) {
+		return false, fmt.Errorf("nonce mismatch: expected %d, got %d", acc.GetSequence(), res.Nonce)
+	}
+
+	return true, nil
+}
+
+// verifyBalance verifies the x/bank store proof of the integer balance and, for
+// EVM coins with less than 18 decimals, the x/precisebank store proof of the
+// fractional balance of the account.
+func verifyBalance(res *rpctypes.AccountResult, coinInfo evmtypes.EvmCoinInfo, appHash []byte) error {
+	proofOps := res.BalanceProofOps
+	if proofOps == nil {
+		return fmt.Errorf("missing proof ops")
+	}
+
+	key, err := rpctypes.BalanceStoreKey(res.Address, coinInfo.Denom)
+	if err != nil {
+		return err
+	}
+
+	if err := verifyProofOps(proofOps, banktypes.StoreKey, key, appHash); err != nil {
+		return err
+	}
+
+	balance := sdkmath.ZeroInt()
+	if len(proofOps.Value) > 0 {
+		if balance, err = banktypes.BalanceValueCodec.Decode(proofOps.Value); err != nil {
+			return fmt.Errorf("failed to decode balance: %w", err)
+		}
+	}
+
+	if coinInfo.Decimals != evmtypes.EighteenDecimals {
+		// the x/precisebank reserve backs the fractional balances and its
+		// extended coin balance is always reported as zero
+		if bytes.Equal(res.Address.Bytes(), authtypes.NewModuleAddress(precisebanktypes.ModuleName)) {
+			balance = sdkmath.ZeroInt()
+		} else {
+			fractional, err := verifyFractionalBalance(res, appHash)
+			if err != nil {
+				return fmt.Errorf("invalid fractional balance proof: %w", err)
+			}
+			balance = balance.Mul(coinInfo.Decimals.ConversionFactor()).Add(fractional)
+		}
+	}
+
+	if res.Balance == nil || res.Balance.ToInt().Cmp(balance.BigInt()) != 0 {
+		return fmt.Errorf("balance mismatch: expected %s, got %v", balance, res.Balance)
+	}
+
+	return nil
+}
+
+// verifyFractionalBalance verifies the x/precisebank store proof of the
+// fractional balance of the account and returns it.
+func verifyFractionalBalance(res *rpctypes.AccountResult, appHash []byte) (sdkmath.Int, error) {
+	proofOps := res.FractionalBalanceProofOps
+	if proofOps == nil {
+		return sdkmath.Int{}, fmt.Errorf("missing proof ops")
+	}
+
+	key := rpctypes.FractionalBalanceStoreKey(res.Address)
+	if err := verifyProofOps(proofOps, precisebanktypes.StoreKey, key, appHash); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	fractional := sdkmath.ZeroInt()
+	if len(proofOps.Value) > 0 {
+		if err := fractional.Unmarshal(proofOps.Value); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("failed to decode fractional balance: %w", err)
+		}
+	}
+
+	return fractional, nil
+}
+
+// verifyCodeHash verifies the x/vm store proof of the code hash of the account.
+func verifyCodeHash(res *rpctypes.AccountResult, appHash []byte) error {
+	proofOps := res.CodeHashProofOps
+	if proofOps == nil {
+		return fmt.Errorf("missing proof ops")
+	}
+
+	key := rpctypes.CodeHashStoreKey(res.Address)
+	if err := verifyProofOps(proofOps, evmtypes.StoreKey, key, appHash); err != nil {
+		return err
+	}
+
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(proofOps.Value) > 0 {
+		codeHash = common.BytesToHash(proofOps.Value)
+	}
+
+	if res.CodeHash != codeHash {
+		return fmt.Errorf("code hash mismatch: expected %s, got %s", codeHash, res.CodeHash)
+	}
+
+	return nil
+}
+
+// verifyStorage verifies the x/vm store proof of a storage slot.
+func verifyStorage(address common.Address, storage rpctypes.StorageResult, appHash []byte) error {
+	proofOps := storage.ProofOps
+	if proofOps == nil {
+		return fmt.Errorf("missing proof ops")
+	}
+
+	key := evmtypes.StateKey(address, common.HexToHash(storage.Key).Bytes())
+	if err := verifyProofOps(proofOps, evmtypes.StoreKey, key, appHash); err != nil {
+		return err
+	}
+
+	value := new(big.Int).SetBytes(proofOps.Value)
+	if storage.Value == nil || storage.Value.ToInt().Cmp(value) != 0 {
+		return fmt.Errorf("value mismatch: expected %s, got %v", value, storage.Value)
+	}
+
+	return nil
+}
+
+// verifyProofOps verifies the membership of the proof value, or the absence of
+// the key if the value is empty, against the app hash.
+func verifyProofOps(proofOps *rpctypes.ProofOps, storeKey string, key, appHash []byte) error {
+	if proofOps.StoreKey != storeKey {
+		return fmt.Errorf("store key mismatch: expected %s, got %s", storeKey, proofOps.StoreKey)
+	}
+
+	if !bytes.Equal(proofOps.Key, key) {
+		return fmt.Errorf("key mismatch: expected %X, got %X", key, []byte(proofOps.Key))
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(proofOps.Value) == 0 {
+		return prt.VerifyAbsence(proofOps.ToProto(), appHash, keyPath)
+	}
+	return prt.VerifyValue(proofOps.ToProto(), appHash, keyPath, proofOps.Value)
+}
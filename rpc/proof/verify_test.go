@@ -0,0 +1,359 @@
+package proof_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/rpc/proof"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	testCodeHash = common.HexToHash("0xc0de")
+
+	testCoinInfos = []evmtypes.EvmCoinInfo{
+		{Denom: "aatom", ExtendedDenom: "aatom", DisplayDenom: "atom", Decimals: evmtypes.EighteenDecimals},
+		{Denom: "uatom", ExtendedDenom: "aatom", DisplayDenom: "atom", Decimals: evmtypes.SixDecimals},
+	}
+)
+
+// setupStore commits an account with the given nonce, a balance of 100 integer
+// and 42 fractional units, a code hash and a storage slot in a multistore. It
+// returns a function building the eth_getProof response of an address and the
+// app hash.
+func setupStore(
+	t *testing.T,
+	cdc codec.Codec,
+	coinInfo evmtypes.EvmCoinInfo,
+	address common.Address,
+	nonce uint64,
+	slot, value common.Hash,
+) (func(common.Address) *rpctypes.AccountResult, []byte) {
+	t.Helper()
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	accKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	precisebankKey := storetypes.NewKVStoreKey(precisebanktypes.StoreKey)
+	evmKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	for _, key := range []*storetypes.KVStoreKey{accKey, bankKey, precisebankKey, evmKey} {
+		store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	acc := authtypes.NewBaseAccount(address.Bytes(), nil, 1, nonce)
+	accBz, err := cdc.MarshalInterface(sdk.AccountI(acc))
+	require.NoError(t, err)
+
+	integer, fractional := sdkmath.NewInt(100), sdkmath.NewInt(42)
+	balance := integer
+	if coinInfo.Decimals != evmtypes.EighteenDecimals {
+		balance = integer.Mul(coinInfo.Decimals.ConversionFactor()).Add(fractional)
+	}
+
+	balanceKey, err := rpctypes.BalanceStoreKey(address, coinInfo.Denom)
+	require.NoError(t, err)
+	integerBz, err := banktypes.BalanceValueCodec.Encode(integer)
+	require.NoError(t, err)
+	fractionalBz, err := fractional.Marshal()
+	require.NoError(t, err)
+
+	store.GetKVStore(accKey).Set(append(authtypes.AddressStoreKeyPrefix.Bytes(), address.Bytes()...), accBz)
+	store.GetKVStore(bankKey).Set(balanceKey, integerBz)
+	store.GetKVStore(precisebankKey).Set(rpctypes.FractionalBalanceStoreKey(address), fractionalBz)
+	store.GetKVStore(evmKey).Set(rpctypes.CodeHashStoreKey(address), testCodeHash.Bytes())
+	store.GetKVStore(evmKey).Set(evmtypes.StateKey(address, slot.Bytes()), value.Bytes())
+	commitID := store.Commit()
+
+	query := func(storeKey string, key []byte) *rpctypes.ProofOps {
+		res, err := store.Query(&storetypes.RequestQuery{
+			Path:   "/" + storeKey + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+		return rpctypes.NewProofOps(storeKey, key, res.Value, res.ProofOps)
+	}
+
+	build := func(addr common.Address) *rpctypes.AccountResult {
+		res := &rpctypes.AccountResult{
+			Address:  addr,
+			Balance:  (*hexutil.Big)(big.NewInt(0)),
+			CodeHash: common.BytesToHash(evmtypes.EmptyCodeHash),
+		}
+		if addr == address {
+			res.Nonce = hexutil.Uint64(nonce)
+			res.Balance = (*hexutil.Big)(balance.BigInt())
+			res.CodeHash = testCodeHash
+		}
+
+		balanceKey, err := rpctypes.BalanceStoreKey(addr, coinInfo.Denom)
+		require.NoError(t, err)
+
+		emptySlot := common.HexToHash("0xff")
+		res.StorageProof = []rpctypes.StorageResult{
+			{
+				Key:      slot.Hex(),
+				Value:    (*hexutil.Big)(big.NewInt(0)),
+				ProofOps: query(evmtypes.StoreKey, evmtypes.StateKey(addr, slot.Bytes())),
+			},
+			{
+				Key:      emptySlot.Hex(),
+				Value:    (*hexutil.Big)(big.NewInt(0)),
+				ProofOps: query(evmtypes.StoreKey, evmtypes.StateKey(addr, emptySlot.Bytes())),
+			},
+		}
+		if addr == address {
+			res.StorageProof[0].Value = (*hexutil.Big)(value.Big())
+		}
+
+		res.AccountProofOps = query(authtypes.StoreKey, append(authtypes.AddressStoreKeyPrefix.Bytes(), addr.Bytes()...))
+		res.BalanceProofOps = query(banktypes.StoreKey, balanceKey)
+		if coinInfo.Decimals != evmtypes.EighteenDecimals {
+			res.FractionalBalanceProofOps = query(precisebanktypes.StoreKey, rpctypes.FractionalBalanceStoreKey(addr))
+		}
+		res.CodeHashProofOps = query(evmtypes.StoreKey, rpctypes.CodeHashStoreKey(addr))
+		return res
+	}
+
+	return build, commitID.Hash
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	address := utiltx.GenerateAddress()
+	slot := common.HexToHash("0x01")
+	value := common.HexToHash("0x2a")
+
+	testCases := []struct {
+		name        string
+		malleate    func(res *rpctypes.AccountResult, appHash []byte) []byte
+		errContains string
+		// fractional marks the cases that only apply to EVM coins with less than
+		// 18 decimals
+		fractional bool
+	}{
+		{
+			"pass - valid account, balance, code hash and storage proofs",
+			func(_ *rpctypes.AccountResult, appHash []byte) []byte {
+				return appHash
+			},
+			"",
+			false,
+		},
+		{
+			"fail - invalid app hash",
+			func(_ *rpctypes.AccountResult, appHash []byte) []byte {
+				return common.HexToHash("0x01").Bytes()
+			},
+			"invalid account proof",
+			false,
+		},
+		{
+			"fail - missing account proof ops",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.AccountProofOps = nil
+				return appHash
+			},
+			"missing proof ops",
+			false,
+		},
+		{
+			"fail - nonce mismatch",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.Nonce++
+				return appHash
+			},
+			"nonce mismatch",
+			false,
+		},
+		{
+			"fail - proof of another account",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.Address = utiltx.GenerateAddress()
+				return appHash
+			},
+			"key mismatch",
+			false,
+		},
+		{
+			"fail - missing balance proof ops",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.BalanceProofOps = nil
+				return appHash
+			},
+			"invalid balance proof: missing proof ops",
+			false,
+		},
+		{
+			"fail - balance mismatch",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.Balance = (*hexutil.Big)(new(big.Int).Add(res.Balance.ToInt(), big.NewInt(1)))
+				return appHash
+			},
+			"balance mismatch",
+			false,
+		},
+		{
+			"fail - tampered balance value",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				bz, err := banktypes.BalanceValueCodec.Encode(sdkmath.NewInt(1_000_000))
+				require.NoError(t, err)
+				res.BalanceProofOps.Value = bz
+				return appHash
+			},
+			"invalid balance proof",
+			false,
+		},
+		{
+			"fail - missing fractional balance proof ops",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.FractionalBalanceProofOps = nil
+				return appHash
+			},
+			"invalid fractional balance proof: missing proof ops",
+			true,
+		},
+		{
+			"fail - tampered fractional balance value",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				bz, err := sdkmath.NewInt(43).Marshal()
+				require.NoError(t, err)
+				res.FractionalBalanceProofOps.Value = bz
+				res.Balance = (*hexutil.Big)(new(big.Int).Add(res.Balance.ToInt(), big.NewInt(1)))
+				return appHash
+			},
+			"invalid fractional balance proof",
+			true,
+		},
+		{
+			"fail - missing code hash proof ops",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.CodeHashProofOps = nil
+				return appHash
+			},
+			"invalid code hash proof: missing proof ops",
+			false,
+		},
+		{
+			"fail - code hash mismatch",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+				return appHash
+			},
+			"code hash mismatch",
+			false,
+		},
+		{
+			"fail - tampered code hash value",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.CodeHashProofOps.Value = evmtypes.EmptyCodeHash
+				res.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+				return appHash
+			},
+			"invalid code hash proof",
+			false,
+		},
+		{
+			"fail - storage value mismatch",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(1))
+				return appHash
+			},
+			"value mismatch",
+			false,
+		},
+		{
+			"fail - tampered storage value",
+			func(res *rpctypes.AccountResult, appHash []byte) []byte {
+				res.StorageProof[0].ProofOps.Value = common.HexToHash("0x01").Bytes()
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(1))
+				return appHash
+			},
+			"invalid storage proof",
+			false,
+		},
+	}
+
+	for _, coinInfo := range testCoinInfos {
+		for _, tc := range testCases {
+			if tc.fractional && coinInfo.Decimals == evmtypes.EighteenDecimals {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%d decimals - %s", coinInfo.Decimals, tc.name), func(t *testing.T) {
+				build, appHash := setupStore(t, cdc, coinInfo, address, 5, slot, value)
+				res := build(address)
+				appHash = tc.malleate(res, appHash)
+
+				err := proof.VerifyAccountResult(cdc, res, coinInfo, appHash)
+				if tc.errContains == "" {
+					require.NoError(t, err)
+				} else {
+					require.ErrorContains(t, err, tc.errContains)
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyAccountResultEmptyAccount(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	for _, coinInfo := range testCoinInfos {
+		build, appHash := setupStore(t, cdc, coinInfo, utiltx.GenerateAddress(), 1, common.HexToHash("0x01"), common.HexToHash("0x02"))
+
+		res := build(utiltx.GenerateAddress())
+		require.NoError(t, proof.VerifyAccountResult(cdc, res, coinInfo, appHash))
+
+		res.Balance = (*hexutil.Big)(big.NewInt(1))
+		require.ErrorContains(t, proof.VerifyAccountResult(cdc, res, coinInfo, appHash), "balance mismatch")
+
+		res = build(utiltx.GenerateAddress())
+		res.CodeHash = testCodeHash
+		require.ErrorContains(t, proof.VerifyAccountResult(cdc, res, coinInfo, appHash), "code hash mismatch")
+	}
+}
+
+func TestVerifyAccountResultWithHeader(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	coinInfo := testCoinInfos[0]
+	address := utiltx.GenerateAddress()
+	build, appHash := setupStore(t, cdc, coinInfo, address, 0, common.HexToHash("0x01"), common.HexToHash("0x02"))
+	res := build(address)
+
+	require.ErrorContains(t, proof.VerifyAccountResultWithHeader(cdc, res, coinInfo, nil), "header cannot be nil")
+	require.NoError(t, proof.VerifyAccountResultWithHeader(cdc, res, coinInfo, &cmttypes.Header{AppHash: appHash}))
+}
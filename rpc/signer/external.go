@@ -0,0 +1,109 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+var _ Signer = (*ExternalSigner)(nil)
+
+// ExternalSigner delegates the signatures to an external signer, such as Clef or
+// Web3Signer, over HTTP JSON-RPC so that the keys don't reside on the node. It calls
+// the account_signTransaction, eth_sign and eth_signTypedData methods of the signer.
+type ExternalSigner struct {
+	client *rpc.Client
+}
+
+// signTransactionResult is the result of account_signTransaction.
+type signTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// NewExternalSigner creates a signer calling the external signer served at the HTTP
+// endpoint.
+func NewExternalSigner(endpoint string) (*ExternalSigner, error) {
+	client, err := rpc.DialHTTP(endpoint)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "dial external signer %s", endpoint)
+	}
+	return &ExternalSigner{client: client}, nil
+}
+
+// SignTransaction implements Signer. The transaction returned by the external
+// signer is rejected if it's not signed by the args sender.
+func (s *ExternalSigner) SignTransaction(ctx context.Context, args evmtypes.TransactionArgs, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error) {
+	var res signTransactionResult
+	if err := s.client.CallContext(ctx, &res, "account_signTransaction", args); err != nil {
+		return nil, errorsmod.Wrap(err, "external signer")
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(res.Raw); err != nil {
+		return nil, errorsmod.Wrap(err, "decode external signer transaction")
+	}
+
+	from, err := ethtypes.Sender(ethSigner, tx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "recover external signer transaction sender")
+	}
+	if from != args.GetFrom() {
+		return nil, fmt.Errorf("external signer transaction sender mismatch (have=%s, want=%s)", from.Hex(), args.GetFrom().Hex())
+	}
+	return tx, nil
+}
+
+// Sign implements Signer. The signature returned by the external signer is rejected
+// if it's not a signature of the data by the address.
+func (s *ExternalSigner) Sign(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
+	return s.call(ctx, accounts.TextHash(data), address, "eth_sign", hexutil.Bytes(data))
+}
+
+// SignTypedData implements Signer. The signature returned by the external signer is
+// rejected if it's not a signature of the typed data by the address.
+func (s *ExternalSigner) SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return s.call(ctx, sigHash, address, "eth_signTypedData", typedData)
+}
+
+// call calls a signing method of the external signer with the address and args, and
+// checks that the returned signature of the hash recovers to the address.
+func (s *ExternalSigner) call(ctx context.Context, hash []byte, address common.Address, method string, args ...interface{}) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := s.client.CallContext(ctx, &signature, method, append([]interface{}{address}, args...)...); err != nil {
+		return nil, errorsmod.Wrap(err, "external signer")
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid external signer signature length, expect: %d, got: %d", crypto.SignatureLength, len(signature))
+	}
+
+	// the signer may return V as 0/1 or 27/28, it's transformed to 27/28 as the keyring signatures
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	sig := common.CopyBytes(signature)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "recover external signer signature")
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != address {
+		return nil, fmt.Errorf("external signer signature mismatch (have=%s, want=%s)", signer.Hex(), address.Hex())
+	}
+	return signature, nil
+}
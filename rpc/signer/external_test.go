@@ -0,0 +1,200 @@
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/signer"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// standInSigner is a local stand-in for the external signer, signing with a single key
+type standInSigner struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+}
+
+type signTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// accountAPI serves the account namespace of the stand-in signer
+type accountAPI struct{ s *standInSigner }
+
+func (api *accountAPI) SignTransaction(args evmtypes.TransactionArgs) (*signTransactionResult, error) {
+	tx, err := ethtypes.SignTx(args.ToTransaction().AsTransaction(), ethtypes.LatestSignerForChainID(api.s.chainID), api.s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTransactionResult{Raw: raw}, nil
+}
+
+// ethAPI serves the eth namespace of the stand-in signer
+type ethAPI struct{ s *standInSigner }
+
+func (api *ethAPI) Sign(_ common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return api.s.sign(accounts.TextHash(data))
+}
+
+func (api *ethAPI) SignTypedData(_ common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.sign(sigHash)
+}
+
+func (s *standInSigner) sign(hash []byte) (hexutil.Bytes, error) {
+	signature, err := crypto.Sign(hash, s.key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+func setupExternalSigner(t *testing.T) (*signer.ExternalSigner, common.Address, *big.Int) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return setupExternalSignerWithKey(t, key)
+}
+
+// setupExternalSignerWithKey serves a stand-in external signer signing with the key
+func setupExternalSignerWithKey(t *testing.T, key *ecdsa.PrivateKey) (*signer.ExternalSigner, common.Address, *big.Int) {
+	t.Helper()
+
+	standIn := &standInSigner{key: key, chainID: big.NewInt(9001)}
+
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("account", &accountAPI{standIn}))
+	require.NoError(t, srv.RegisterName("eth", &ethAPI{standIn}))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		httpSrv.Close()
+		srv.Stop()
+	})
+
+	s, err := signer.NewExternalSigner(httpSrv.URL)
+	require.NoError(t, err)
+	return s, crypto.PubkeyToAddress(key.PublicKey), standIn.chainID
+}
+
+func TestExternalSignerSignTransaction(t *testing.T) {
+	s, from, chainID := setupExternalSigner(t)
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	to := common.BigToAddress(big.NewInt(1))
+	gas := hexutil.Uint64(21000)
+	nonce := hexutil.Uint64(3)
+	args := evmtypes.TransactionArgs{
+		To:                   &to,
+		Gas:                  &gas,
+		Nonce:                &nonce,
+		Value:                (*hexutil.Big)(big.NewInt(100)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(1000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(10)),
+		ChainID:              (*hexutil.Big)(chainID),
+	}
+
+	testCases := []struct {
+		name    string
+		from    common.Address
+		expPass bool
+	}{
+		{"signer account", from, true},
+		{"other account", common.BigToAddress(big.NewInt(2)), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args.From = &tc.from
+			tx, err := s.SignTransaction(context.Background(), args, ethSigner)
+			if !tc.expPass {
+				require.ErrorContains(t, err, "sender mismatch")
+				return
+			}
+
+			require.NoError(t, err)
+			sender, err := ethtypes.Sender(ethSigner, tx)
+			require.NoError(t, err)
+			require.Equal(t, from, sender)
+			require.Equal(t, uint64(nonce), tx.Nonce())
+			require.Equal(t, to, *tx.To())
+			require.Equal(t, big.NewInt(100), tx.Value())
+		})
+	}
+}
+
+func TestExternalSignerSign(t *testing.T) {
+	s, from, _ := setupExternalSigner(t)
+
+	data := []byte("hello")
+	signature, err := s.Sign(context.Background(), from, data)
+	require.NoError(t, err)
+	require.Len(t, signature, crypto.SignatureLength)
+
+	signature[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(accounts.TextHash(data), signature)
+	require.NoError(t, err)
+	require.Equal(t, from, crypto.PubkeyToAddress(*pubKey))
+
+	// the stand-in signs with its key whatever the requested account
+	_, err = s.Sign(context.Background(), common.BigToAddress(big.NewInt(2)), data)
+	require.ErrorContains(t, err, "signature mismatch")
+}
+
+func TestExternalSignerSignTypedData(t *testing.T) {
+	s, from, chainID := setupExternalSigner(t)
+
+	typedData := newTypedData(chainID)
+	signature, err := s.SignTypedData(context.Background(), from, typedData)
+	require.NoError(t, err)
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	signature[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(sigHash, signature)
+	require.NoError(t, err)
+	require.Equal(t, from, crypto.PubkeyToAddress(*pubKey))
+
+	_, err = s.SignTypedData(context.Background(), common.BigToAddress(big.NewInt(2)), typedData)
+	require.ErrorContains(t, err, "signature mismatch")
+}
+
+func newTypedData(chainID *big.Int) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "test", ChainId: (*math.HexOrDecimal256)(chainID)},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+}
+
+func TestExternalSignerUnavailable(t *testing.T) {
+	s, err := signer.NewExternalSigner("http://127.0.0.1:1")
+	require.NoError(t, err)
+
+	_, err = s.Sign(context.Background(), common.Address{}, []byte("hello"))
+	require.ErrorContains(t, err, "external signer")
+}
@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ Signer = (*KeyringSigner)(nil)
+
+// KeyringSigner signs with the keys of the node's keyring.
+type KeyringSigner struct {
+	keyring keyring.Keyring
+}
+
+// NewKeyringSigner creates a signer using the keys of the keyring.
+func NewKeyringSigner(kr keyring.Keyring) *KeyringSigner {
+	return &KeyringSigner{keyring: kr}
+}
+
+// SignTransaction implements Signer.
+func (s *KeyringSigner) SignTransaction(_ context.Context, args evmtypes.TransactionArgs, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error) {
+	msg := args.ToTransaction()
+	if err := msg.Sign(ethSigner, s.keyring); err != nil {
+		return nil, err
+	}
+	return msg.AsTransaction(), nil
+}
+
+// Sign implements Signer. It signs the EIP-191 hash of the data, as the external
+// signers and as expected by personal_ecRecover.
+func (s *KeyringSigner) Sign(_ context.Context, address common.Address, data []byte) ([]byte, error) {
+	return s.signByAddress(address, accounts.TextHash(data))
+}
+
+// SignTypedData implements Signer.
+func (s *KeyringSigner) SignTypedData(_ context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return s.signByAddress(address, sigHash)
+}
+
+func (s *KeyringSigner) signByAddress(address common.Address, data []byte) ([]byte, error) {
+	from := sdk.AccAddress(address.Bytes())
+
+	if _, err := s.keyring.KeyByAddress(from); err != nil {
+		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	// Sign the requested hash with the wallet, the Ethereum keys sign the 32 bytes
+	// hashes as is
+	signature, _, err := s.keyring.SignByAddress(from, data, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
@@ -0,0 +1,25 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Signer signs the transactions and data of the eth_sendTransaction, eth_sign and
+// eth_signTypedData requests with the keys of the accounts it manages.
+type Signer interface {
+	// SignTransaction returns the transaction built from the args, signed by the
+	// args sender with the given Ethereum signer.
+	SignTransaction(ctx context.Context, args evmtypes.TransactionArgs, ethSigner ethtypes.Signer) (*ethtypes.Transaction, error)
+	// Sign returns the signature of the data by the address, with the V value
+	// transformed to 27/28.
+	Sign(ctx context.Context, address common.Address, data []byte) ([]byte, error)
+	// SignTypedData returns the signature of the EIP-712 typed data by the address,
+	// with the V value transformed to 27/28.
+	SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) ([]byte, error)
+}
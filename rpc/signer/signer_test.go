@@ -0,0 +1,84 @@
+package signer_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/hd"
+	enccodec "github.com/cosmos/evm/encoding/codec"
+	"github.com/cosmos/evm/rpc/signer"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// TestSigners checks that the keyring and external signers sign the same digests,
+// so that their signatures recover to the signing address in the same way.
+func TestSigners(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	externalSigner, from, chainID := setupExternalSignerWithKey(t, key)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	enccodec.RegisterInterfaces(interfaceRegistry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(interfaceRegistry), hd.EthSecp256k1Option())
+	require.NoError(t, kr.ImportPrivKeyHex("signer", hex.EncodeToString(crypto.FromECDSA(key)), string(hd.EthSecp256k1Type)))
+	keyringSigner := signer.NewKeyringSigner(kr)
+
+	data := []byte("hello")
+	typedData := newTypedData(chainID)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		hash []byte
+		sign func(s signer.Signer) ([]byte, error)
+	}{
+		{
+			"eth_sign signs the EIP-191 hash of the data",
+			accounts.TextHash(data),
+			func(s signer.Signer) ([]byte, error) {
+				return s.Sign(context.Background(), from, data)
+			},
+		},
+		{
+			"eth_signTypedData signs the EIP-712 hash of the typed data",
+			sigHash,
+			func(s signer.Signer) ([]byte, error) {
+				return s.SignTypedData(context.Background(), from, typedData)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var signatures [][]byte
+			for _, s := range []signer.Signer{keyringSigner, externalSigner} {
+				signature, err := tc.sign(s)
+				require.NoError(t, err)
+				require.Len(t, signature, crypto.SignatureLength)
+				require.Contains(t, []byte{27, 28}, signature[crypto.RecoveryIDOffset])
+
+				sig := common.CopyBytes(signature)
+				sig[crypto.RecoveryIDOffset] -= 27
+				pubKey, err := crypto.SigToPub(tc.hash, sig)
+				require.NoError(t, err)
+				require.Equal(t, from, crypto.PubkeyToAddress(*pubKey), "%T signature", s)
+
+				signatures = append(signatures, signature)
+			}
+
+			// both sign with the same key and deterministic nonces
+			require.Equal(t, signatures[0], signatures[1])
+		})
+	}
+}
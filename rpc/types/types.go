@@ -12,21 +12,59 @@ import (
 // internal pkg on geth.
 
 // AccountResult struct for account proof
+//
+// NOTE: AccountProofOps, BalanceProofOps, FractionalBalanceProofOps and
+// CodeHashProofOps are Cosmos EVM extensions of the eth_getProof response. They
+// prove the x/auth account, the x/bank balance of the EVM coin, the x/precisebank
+// fractional balance (only for EVM coins with less than 18 decimals) and the x/vm
+// code hash of the address.
+//
+// NOTE: StorageHash is not the Ethereum storage trie root. It is the root of a
+// trie built over the raw storage keys and values of the account (without the
+// keccak hashing of the keys and the RLP encoding of the values), computed by
+// the node and not committed in the app hash, so it cannot be verified. It is
+// left empty for accounts with more than the x/vm MaxStorageRootSlots storage
+// slots.
 type AccountResult struct {
-	Address      common.Address  `json:"address"`
-	AccountProof []string        `json:"accountProof"`
-	Balance      *hexutil.Big    `json:"balance"`
-	CodeHash     common.Hash     `json:"codeHash"`
-	Nonce        hexutil.Uint64  `json:"nonce"`
-	StorageHash  common.Hash     `json:"storageHash"`
-	StorageProof []StorageResult `json:"storageProof"`
+	Address                   common.Address  `json:"address"`
+	AccountProof              []string        `json:"accountProof"`
+	Balance                   *hexutil.Big    `json:"balance"`
+	CodeHash                  common.Hash     `json:"codeHash"`
+	Nonce                     hexutil.Uint64  `json:"nonce"`
+	StorageHash               common.Hash     `json:"storageHash"`
+	StorageProof              []StorageResult `json:"storageProof"`
+	AccountProofOps           *ProofOps       `json:"accountProofOps,omitempty"`
+	BalanceProofOps           *ProofOps       `json:"balanceProofOps,omitempty"`
+	FractionalBalanceProofOps *ProofOps       `json:"fractionalBalanceProofOps,omitempty"`
+	CodeHashProofOps          *ProofOps       `json:"codeHashProofOps,omitempty"`
 }
 
 // StorageResult defines the format for storage proof return
+//
+// NOTE: ProofOps is a Cosmos EVM extension of the eth_getProof response.
 type StorageResult struct {
-	Key   string       `json:"key"`
-	Value *hexutil.Big `json:"value"`
-	Proof []string     `json:"proof"`
+	Key      string       `json:"key"`
+	Value    *hexutil.Big `json:"value"`
+	Proof    []string     `json:"proof"`
+	ProofOps *ProofOps    `json:"proofOps,omitempty"`
+}
+
+// ProofOps defines the ICS23 proof of a key in a module store against the app
+// hash. The ops are ordered from the store key proof to the multistore proof,
+// so that the root computed by the last op is the app hash of the block
+// following the queried height.
+type ProofOps struct {
+	StoreKey string        `json:"storeKey"`
+	Key      hexutil.Bytes `json:"key"`
+	Value    hexutil.Bytes `json:"value"`
+	Ops      []ProofOp     `json:"ops"`
+}
+
+// ProofOp defines a single merkle proof operation as returned by CometBFT.
+type ProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
 }
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
@@ -87,3 +125,26 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// AddressTxsArgs represents the pagination arguments of eth_getTransactionsByAddress.
+type AddressTxsArgs struct {
+	// PageSize is the maximum number of transactions of the page
+	PageSize hexutil.Uint64 `json:"pageSize"`
+	// Ascending walks the history from the oldest transaction instead of the newest one
+	Ascending bool `json:"ascending"`
+	// Cursor is the position returned by the previous page, the page starts right after it
+	Cursor *AddressTxCursor `json:"cursor,omitempty"`
+}
+
+// AddressTxCursor is the position of a transaction in the history of an address.
+type AddressTxCursor struct {
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint   `json:"transactionIndex"`
+}
+
+// AddressTxsResult represents a page of the transactions sent by or to an address,
+// created a contract at it or emitting logs from it. Cursor is nil on the last page.
+type AddressTxsResult struct {
+	Transactions []*RPCTransaction `json:"transactions"`
+	Cursor       *AddressTxCursor  `json:"cursor"`
+}
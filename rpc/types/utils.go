@@ -12,16 +12,21 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // ExceedBlockGasLimitError defines the error message when tx execution exceeds the block gas limit.
@@ -287,3 +292,61 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
 }
+
+// NewProofOps returns the ICS23 proof operations of a store key in the
+// eth_getProof extension format. It returns nil if the proof is empty.
+func NewProofOps(storeKey string, key, value []byte, proof *crypto.ProofOps) *ProofOps {
+	if proof == nil {
+		return nil
+	}
+
+	ops := make([]ProofOp, len(proof.Ops))
+	for i, op := range proof.Ops {
+		ops[i] = ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+
+	return &ProofOps{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    value,
+		Ops:      ops,
+	}
+}
+
+// BalanceStoreKey returns the x/bank store key of the balance of the given denom
+// for an address.
+func BalanceStoreKey(address common.Address, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		collections.Join(sdk.AccAddress(address.Bytes()), denom),
+	)
+}
+
+// FractionalBalanceStoreKey returns the x/precisebank store key of the
+// fractional balance of an address.
+func FractionalBalanceStoreKey(address common.Address) []byte {
+	return append(precisebanktypes.FractionalBalancePrefix, precisebanktypes.FractionalBalanceKey(address.Bytes())...)
+}
+
+// CodeHashStoreKey returns the x/vm store key of the code hash of an address.
+func CodeHashStoreKey(address common.Address) []byte {
+	return append(evmtypes.KeyPrefixCodeHash, address.Bytes()...)
+}
+
+// ToProto converts the proof operations to the CometBFT proof format.
+func (p ProofOps) ToProto() *crypto.ProofOps {
+	ops := make([]crypto.ProofOp, len(p.Ops))
+	for i, op := range p.Ops {
+		ops[i] = crypto.ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+	return &crypto.ProofOps{Ops: ops}
+}
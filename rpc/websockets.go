@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"net"
 	"net/http"
 	"strconv"
 	"sync"
@@ -314,6 +315,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// identify the WebSocket client to the JSON-RPC rate limits
+	if host, _, err := net.SplitHostPort(wsConn.conn.RemoteAddr().String()); err == nil {
+		req.Header.Set("X-Forwarded-For", host)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -3,12 +3,15 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
+	cmtstrings "github.com/cometbft/cometbft/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -48,6 +51,9 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAuthAddress is the default address the authenticated JSON-RPC server binds to.
+	DefaultJSONRPCAuthAddress = "127.0.0.1:8551"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -103,6 +109,24 @@ const (
 
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
+
+	// DefaultBatchRequestLimit is the default maximum number of calls in a JSON-RPC batch request
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default maximum number of bytes returned from a JSON-RPC batch request
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+
+	// DefaultHTTPBodyLimit is the default maximum size in bytes of a JSON-RPC HTTP request body
+	DefaultHTTPBodyLimit = 5 * 1024 * 1024
+
+	// DefaultBlockCacheSize is the default number of decoded blocks cached by the JSON-RPC backend
+	DefaultBlockCacheSize = 256
+
+	// DefaultReceiptCacheSize is the default number of transaction receipts cached by the JSON-RPC backend
+	DefaultReceiptCacheSize = 4096
+
+	// DefaultLogCacheSize is the default number of blocks whose logs are cached by the JSON-RPC backend
+	DefaultLogCacheSize = 1024
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -172,6 +196,56 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// BatchRequestLimit defines the maximum number of calls in a batch request (0=unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize defines the maximum number of bytes returned from a batch request (0=unlimited).
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// HTTPBodyLimit defines the maximum size in bytes of an HTTP request body.
+	HTTPBodyLimit int `mapstructure:"http-body-limit"`
+	// AllowedMethods defines the namespaces (e.g. "eth") and methods (e.g. "debug_traceTransaction")
+	// that can be called. All the methods of the enabled namespaces can be called if empty.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the namespaces and methods that cannot be called.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// RateLimits defines the per client IP rate limits of namespaces and methods, in the
+	// "<namespace|method>:<calls per second>:<burst>" format.
+	RateLimits []string `mapstructure:"rate-limits"`
+	// AuthEnable defines if the JWT authenticated JSON-RPC server should be enabled.
+	AuthEnable bool `mapstructure:"auth-enable"`
+	// AuthAddress defines the HTTP server of the authenticated JSON-RPC server to listen on
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthJWTSecret defines the path of the file holding the hex encoded 32 bytes JWT secret.
+	// A new secret is written to <home>/config/jwt.hex if empty and the file does not exist.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// AuthAPI defines the privileged JSON-RPC namespaces that are only served by the
+	// authenticated server when it is enabled.
+	AuthAPI []string `mapstructure:"auth-api"`
+	// BlockCacheSize defines the number of finalized decoded blocks cached by the backend (0=disabled).
+	BlockCacheSize int `mapstructure:"block-cache-size"`
+	// ReceiptCacheSize defines the number of finalized transaction receipts cached by the backend (0=disabled).
+	ReceiptCacheSize int `mapstructure:"receipt-cache-size"`
+	// LogCacheSize defines the number of finalized blocks whose logs are cached by the backend (0=disabled).
+	LogCacheSize int `mapstructure:"log-cache-size"`
+	// GraphQLEnable defines if the EIP-1767 GraphQL endpoint should be served at /graphql
+	// by the JSON-RPC HTTP server. The method restrictions and rate limits apply to the
+	// GraphQL requests as calls to the eth_graphql method.
+	GraphQLEnable bool `mapstructure:"graphql-enable"`
+	// ExternalSigner defines the HTTP JSON-RPC endpoint of an external signer (e.g. Clef or Web3Signer)
+	// signing the eth_sendTransaction, eth_sign and eth_signTypedData requests instead of the node's
+	// keyring. The keyring is used if empty. As with the keyring, eth_sendTransaction requires
+	// AllowInsecureUnlock.
+	ExternalSigner string `mapstructure:"external-signer"`
+}
+
+// RateLimit defines a token bucket rate limit applied per client IP to the calls of a
+// JSON-RPC namespace or method.
+type RateLimit struct {
+	// Target is the namespace (e.g. "debug") or method (e.g. "eth_getLogs") that is limited.
+	Target string
+	// Rate is the number of calls per second added to the bucket.
+	Rate float64
+	// Burst is the size of the bucket.
+	Burst int
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -194,7 +268,7 @@ func DefaultEVMConfig() *EVMConfig {
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !cmtstrings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -206,9 +280,15 @@ func GetDefaultAPINamespaces() []string {
 	return []string{"eth", "net", "web3"}
 }
 
+// GetDefaultAuthAPINamespaces returns the default list of privileged JSON-RPC namespaces
+// that are only served by the authenticated server
+func GetDefaultAuthAPINamespaces() []string {
+	return []string{"personal", "debug", "miner"}
+}
+
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "ots"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -233,6 +313,21 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		HTTPBodyLimit:            DefaultHTTPBodyLimit,
+		AllowedMethods:           []string{},
+		DeniedMethods:            []string{},
+		RateLimits:               []string{},
+		AuthEnable:               false,
+		AuthAddress:              DefaultJSONRPCAuthAddress,
+		AuthJWTSecret:            "",
+		AuthAPI:                  GetDefaultAuthAPINamespaces(),
+		BlockCacheSize:           DefaultBlockCacheSize,
+		ReceiptCacheSize:         DefaultReceiptCacheSize,
+		LogCacheSize:             DefaultLogCacheSize,
+		GraphQLEnable:            false,
+		ExternalSigner:           "",
 	}
 }
 
@@ -274,6 +369,22 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.HTTPBodyLimit <= 0 {
+		return errors.New("JSON-RPC HTTP body limit cannot be negative or 0")
+	}
+
+	if c.BlockCacheSize < 0 || c.ReceiptCacheSize < 0 || c.LogCacheSize < 0 {
+		return errors.New("JSON-RPC cache sizes cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -284,9 +395,78 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	for _, method := range append(c.AllowedMethods, c.DeniedMethods...) {
+		if method == "" {
+			return errors.New("JSON-RPC allowed and denied methods cannot be empty")
+		}
+	}
+
+	if _, err := ParseRateLimits(c.RateLimits); err != nil {
+		return err
+	}
+
+	if c.AuthEnable {
+		if c.AuthAddress == "" {
+			return errors.New("cannot enable the authenticated JSON-RPC server without an address")
+		}
+
+		if c.AuthAddress == c.Address {
+			return errors.New("the authenticated JSON-RPC server address cannot be the JSON-RPC server address")
+		}
+	}
+
+	seenAuthAPIs := make(map[string]bool)
+	for _, api := range c.AuthAPI {
+		if seenAuthAPIs[api] {
+			return fmt.Errorf("repeated authenticated API namespace '%s'", api)
+		}
+
+		seenAuthAPIs[api] = true
+	}
+
+	if c.ExternalSigner != "" {
+		u, err := url.Parse(c.ExternalSigner)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid external signer endpoint '%s', expected an http(s) URL", c.ExternalSigner)
+		}
+	}
+
 	return nil
 }
 
+// ParseRateLimits parses the rate limits defined in the
+// "<namespace|method>:<calls per second>:<burst>" format.
+func ParseRateLimits(entries []string) ([]RateLimit, error) {
+	limits := make([]RateLimit, 0, len(entries))
+	seenTargets := make(map[string]bool)
+
+	for _, entry := range entries {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC rate limit '%s', expected <namespace|method>:<calls per second>:<burst>", entry)
+		}
+
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC rate limit '%s': rate must be a positive number", entry)
+		}
+
+		burst, err := strconv.Atoi(parts[2])
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC rate limit '%s': burst must be a positive integer", entry)
+		}
+
+		if seenTargets[parts[0]] {
+			return nil, fmt.Errorf("repeated JSON-RPC rate limit for '%s'", parts[0])
+		}
+		seenTargets[parts[0]] = true
+
+		limits = append(limits, RateLimit{Target: parts[0], Rate: rate, Burst: burst})
+	}
+
+	return limits, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
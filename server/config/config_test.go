@@ -69,3 +69,80 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRateLimits(t *testing.T) {
+	testCases := []struct {
+		name    string
+		entries []string
+		expPass bool
+	}{
+		{"empty", nil, true},
+		{"namespace and method", []string{"debug:1:5", "eth_call:0.5:1"}, true},
+		{"missing burst", []string{"debug:1"}, false},
+		{"empty target", []string{":1:5"}, false},
+		{"zero rate", []string{"debug:0:5"}, false},
+		{"invalid rate", []string{"debug:fast:5"}, false},
+		{"zero burst", []string{"debug:1:0"}, false},
+		{"repeated target", []string{"debug:1:5", "debug:2:5"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limits, err := serverconfig.ParseRateLimits(tc.entries)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Len(t, limits, len(tc.entries))
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		expPass  bool
+	}{
+		{"default", func(*serverconfig.JSONRPCConfig) {}, true},
+		{"negative batch request limit", func(cfg *serverconfig.JSONRPCConfig) { cfg.BatchRequestLimit = -1 }, false},
+		{"negative batch response size", func(cfg *serverconfig.JSONRPCConfig) { cfg.BatchResponseMaxSize = -1 }, false},
+		{"zero body limit", func(cfg *serverconfig.JSONRPCConfig) { cfg.HTTPBodyLimit = 0 }, false},
+		{"empty allowed method", func(cfg *serverconfig.JSONRPCConfig) { cfg.AllowedMethods = []string{""} }, false},
+		{"empty denied method", func(cfg *serverconfig.JSONRPCConfig) { cfg.DeniedMethods = []string{""} }, false},
+		{"invalid rate limit", func(cfg *serverconfig.JSONRPCConfig) { cfg.RateLimits = []string{"debug"} }, false},
+		{"valid rate limit", func(cfg *serverconfig.JSONRPCConfig) { cfg.RateLimits = []string{"debug:1:5"} }, true},
+		{"auth enabled", func(cfg *serverconfig.JSONRPCConfig) { cfg.AuthEnable = true }, true},
+		{"auth enabled without address", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthEnable = true
+			cfg.AuthAddress = ""
+		}, false},
+		{"auth address same as address", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.AuthEnable = true
+			cfg.AuthAddress = cfg.Address
+		}, false},
+		{"negative block cache size", func(cfg *serverconfig.JSONRPCConfig) { cfg.BlockCacheSize = -1 }, false},
+		{"disabled caches", func(cfg *serverconfig.JSONRPCConfig) {
+			cfg.BlockCacheSize = 0
+			cfg.ReceiptCacheSize = 0
+			cfg.LogCacheSize = 0
+		}, true},
+		{"repeated auth namespace", func(cfg *serverconfig.JSONRPCConfig) { cfg.AuthAPI = []string{"debug", "debug"} }, false},
+		{"external signer", func(cfg *serverconfig.JSONRPCConfig) { cfg.ExternalSigner = "http://127.0.0.1:8550" }, true},
+		{"external signer without scheme", func(cfg *serverconfig.JSONRPCConfig) { cfg.ExternalSigner = "127.0.0.1:8550" }, false},
+		{"external signer ipc path", func(cfg *serverconfig.JSONRPCConfig) { cfg.ExternalSigner = "/tmp/clef.ipc" }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			if tc.expPass {
+				require.NoError(t, cfg.Validate())
+			} else {
+				require.Error(t, cfg.Validate())
+			}
+		})
+	}
+}
@@ -86,6 +86,69 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# BatchRequestLimit defines the maximum number of calls in a batch request (0=unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize defines the maximum number of bytes returned from a batch request (0=unlimited).
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
+# HTTPBodyLimit defines the maximum size in bytes of an HTTP request body.
+http-body-limit = {{ .JSONRPC.HTTPBodyLimit }}
+
+# AllowedMethods defines the namespaces and methods that can be called. All the methods of the
+# enabled namespaces can be called if empty.
+# Example: "eth,net,web3,debug_traceTransaction"
+allowed-methods = "{{range $index, $elmt := .JSONRPC.AllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# DeniedMethods defines the namespaces and methods that cannot be called.
+# Example: "personal,eth_sign"
+denied-methods = "{{range $index, $elmt := .JSONRPC.DeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimits defines the token bucket rate limits applied per client IP to namespaces and methods,
+# in the "<namespace|method>:<calls per second>:<burst>" format. Method limits take precedence
+# over namespace limits. Rejected calls are counted in the rpc/rejected metrics.
+# Example: "debug:1:5,eth_getLogs:10:20"
+rate-limits = "{{range $index, $elmt := .JSONRPC.RateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AuthEnable defines if the JWT authenticated JSON-RPC server should be enabled. When enabled, the
+# auth-api namespaces are only served by this server, which requires an HS256 JWT signed with the
+# shared secret in the Authorization header of each request.
+auth-enable = {{ .JSONRPC.AuthEnable }}
+
+# AuthAddress defines the authenticated JSON-RPC HTTP server address to bind to.
+auth-address = "{{ .JSONRPC.AuthAddress }}"
+
+# AuthJWTSecret defines the path of the file holding the hex encoded 32 bytes JWT secret.
+# A new secret is written to <home>/config/jwt.hex if empty and the file does not exist.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
+# AuthAPI defines the privileged namespaces that are only served by the authenticated server when
+# it is enabled. The enabled namespaces of the api list are served by both servers.
+auth-api = "{{range $index, $elmt := .JSONRPC.AuthAPI}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# BlockCacheSize defines the number of finalized decoded blocks cached by the backend (0=disabled).
+block-cache-size = {{ .JSONRPC.BlockCacheSize }}
+
+# ReceiptCacheSize defines the number of finalized transaction receipts cached by the backend (0=disabled).
+receipt-cache-size = {{ .JSONRPC.ReceiptCacheSize }}
+
+# LogCacheSize defines the number of finalized blocks whose logs are cached by the backend (0=disabled).
+# Cache hits and misses are counted in the rpc/cache metrics.
+log-cache-size = {{ .JSONRPC.LogCacheSize }}
+
+# GraphQLEnable defines if the EIP-1767 GraphQL endpoint should be served at /graphql by the
+# JSON-RPC HTTP server. The queries are subject to the gas-cap, block-range-cap and logs-cap
+# limits, and to the allowed-methods, denied-methods and rate-limits ones as calls to the
+# eth_graphql method.
+graphql-enable = {{ .JSONRPC.GraphQLEnable }}
+
+# ExternalSigner defines the HTTP JSON-RPC endpoint of an external signer (e.g. Clef or Web3Signer)
+# signing the eth_sendTransaction, eth_sign and eth_signTypedData requests through its
+# account_signTransaction, eth_sign and eth_signTypedData methods, so that the keys don't reside on
+# the node. The node's keyring is used if empty. As with the keyring, eth_sendTransaction requires
+# allow-insecure-unlock.
+external-signer = "{{ .JSONRPC.ExternalSigner }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -52,6 +52,12 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCAuthEnable          = "json-rpc.auth-enable"
+	JSONRPCAuthAddress         = "json-rpc.auth-address"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
+	JSONRPCAuthAPI             = "json-rpc.auth-api"
+	JSONRPCGraphQLEnable       = "json-rpc.graphql-enable"
+	JSONRPCExternalSigner      = "json-rpc.external-signer"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -1,15 +1,25 @@
 package server
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"path/filepath"
+	"slices"
 	"time"
 
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	"github.com/cosmos/evm/rpc/middleware"
 	serverconfig "github.com/cosmos/evm/server/config"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
@@ -32,26 +42,43 @@ func StartJSONRPC(ctx *server.Context,
 	handler := &CustomSlogHandler{logger: logger}
 	slog.SetDefault(slog.New(handler))
 
-	rpcServer := ethrpc.NewServer()
-
-	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
+	if config.JSONRPC.AuthEnable {
+		// privileged namespaces are only served by the authenticated server
+		rpcAPIArr = excludeNamespaces(rpcAPIArr, config.JSONRPC.AuthAPI)
+	}
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	// the backends of the namespaces and the GraphQL server share the same cache
+	cache := backend.NewRPCCache(config.JSONRPC)
 
-	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			ctx.Logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
-			return nil, nil, err
-		}
+	rpcServer, err := newRPCServer(ctx, clientCtx, tmWsClient, config, indexer, cache, rpcAPIArr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rpcHandler, err := middleware.NewLimitsHandler(rpcServer, config.JSONRPC, ctx.Logger)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
+
+	if config.JSONRPC.GraphQLEnable {
+		evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, config.JSONRPC.AllowUnprotectedTxs, indexer, cache)
+		graphqlHandler, err := graphql.NewHandler(ctx.Logger, evmBackend, config.JSONRPC.HTTPBodyLimit)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create the GraphQL handler: %w", err)
+		}
+
+		limitedGraphqlHandler, err := middleware.NewGraphQLLimitsHandler(graphqlHandler, config.JSONRPC, ctx.Logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r.Handle("/graphql", limitedGraphqlHandler).Methods("POST")
+		ctx.Logger.Info("Serving GraphQL queries", "address", config.JSONRPC.Address, "path", "/graphql")
+	}
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -73,32 +100,159 @@ func StartJSONRPC(ctx *server.Context,
 		return nil, nil, err
 	}
 
+	ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
+	if err := serveJSONRPC(ctx, httpSrv, ln, httpSrvDone); err != nil {
+		return nil, nil, err
+	}
+
+	if config.JSONRPC.AuthEnable {
+		authSrv, err := startAuthJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, config, indexer, cache)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the authenticated server is stopped with the public one
+		httpSrv.RegisterOnShutdown(func() {
+			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFn()
+			if err := authSrv.Shutdown(shutdownCtx); err != nil {
+				ctx.Logger.Error("authenticated JSON-RPC server shutdown produced a warning", "error", err.Error())
+			}
+		})
+	}
+
+	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+
+	// allocate separate WS connection to Tendermint
+	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv.Start()
+	return httpSrv, httpSrvDone, nil
+}
+
+// startAuthJSONRPC starts the JSON-RPC server serving the enabled and privileged
+// namespaces to the requests authenticated with the JWT secret.
+func startAuthJSONRPC(ctx *server.Context,
+	clientCtx client.Context,
+	tmRPCAddr,
+	tmEndpoint string,
+	config *serverconfig.Config,
+	indexer cosmosevmtypes.EVMTxIndexer,
+	cache *backend.RPCCache,
+) (*http.Server, error) {
+	secretPath := config.JSONRPC.AuthJWTSecret
+	if secretPath == "" {
+		secretPath = filepath.Join(ctx.Config.RootDir, "config", "jwt.hex")
+	}
+
+	// a new secret is written to the file if it does not exist
+	secret, err := middleware.LoadJWTSecret(secretPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the JWT secret from %s: %w", secretPath, err)
+	}
+
+	// allocate separate WS connection to CometBFT for the filters
+	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+
+	namespaces := append(excludeNamespaces(config.JSONRPC.API, config.JSONRPC.AuthAPI), config.JSONRPC.AuthAPI...)
+	rpcServer, err := newRPCServer(ctx, clientCtx, tmWsClient, config, indexer, cache, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	r := mux.NewRouter()
+	r.Handle("/", middleware.NewJWTHandler(secret, rpcServer)).Methods("POST")
+
+	authSrv := &http.Server{
+		Addr:              config.JSONRPC.AuthAddress,
+		Handler:           r,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(authSrv.Addr, config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Info(
+		"Starting authenticated JSON-RPC server",
+		"address", config.JSONRPC.AuthAddress,
+		"namespaces", namespaces,
+		"jwt-secret", secretPath,
+	)
+	if err := serveJSONRPC(ctx, authSrv, ln, make(chan struct{}, 1)); err != nil {
+		return nil, err
+	}
+
+	return authSrv, nil
+}
+
+// newRPCServer creates the JSON-RPC server serving the given namespaces with the
+// batch and body limits of the configuration.
+func newRPCServer(ctx *server.Context,
+	clientCtx client.Context,
+	tmWsClient *rpcclient.WSClient,
+	config *serverconfig.Config,
+	indexer cosmosevmtypes.EVMTxIndexer,
+	cache *backend.RPCCache,
+	namespaces []string,
+) (*ethrpc.Server, error) {
+	rpcServer := ethrpc.NewServer()
+	rpcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+	rpcServer.SetHTTPBodyLimit(config.JSONRPC.HTTPBodyLimit)
+
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, config.JSONRPC.AllowUnprotectedTxs, indexer, cache, namespaces)
+
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			ctx.Logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return nil, err
+		}
+	}
+
+	return rpcServer, nil
+}
+
+// serveJSONRPC serves the HTTP server on the listener and waits for it to start.
+// The done channel is closed once the server is shut down.
+func serveJSONRPC(ctx *server.Context, httpSrv *http.Server, ln net.Listener, done chan struct{}) error {
 	errCh := make(chan error)
 	go func() {
-		ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
 		if err := httpSrv.Serve(ln); err != nil {
 			if err == http.ErrServerClosed {
-				close(httpSrvDone)
+				close(done)
 				return
 			}
 
-			ctx.Logger.Error("failed to start JSON-RPC server", "error", err.Error())
+			ctx.Logger.Error("failed to start JSON-RPC server", "address", httpSrv.Addr, "error", err.Error())
 			errCh <- err
 		}
 	}()
 
 	select {
 	case err := <-errCh:
-		ctx.Logger.Error("failed to boot JSON-RPC server", "error", err.Error())
-		return nil, nil, err
+		ctx.Logger.Error("failed to boot JSON-RPC server", "address", httpSrv.Addr, "error", err.Error())
+		return err
 	case <-time.After(serverconfig.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
-	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+	return nil
+}
 
-	// allocate separate WS connection to Tendermint
-	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
-	wsSrv.Start()
-	return httpSrv, httpSrvDone, nil
+// excludeNamespaces returns the namespaces that are not in the excluded list.
+func excludeNamespaces(namespaces, excluded []string) []string {
+	filtered := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if !slices.Contains(excluded, namespace) {
+			filtered = append(filtered, namespace)
+		}
+	}
+	return filtered
 }
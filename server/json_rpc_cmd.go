@@ -0,0 +1,201 @@
+package server
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// NewJSONRPCCmd creates a new Cobra command that runs the Ethereum JSON-RPC server
+// as a standalone process, serving the requests from the gRPC and CometBFT RPC
+// endpoints of a remote node. As no application is created by the process, the
+// evmAppOptions function is called with the configured EVM chain ID to set up the
+// global EVM configuration (chain config and coin info) of the chain.
+func NewJSONRPCCmd(evmAppOptions func(uint64) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "json-rpc",
+		Short: "Run a standalone Ethereum JSON-RPC server connected to a remote node",
+		Long: `Run the Ethereum JSON-RPC and WebSocket servers without a local node.
+
+State queries are sent to the gRPC endpoint given by --grpc-addr and blocks, transactions
+and event subscriptions are read from the CometBFT RPC endpoint given by --node. The
+process keeps no chain state, so several instances can be run behind a load balancer.
+
+The EVM chain ID and the remaining JSON-RPC settings are read from the app.toml in the
+home directory. When the custom tx indexer is enabled, its database is kept in the home
+directory and filled from the blocks of the remote node.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grpcAddr, _ := cmd.Flags().GetString(flags.FlagGRPC)
+			grpcInsecure, _ := cmd.Flags().GetBool(flags.FlagGRPCInsecure)
+			clientCtx, err = withRemoteGRPCClient(clientCtx, grpcAddr, grpcInsecure)
+			if err != nil {
+				return err
+			}
+
+			return startStandaloneJSONRPC(serverCtx, clientCtx, evmAppOptions)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to the CometBFT RPC interface of the remote node")
+	cmd.Flags().String(flags.FlagGRPC, "", "the gRPC endpoint of the remote node")
+	cmd.Flags().Bool(flags.FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
+	cmd.Flags().String(srvflags.AppDBBackend, "", "The type of database for the custom tx indexer")
+	cmd.Flags().Bool(srvflags.EnabledUnsafeCors, false, "Defines if CORS should be enabled (unsafe - use it at your own risk)")
+
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
+	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aedgens (0=infinite)")    //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)") //nolint:lll
+	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
+	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCAuthEnable, false, "Define if the JWT authenticated JSON-RPC server serving the privileged namespaces should be enabled") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, cosmosevmserverconfig.DefaultJSONRPCAuthAddress, "the authenticated JSON-RPC server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Path to the hex encoded JWT secret file of the authenticated JSON-RPC server (default <home>/config/jwt.hex)")                           //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, cosmosevmserverconfig.GetDefaultAuthAPINamespaces(), "Defines a list of JSON-RPC namespaces that are only served by the authenticated server") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCGraphQLEnable, false, "Define if the EIP-1767 GraphQL endpoint should be served at /graphql by the JSON-RPC server")
+	cmd.Flags().String(srvflags.JSONRPCExternalSigner, "", "the HTTP JSON-RPC endpoint of the external signer (e.g. Clef or Web3Signer) used instead of the node's keyring")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+
+	return cmd
+}
+
+// startStandaloneJSONRPC starts the JSON-RPC server against the remote node set in the
+// client context and blocks until a quit signal is received.
+func startStandaloneJSONRPC(svrCtx *server.Context, clientCtx client.Context, evmAppOptions func(uint64) error) error {
+	logger := svrCtx.Logger
+	g, ctx := getCtx(svrCtx, true)
+
+	if clientCtx.Client == nil {
+		return errors.New("the CometBFT RPC endpoint of the remote node must be set with --node")
+	}
+
+	config, err := cosmosevmserverconfig.GetConfig(svrCtx.Viper)
+	if err != nil {
+		logger.Error("failed to get server config", "error", err.Error())
+		return err
+	}
+
+	if err := config.ValidateBasic(); err != nil {
+		logger.Error("invalid server config", "error", err.Error())
+		return err
+	}
+
+	if err := evmAppOptions(config.EVM.EVMChainID); err != nil {
+		return fmt.Errorf("failed to set up the EVM configuration: %w", err)
+	}
+
+	status, err := clientCtx.Client.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the status of the remote node %s: %w", clientCtx.NodeURI, err)
+	}
+
+	clientCtx = clientCtx.
+		WithHomeDir(svrCtx.Config.RootDir).
+		WithChainID(status.NodeInfo.Network)
+
+	// Flag not added in config to avoid user enabling in config without passing in CLI
+	if svrCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+	}
+
+	var idxer cosmosevmtypes.EVMTxIndexer
+	if config.JSONRPC.EnableIndexer {
+		remote, ok := clientCtx.Client.(rpcclient.Client)
+		if !ok {
+			return fmt.Errorf("invalid rpc client, expected: rpcclient.Client, got: %T", clientCtx.Client)
+		}
+
+		// the remote client only delivers the new block events once its
+		// WebSocket connection is started
+		if !remote.IsRunning() {
+			if err := remote.Start(); err != nil {
+				return fmt.Errorf("failed to subscribe to the remote node events: %w", err)
+			}
+		}
+
+		idxer, err = startEVMIndexer(ctx, svrCtx, clientCtx, g)
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Info(
+		"serving JSON-RPC from remote node",
+		"chain-id", clientCtx.ChainID,
+		"node", clientCtx.NodeURI,
+	)
+
+	httpSrv, httpSrvDone, err := StartJSONRPC(svrCtx, clientCtx, clientCtx.NodeURI, "/websocket", &config, idxer)
+	if err != nil {
+		return err
+	}
+	defer stopJSONRPCServer(svrCtx, httpSrv, httpSrvDone)
+
+	// wait for signal capture and gracefully return
+	// we are guaranteed to be waiting for the "ListenForQuitSignals" goroutine.
+	return g.Wait()
+}
+
+// withRemoteGRPCClient sets the gRPC client of the remote node on the client context.
+// Unlike the client created by the SDK from the --grpc-addr flag, it decodes the
+// query responses with the gogoproto codec, which is required for the custom types
+// (e.g. math.Int) used by the module queries.
+func withRemoteGRPCClient(clientCtx client.Context, address string, useInsecure bool) (client.Context, error) {
+	if address == "" {
+		return clientCtx, errors.New("the gRPC endpoint of the remote node must be set with --grpc-addr")
+	}
+
+	creds := insecure.NewCredentials()
+	if !useInsecure {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	grpcClient, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			grpc.MaxCallRecvMsgSize(serverconfig.DefaultGRPCMaxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(serverconfig.DefaultGRPCMaxSendMsgSize),
+		),
+	)
+	if err != nil {
+		return clientCtx, fmt.Errorf("failed to create the gRPC client of the remote node %s: %w", address, err)
+	}
+
+	return clientCtx.WithGRPCClient(grpcClient), nil
+}
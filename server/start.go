@@ -198,6 +198,12 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCAuthEnable, false, "Define if the JWT authenticated JSON-RPC server serving the privileged namespaces should be enabled") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, cosmosevmserverconfig.DefaultJSONRPCAuthAddress, "the authenticated JSON-RPC server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Path to the hex encoded JWT secret file of the authenticated JSON-RPC server (default <home>/config/jwt.hex)")                           //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, cosmosevmserverconfig.GetDefaultAuthAPINamespaces(), "Defines a list of JSON-RPC namespaces that are only served by the authenticated server") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCGraphQLEnable, false, "Define if the EIP-1767 GraphQL endpoint should be served at /graphql by the JSON-RPC server")
+	cmd.Flags().String(srvflags.JSONRPCExternalSigner, "", "the HTTP JSON-RPC endpoint of the external signer (e.g. Clef or Web3Signer) used instead of the node's keyring")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -421,20 +427,10 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer cosmosevmtypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxer, err = startEVMIndexer(ctx, svrCtx, clientCtx, g)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
-		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
-
-		g.Go(func() error {
-			return indexerService.Start()
-		})
 	}
 
 	if config.API.Enable || config.JSONRPC.Enable {
@@ -464,19 +460,7 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
 	if httpSrv != nil {
-		defer func() {
-			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancelFn()
-			if err := httpSrv.Shutdown(shutdownCtx); err != nil {
-				logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-httpSrvDone:
-				}
-			}
-		}()
+		defer stopJSONRPCServer(svrCtx, httpSrv, httpSrvDone)
 	}
 
 	// At this point it is safe to block the process if we're in query only mode as
@@ -492,6 +476,59 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	return g.Wait()
 }
 
+// startEVMIndexer opens the custom eth indexer db under the node home and starts
+// the service that indexes the new blocks received from the CometBFT client. The
+// indexing loop never returns, so the errgroup stops waiting for it once ctx is
+// canceled by a quit signal.
+func startEVMIndexer(
+	ctx context.Context,
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+) (cosmosevmtypes.EVMTxIndexer, error) {
+	idxDB, err := OpenIndexerDB(svrCtx.Config.RootDir, server.GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		svrCtx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+
+	idxLogger := svrCtx.Logger.With("indexer", "evm")
+	idxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+	indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+	indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+
+	g.Go(func() error {
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- indexerService.Start()
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	})
+	return idxer, nil
+}
+
+// stopJSONRPCServer gracefully shuts down the JSON-RPC HTTP server, waiting up to
+// 5 seconds for it to finish serving.
+func stopJSONRPCServer(svrCtx *server.Context, httpSrv *http.Server, httpSrvDone chan struct{}) {
+	shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+	if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+		svrCtx.Logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+	} else {
+		svrCtx.Logger.Info("HTTP server shut down, waiting 5 sec")
+		select {
+		case <-time.Tick(5 * time.Second):
+		case <-httpSrvDone:
+		}
+	}
+}
+
 // OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
 func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
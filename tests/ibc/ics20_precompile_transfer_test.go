@@ -48,6 +48,7 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.EVMKeeper,
+		evmAppA.Erc20Keeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile, _ = ics20.NewPrecompile(
@@ -55,6 +56,7 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.EVMKeeper,
+		evmAppB.Erc20Keeper,
 	)
 }
 
@@ -329,6 +331,144 @@ func (suite *ICS20TransferTestSuite) TestHandleMsgTransfer() {
 	}
 }
 
+// TestHandleMsgTransferFrom checks that the ics20 precompile transferFrom method spends the
+// ERC20 precompile allowance granted by the sender and escrows the sender's tokens.
+func (suite *ICS20TransferTestSuite) TestHandleMsgTransferFrom() {
+	var allowance *big.Int
+
+	msgAmount := evmibctesting.DefaultCoinAmount
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPass      bool
+		expAllowance *big.Int
+	}{
+		{
+			"fail - no allowance",
+			func() {
+				allowance = nil
+			},
+			false,
+			common.Big0,
+		},
+		{
+			"fail - insufficient allowance",
+			func() {
+				allowance = new(big.Int).Sub(msgAmount.BigInt(), common.Big1)
+			},
+			false,
+			new(big.Int).Sub(msgAmount.BigInt(), common.Big1),
+		},
+		{
+			"pass - allowance fully spent",
+			func() {
+				allowance = msgAmount.BigInt()
+			},
+			true,
+			common.Big0,
+		},
+		{
+			"pass - remaining allowance",
+			func() {
+				allowance = new(big.Int).Add(msgAmount.BigInt(), common.Big1)
+			},
+			true,
+			common.Big1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pathAToB := evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			pathAToB.Setup()
+
+			tc.malleate()
+
+			evmAppA := suite.chainA.App.(*evmd.EVMD)
+			ctxA := suite.chainA.GetContext()
+			bondDenom, err := evmAppA.StakingKeeper.BondDenom(ctxA)
+			suite.Require().NoError(err)
+
+			// register the ERC20 precompile of the bond denom, on which the allowance is granted
+			tokenPair, err := evmAppA.Erc20Keeper.RegisterNativeERC20Extension(ctxA, bondDenom)
+			suite.Require().NoError(err)
+			erc20Addr := tokenPair.GetERC20Contract()
+
+			// the owner of the tokens is a different account than the one sending the transaction
+			ownerAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			owner := common.BytesToAddress(ownerAcc.Bytes())
+			spender := common.BytesToAddress(suite.chainA.SenderAccount.GetAddress().Bytes())
+			if allowance != nil {
+				err = evmAppA.Erc20Keeper.SetAllowance(ctxA, erc20Addr, owner, spender, allowance)
+				suite.Require().NoError(err)
+			}
+
+			ownerBalance := evmAppA.BankKeeper.GetBalance(ctxA, ownerAcc, bondDenom)
+			spenderBalance := evmAppA.BankKeeper.GetBalance(ctxA, suite.chainA.SenderAccount.GetAddress(), bondDenom)
+
+			data, err := suite.chainAPrecompile.ABI.Pack(ics20.TransferFromMethod,
+				pathAToB.EndpointA.ChannelConfig.PortID,
+				pathAToB.EndpointA.ChannelID,
+				bondDenom,
+				msgAmount.BigInt(),
+				owner,
+				suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(1, 110),
+				uint64(0),
+				"",
+			)
+			suite.Require().NoError(err)
+
+			res, err := suite.chainA.SendEvmTx(
+				suite.chainA.SenderPrivKey, suite.chainAPrecompile.Address(), big.NewInt(0), data)
+
+			ctxA = suite.chainA.GetContext()
+			remaining, allowanceErr := evmAppA.Erc20Keeper.GetAllowance(ctxA, erc20Addr, owner, spender)
+			suite.Require().NoError(allowanceErr)
+			suite.Require().Equal(tc.expAllowance.String(), remaining.String())
+
+			// NOTE: a reverted EVM transaction is still included in the block, so we
+			// check that no packet was sent instead of the transaction result.
+			suite.Require().NoError(err)
+			packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(ownerBalance, evmAppA.BankKeeper.GetBalance(ctxA, ownerAcc, bondDenom))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the tokens are escrowed from the owner, the spender only pays for the fees
+			escrowAddress := transfertypes.GetEscrowAddress(pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID)
+			suite.Require().Equal(
+				ownerBalance.Amount.Sub(msgAmount),
+				evmAppA.BankKeeper.GetBalance(ctxA, ownerAcc, bondDenom).Amount,
+			)
+			suite.Require().Equal(msgAmount, evmAppA.BankKeeper.GetBalance(ctxA, escrowAddress, bondDenom).Amount)
+			suite.Require().True(
+				spenderBalance.Amount.Sub(msgAmount).LT(evmAppA.BankKeeper.GetBalance(ctxA, suite.chainA.SenderAccount.GetAddress(), bondDenom).Amount),
+			)
+
+			err = pathAToB.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			// check that voucher exists on chain B
+			evmAppB := suite.chainB.App.(*evmd.EVMD)
+			traceAToB := transfertypes.NewHop(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID)
+			chainBDenom := transfertypes.NewDenom(bondDenom, traceAToB)
+			chainBBalance := evmAppB.BankKeeper.GetBalance(
+				suite.chainB.GetContext(),
+				suite.chainB.SenderAccount.GetAddress(),
+				chainBDenom.IBCDenom(),
+			)
+			suite.Require().Equal(sdk.NewCoin(chainBDenom.IBCDenom(), msgAmount), chainBBalance)
+		})
+	}
+}
+
 func TestICS20TransferTestSuite(t *testing.T) {
 	suite.Run(t, new(ICS20TransferTestSuite))
 }
@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
 	testifysuite "github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/evmd"
@@ -15,6 +18,7 @@ import (
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	"github.com/cosmos/evm/x/erc20/types"
 	"github.com/cosmos/evm/x/erc20/v2"
+	"github.com/cosmos/evm/x/vm/statedb"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
@@ -939,3 +943,77 @@ func (suite *MiddlewareV2TestSuite) TestOnTimeoutPacketNativeErc20() {
 		})
 	}
 }
+
+// TestPacketCallback tests that the callbacks registered by the contracts sending
+// v2 packets through the ICS20 precompile are executed and cleared on the
+// acknowledgement and timeout of the packets.
+func (suite *MiddlewareV2TestSuite) TestPacketCallback() {
+	// selectorRecorderCode stores the 4-byte selector of the call in the storage slot 0:
+	// PUSH1 0 CALLDATALOAD PUSH1 0xe0 SHR PUSH1 0 SSTORE STOP
+	selectorRecorderCode := common.FromHex("0x60003560e01c60005500")
+
+	testCases := []struct {
+		name        string
+		timeout     bool
+		expSelector []byte
+	}{
+		{"pass: onAckPacket", false, types.ICS20CallbacksABI.Methods[types.OnAckPacketMethod].ID},
+		{"pass: onTimeoutPacket", true, types.ICS20CallbacksABI.Methods[types.OnTimeoutPacketMethod].ID},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.evmChainA.GetContext()
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			bondDenom, err := evmApp.StakingKeeper.BondDenom(ctx)
+			suite.Require().NoError(err)
+
+			contract := common.BytesToAddress([]byte("callback-contract"))
+			codeHash := crypto.Keccak256(selectorRecorderCode)
+			evmApp.EVMKeeper.SetCode(ctx, codeHash, selectorRecorderCode)
+			suite.Require().NoError(evmApp.EVMKeeper.SetAccount(ctx, contract, statedb.Account{
+				Balance:  uint256.NewInt(0),
+				CodeHash: codeHash,
+			}))
+
+			sourceClient := suite.pathAToB.EndpointA.ClientID
+			evmApp.Erc20Keeper.SetPacketCallback(ctx, transfertypes.PortID, sourceClient, 1, contract)
+
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				bondDenom,
+				ibctesting.DefaultCoinAmount.String(),
+				suite.evmChainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				"",
+			)
+			payload := channeltypesv2.NewPayload(
+				transfertypes.PortID, transfertypes.PortID,
+				transfertypes.V1, transfertypes.EncodingJSON,
+				packetData.GetBytes(),
+			)
+
+			transferStack := suite.evmChainA.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(ibctesting.TransferPort)
+			suite.Require().NoError(transferStack.OnSendPacket(
+				ctx, sourceClient, suite.pathAToB.EndpointB.ClientID, 1, payload, suite.evmChainA.SenderAccount.GetAddress(),
+			))
+
+			if tc.timeout {
+				err = transferStack.OnTimeoutPacket(
+					ctx, sourceClient, suite.pathAToB.EndpointB.ClientID, 1, payload, suite.evmChainA.SenderAccount.GetAddress(),
+				)
+			} else {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+				err = transferStack.OnAcknowledgementPacket(
+					ctx, sourceClient, suite.pathAToB.EndpointB.ClientID, 1, ack, payload, suite.evmChainA.SenderAccount.GetAddress(),
+				)
+			}
+			suite.Require().NoError(err)
+
+			slot := evmApp.EVMKeeper.GetState(ctx, contract, common.Hash{})
+			suite.Require().Equal(common.BytesToHash(tc.expSelector), slot)
+			_, found := evmApp.Erc20Keeper.GetPacketCallback(ctx, transfertypes.PortID, sourceClient, 1)
+			suite.Require().False(found)
+		})
+	}
+}
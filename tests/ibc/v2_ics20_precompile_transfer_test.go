@@ -49,6 +49,7 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
 		evmAppA.EVMKeeper,
+		evmAppA.Erc20Keeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile, _ = ics20.NewPrecompile(
@@ -56,6 +57,7 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
 		evmAppB.EVMKeeper,
+		evmAppB.Erc20Keeper,
 	)
 }
 
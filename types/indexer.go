@@ -17,4 +17,27 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetTxHashesByAddress returns the hashes of the txs an address appears in,
+	// in the blocks before or after a block number, and whether more are left.
+	GetTxHashesByAddress(common.Address, int64, bool, int) ([]common.Hash, bool, error)
+	// GetByAddress returns the txs an address appears in, after a cursor.
+	GetByAddress(common.Address, *AddressTx, bool, int) ([]AddressTx, error)
+	// GetTxHashBySenderAndNonce returns the empty hash if tx not found.
+	GetTxHashBySenderAndNonce(common.Address, uint64) (common.Hash, error)
+	// GetContractCreation returns nil if the contract creation is not found.
+	GetContractCreation(common.Address) (*ContractCreation, error)
+}
+
+// AddressTx is the position of an eth tx an address appears in.
+type AddressTx struct {
+	Height     int64
+	EthTxIndex int32
+	TxHash     common.Hash
+}
+
+// ContractCreation is the eth tx deploying a contract and its sender.
+type ContractCreation struct {
+	TxHash  common.Hash
+	Creator common.Address
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"math/big"
 	"sort"
@@ -11,6 +12,7 @@ import (
 	"golang.org/x/exp/constraints"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/secp256r1"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -51,11 +53,11 @@ func CosmosToEthAddr(accAddr sdk.AccAddress) common.Address {
 }
 
 // IsSupportedKey returns true if the pubkey type is supported by the chain
-// (i.e. eth_secp256k1, amino multisig, ed25519).
+// (i.e. eth_secp256k1, eth_secp256r1, amino multisig, ed25519).
 // NOTE: Nested multisigs are not supported.
 func IsSupportedKey(pubkey cryptotypes.PubKey) bool {
 	switch pubkey := pubkey.(type) {
-	case *ethsecp256k1.PubKey, *ed25519.PubKey:
+	case *ethsecp256k1.PubKey, *secp256r1.PubKey, *ed25519.PubKey:
 		return true
 	case multisig.PubKey:
 		if len(pubkey.GetPubKeys()) == 0 {
@@ -64,7 +66,7 @@ func IsSupportedKey(pubkey cryptotypes.PubKey) bool {
 
 		for _, pk := range pubkey.GetPubKeys() {
 			switch pk.(type) {
-			case *ethsecp256k1.PubKey, *ed25519.PubKey:
+			case *ethsecp256k1.PubKey, *secp256r1.PubKey, *ed25519.PubKey:
 				continue
 			default:
 				// Nested multisigs are unsupported
@@ -140,6 +142,21 @@ func GetIBCDenomAddress(denom string) (common.Address, error) {
 	return common.BytesToAddress(bz), nil
 }
 
+// GetNativeDenomAddress returns a deterministic address for a native (i.e. non IBC)
+// denomination, derived from the SHA-256 hash of the denomination.
+func GetNativeDenomAddress(denom string) (common.Address, error) {
+	if strings.HasPrefix(denom, "ibc/") {
+		return common.Address{}, fmt.Errorf("coin %s is an IBC voucher", denom)
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return common.Address{}, err
+	}
+
+	hash := sha256.Sum256([]byte(denom))
+	return common.BytesToAddress(hash[:]), nil
+}
+
 // SortSlice sorts a slice of any ordered type.
 func SortSlice[T constraints.Ordered](slice []T) {
 	sort.Slice(slice, func(i, j int) bool {
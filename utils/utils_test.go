@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/secp256r1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -32,6 +34,11 @@ func TestIsSupportedKeys(t *testing.T) {
 			&ethsecp256k1.PubKey{},
 			true,
 		},
+		{
+			"secp256r1 key",
+			&secp256r1.PubKey{},
+			true,
+		},
 		{
 			"ed25519 key",
 			&ed25519.PubKey{},
@@ -261,7 +268,7 @@ func TestGetIBCDenomAddress(t *testing.T) {
 	}{
 		{
 			"",
-			edgen,
+			"aedgen",
 			true,
 			"does not have 'ibc/' prefix",
 		},
@@ -298,3 +305,49 @@ func TestGetIBCDenomAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNativeDenomAddress(t *testing.T) {
+	testCases := []struct {
+		name        string
+		denom       string
+		expErr      bool
+		expectedRes string
+	}{
+		{
+			"fail - IBC voucher",
+			"ibc/DF63978F803A2E27CA5CC9B7631654CCF0BBC788B3B7F0A10200508E37C70992",
+			true,
+			"is an IBC voucher",
+		},
+		{
+			"fail - invalid denom",
+			"",
+			true,
+			"invalid denom",
+		},
+		{
+			"pass - native denom",
+			"factory/cosmos1test/utoken",
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, err := GetNativeDenomAddress(tc.denom)
+			if tc.expErr {
+				require.Error(t, err, "expected error while get native denom address")
+				require.Contains(t, err.Error(), tc.expectedRes, "expected different error")
+			} else {
+				require.NoError(t, err, "expected no error while get native denom address")
+				hash := sha256.Sum256([]byte(tc.denom))
+				require.Equal(t, common.BytesToAddress(hash[:]), address)
+
+				again, err := GetNativeDenomAddress(tc.denom)
+				require.NoError(t, err)
+				require.Equal(t, address, again, "expected deterministic address")
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -9,6 +11,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagOwner  = "owner"
+	flagStatus = "status"
+	flagOrigin = "origin"
 )
 
 // GetQueryCmd returns the parent command for all erc20 CLI query commands
@@ -25,6 +34,9 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetTokenPairsByFilterCmd(),
+		GetERC20AddressByIBCHashCmd(),
+		GetDenomByERC20AddressCmd(),
 	)
 	return cmd
 }
@@ -127,3 +139,150 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetTokenPairsByFilterCmd queries the registered token pairs that match the
+// given filters
+func GetTokenPairsByFilterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pairs-by-filter",
+		Short: "Gets registered token pairs filtered by owner, status and origin",
+		Long: `Gets registered token pairs filtered by owner, status and origin.
+Omitted filters match any token pair.`,
+		Example: fmt.Sprintf("%s query erc20 token-pairs-by-filter --owner module --status enabled --origin ibc", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := parseEnumFlag(cmd, flagOwner, "OWNER_", types.Owner_value)
+			if err != nil {
+				return err
+			}
+			status, err := parseEnumFlag(cmd, flagStatus, "TOKEN_PAIR_STATUS_", types.TokenPairStatus_value)
+			if err != nil {
+				return err
+			}
+			origin, err := parseEnumFlag(cmd, flagOrigin, "TOKEN_PAIR_ORIGIN_", types.TokenPairOrigin_value)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairsByFilterRequest{
+				ContractOwner: types.Owner(owner),
+				Status:        types.TokenPairStatus(status),
+				Origin:        types.TokenPairOrigin(origin),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.TokenPairsByFilter(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Filter by contract owner (module|external)")
+	cmd.Flags().String(flagStatus, "", "Filter by conversion status (enabled|disabled)")
+	cmd.Flags().String(flagOrigin, "", "Filter by coin origin (ibc|native)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pairs-by-filter")
+	return cmd
+}
+
+// GetERC20AddressByIBCHashCmd queries the ERC20 address of the token pair
+// registered for an IBC denomination hash
+func GetERC20AddressByIBCHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-address IBC_HASH",
+		Short: "Get the ERC20 address of the token pair registered for an IBC denom hash",
+		Long:  "Get the ERC20 address of the token pair registered for an IBC denom hash. The hash can be provided with or without the 'ibc/' prefix.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryERC20AddressByIBCHashRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.ERC20AddressByIBCHash(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDenomByERC20AddressCmd queries the Cosmos denomination of the token pair
+// registered for an ERC20 contract or precompile address
+func GetDenomByERC20AddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom ERC20_ADDRESS",
+		Short: "Get the Cosmos denom of the token pair registered for an ERC20 contract or precompile address",
+		Long:  "Get the Cosmos denom of the token pair registered for an ERC20 contract or precompile address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomByERC20AddressRequest{
+				Erc20Address: args[0],
+			}
+
+			res, err := queryClient.DenomByERC20Address(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseEnumFlag parses the value of a string flag into the corresponding protobuf
+// enum value. The value is matched case-insensitively with or without the enum
+// prefix, and an empty value returns the unspecified (zero) value.
+func parseEnumFlag(cmd *cobra.Command, flag, prefix string, values map[string]int32) (int32, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return 0, err
+	}
+
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, prefix) {
+		name = prefix + name
+	}
+
+	enum, ok := values[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid --%s value: %s", flag, value)
+	}
+	return enum, nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // NewTxCmd returns a root CLI command handler for erc20 transaction commands
@@ -31,6 +33,7 @@ func NewTxCmd() *cobra.Command {
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
 		NewMsgRegisterERC20Cmd(),
+		NewMsgUpdateTokenPairMetadataCmd(),
 	)
 	return txCmd
 }
@@ -158,3 +161,62 @@ func NewMsgRegisterERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgUpdateTokenPairMetadataCmd returns a CLI command handler for updating the
+// bank denom metadata of a registered token pair. The sender is used as the
+// authority, so the command is intended to be used with --generate-only to build
+// the message of a governance proposal.
+func NewMsgUpdateTokenPairMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-token-pair-metadata TOKEN METADATA_FILE",
+		Short: "Update the bank denom metadata of a registered token pair",
+		Long: `Update the bank denom metadata of a registered token pair. TOKEN can be either the
+hex address of the ERC20 contract or the Cosmos base denomination. METADATA_FILE is the
+path to a JSON file containing the new bank metadata, e.g.:
+
+{
+  "description": "The native staking and governance token",
+  "denom_units": [
+    {"denom": "atest", "exponent": 0},
+    {"denom": "test", "exponent": 18}
+  ],
+  "base": "atest",
+  "display": "test",
+  "name": "Test Token",
+  "symbol": "TEST",
+  "uri": "https://example.com/logo.svg"
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read metadata file: %w", err)
+			}
+
+			var metadata banktypes.Metadata
+			if err := cliCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return fmt.Errorf("failed to unmarshal metadata: %w", err)
+			}
+
+			msg := &types.MsgUpdateTokenPairMetadata{
+				Authority: cliCtx.GetFromAddress().String(),
+				Token:     args[0],
+				Metadata:  metadata,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -44,13 +44,20 @@ func InitGenesis(
 			panic(fmt.Errorf("error setting allowance %s", err))
 		}
 	}
+
+	for _, nonce := range data.PermitNonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		owner := common.HexToAddress(nonce.Owner)
+		k.SetPermitNonce(ctx, erc20, owner, nonce.Nonce)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
-		Allowances: k.GetAllowances(ctx),
+		Params:       k.GetParams(ctx),
+		TokenPairs:   k.GetTokenPairs(ctx),
+		Allowances:   k.GetAllowances(ctx),
+		PermitNonces: k.GetPermitNonces(ctx),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -248,3 +249,30 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestERC20GenesisPermitNonces() {
+	erc20Addr := common.HexToAddress(osmoERC20ContractAddr)
+	alice := utiltx.GenerateAddress()
+	bob := utiltx.GenerateAddress()
+
+	// used permit nonces are exported
+	suite.app.Erc20Keeper.IncrementPermitNonce(suite.ctx, erc20Addr, alice)
+	suite.app.Erc20Keeper.IncrementPermitNonce(suite.ctx, erc20Addr, alice)
+	suite.app.Erc20Keeper.IncrementPermitNonce(suite.ctx, erc20Addr, bob)
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().ElementsMatch([]types.PermitNonce{
+		types.NewPermitNonce(erc20Addr, alice, 2),
+		types.NewPermitNonce(erc20Addr, bob, 1),
+	}, genesisExported.PermitNonces)
+	suite.Require().NoError(genesisExported.Validate())
+
+	// and imported on a new chain, so that the used permits cannot be replayed
+	suite.SetupTest()
+	suite.Require().Equal(uint64(0), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, erc20Addr, alice))
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, *genesisExported)
+	suite.Require().Equal(uint64(2), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, erc20Addr, alice))
+	suite.Require().Equal(uint64(1), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, erc20Addr, bob))
+	suite.Require().Equal(genesisExported, erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper))
+}
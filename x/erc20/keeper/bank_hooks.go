@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewMintCoinsHook returns a bank minting restriction function that registers a
+// dynamic ERC20 precompile for every native coin with bank metadata the first
+// time it is minted, if the AutoRegisterDenoms parameter is enabled. The bank
+// keeper has no hook on the metadata updates, so a coin minted before its
+// metadata is set is registered at its next mint.
+//
+// The hook never restricts minting: failures to register the precompile are
+// logged and the state changes from the registration attempt are discarded.
+//
+// NOTE: The keeper is passed as a pointer because the bank keeper has to be
+// instantiated before the erc20 keeper.
+func NewMintCoinsHook(k *Keeper) banktypes.MintingRestrictionFn {
+	return func(goCtx context.Context, coins sdk.Coins) error {
+		ctx := sdk.UnwrapSDKContext(goCtx)
+		for _, coin := range coins {
+			k.autoRegisterERC20Extension(ctx, coin.Denom)
+		}
+		return nil
+	}
+}
+
+// RegisterNativeERC20Extension creates and adds an ERC20 precompile interface for
+// a native Cosmos coin.
+//
+// It derives the ERC-20 address deterministically from the token denomination and
+// registers the EVM extension as an active dynamic precompile.
+//
+// CONTRACT: This must ONLY be called if there is no existing token pair for the given denom.
+func (k Keeper) RegisterNativeERC20Extension(ctx sdk.Context, denom string) (*types.TokenPair, error) {
+	pair, err := types.NewNativeTokenPair(denom)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetToken(ctx, pair)
+
+	// Add to existing EVM extensions
+	if err := k.EnableDynamicPrecompiles(ctx, pair.GetERC20Contract()); err != nil {
+		return nil, err
+	}
+
+	return &pair, nil
+}
+
+// autoRegisterERC20Extension registers a dynamic ERC20 precompile for the given
+// denomination if automatic registration is enabled and the denomination is a
+// native coin with bank metadata that has no token pair yet.
+//
+// IBC vouchers are skipped since they are registered on packet receive, as well
+// as the staking and EVM denominations which are handled by the native precompiles.
+func (k Keeper) autoRegisterERC20Extension(ctx sdk.Context, denom string) {
+	if strings.HasPrefix(denom, "ibc/") || strings.HasPrefix(denom, types.Erc20NativeCoinDenomPrefix) {
+		return
+	}
+
+	// NOTE: the parameters are read with an infinite gas meter so that the gas
+	// consumed by minting is not affected while the automatic registration is disabled.
+	paramsCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if !k.IsERC20Enabled(paramsCtx) || !k.isAutoRegisterDenoms(paramsCtx) {
+		return
+	}
+
+	if denom == evmtypes.GetEVMCoinDenom() {
+		return
+	}
+
+	if bondDenom, err := k.stakingKeeper.BondDenom(ctx); err == nil && denom == bondDenom {
+		return
+	}
+
+	if k.IsDenomRegistered(ctx, denom) || !k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	pair, err := k.RegisterNativeERC20Extension(cacheCtx, denom)
+	if err != nil {
+		k.Logger(ctx).Error("failed to auto-register ERC20 extension", "denom", denom, "error", err.Error())
+		return
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20Extension,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		),
+	)
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestMintCoinsHook() {
+	const denom = "factory/cosmos1test/utoken"
+
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expRegister bool
+	}{
+		{
+			"no-op - auto registration disabled",
+			func() {},
+			false,
+		},
+		{
+			"no-op - denom without metadata",
+			func() {
+				suite.enableAutoRegisterDenoms(ctx)
+			},
+			false,
+		},
+		{
+			"no-op - erc20 module disabled",
+			func() {
+				suite.network.App.BankKeeper.SetDenomMetaData(ctx, newFactoryMetadata(denom))
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.AutoRegisterDenoms = true
+				params.EnableErc20 = false
+				suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+			},
+			false,
+		},
+		{
+			"ok - register denom with metadata",
+			func() {
+				suite.network.App.BankKeeper.SetDenomMetaData(ctx, newFactoryMetadata(denom))
+				suite.enableAutoRegisterDenoms(ctx)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100)))
+			err := suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+			suite.Require().NoError(err)
+
+			expAddr, err := utils.GetNativeDenomAddress(denom)
+			suite.Require().NoError(err)
+
+			pair, found := suite.network.App.Erc20Keeper.GetTokenPair(
+				ctx, suite.network.App.Erc20Keeper.GetDenomMap(ctx, denom),
+			)
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			if tc.expRegister {
+				suite.Require().True(found)
+				suite.Require().Equal(expAddr.Hex(), pair.Erc20Address)
+				suite.Require().Equal(types.OWNER_MODULE, pair.ContractOwner)
+				suite.Require().True(params.IsDynamicPrecompile(expAddr))
+
+				// minting again must not fail on the existing registration
+				err = suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().False(found)
+				suite.Require().False(params.IsDynamicPrecompile(expAddr))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMintCoinsHookMetadataSetAfterMint() {
+	const denom = "factory/cosmos1test/utoken"
+
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	suite.enableAutoRegisterDenoms(ctx)
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100)))
+	suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	suite.Require().False(suite.network.App.Erc20Keeper.IsDenomRegistered(ctx, denom))
+
+	// setting the metadata does not register the coin
+	suite.network.App.BankKeeper.SetDenomMetaData(ctx, newFactoryMetadata(denom))
+	suite.Require().False(suite.network.App.Erc20Keeper.IsDenomRegistered(ctx, denom))
+
+	// the next mint does
+	suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	suite.Require().True(suite.network.App.Erc20Keeper.IsDenomRegistered(ctx, denom))
+}
+
+func (suite *KeeperTestSuite) enableAutoRegisterDenoms(ctx sdk.Context) {
+	params := suite.network.App.Erc20Keeper.GetParams(ctx)
+	params.AutoRegisterDenoms = true
+	suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+}
+
+func newFactoryMetadata(denom string) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "Token factory test token",
+		Base:        denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Name:    "Token",
+		Symbol:  "TKN",
+		Display: "token",
+	}
+}
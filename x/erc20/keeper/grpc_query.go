@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	"cosmossdk.io/store/prefix"
 
@@ -84,3 +87,95 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// TokenPairsByFilter returns the registered pairs that match the given filters
+func (k Keeper) TokenPairsByFilter(c context.Context, req *types.QueryTokenPairsByFilterRequest) (*types.QueryTokenPairsByFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, ok := types.Owner_name[int32(req.ContractOwner)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract owner %d", req.ContractOwner)
+	}
+	if _, ok := types.TokenPairStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %d", req.Status)
+	}
+	if _, ok := types.TokenPairOrigin_name[int32(req.Origin)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid origin %d", req.Origin)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pairs []types.TokenPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return false, err
+		}
+
+		if !pair.MatchesFilter(req.ContractOwner, req.Status, req.Origin) {
+			return false, nil
+		}
+
+		if accumulate {
+			pairs = append(pairs, pair)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokenPairsByFilterResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ERC20AddressByIBCHash returns the ERC20 address of the token pair registered
+// for the given IBC denomination hash
+func (k Keeper) ERC20AddressByIBCHash(c context.Context, req *types.QueryERC20AddressByIBCHashRequest) (*types.QueryERC20AddressByIBCHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(req.Hash, "ibc/"))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid IBC denom hash %s: %s", req.Hash, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denom := "ibc/" + hash.String()
+	pair, found := k.GetTokenPair(ctx, k.GetDenomMap(ctx, denom))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with denom '%s'", denom)
+	}
+
+	return &types.QueryERC20AddressByIBCHashResponse{
+		Erc20Address: pair.Erc20Address,
+		Denom:        pair.Denom,
+	}, nil
+}
+
+// DenomByERC20Address returns the Cosmos denomination of the token pair
+// registered for the given ERC20 contract or precompile address
+func (k Keeper) DenomByERC20Address(c context.Context, req *types.QueryDenomByERC20AddressRequest) (*types.QueryDenomByERC20AddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Erc20Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ERC20 address %s", req.Erc20Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, common.HexToAddress(req.Erc20Address)))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with ERC20 address '%s'", req.Erc20Address)
+	}
+
+	return &types.QueryDenomByERC20AddressResponse{Denom: pair.Denom}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 
 	exampleapp "github.com/cosmos/evm/evmd"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -171,6 +172,232 @@ func (suite *KeeperTestSuite) TestTokenPair() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTokenPairsByFilter() {
+	var (
+		ctx         sdk.Context
+		req         *types.QueryTokenPairsByFilterRequest
+		expPairs    []types.TokenPair
+		ibcPair     types.TokenPair
+		externalOne types.TokenPair
+		disabled    types.TokenPair
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid owner",
+			func() {
+				req = &types.QueryTokenPairsByFilterRequest{ContractOwner: types.Owner(10)}
+			},
+			false,
+		},
+		{
+			"ok - no filters",
+			func() {
+				req = &types.QueryTokenPairsByFilterRequest{}
+				expPairs = append(testconstants.ExampleTokenPairs, ibcPair, externalOne, disabled)
+			},
+			true,
+		},
+		{
+			"ok - filter by external owner",
+			func() {
+				req = &types.QueryTokenPairsByFilterRequest{ContractOwner: types.OWNER_EXTERNAL}
+				expPairs = []types.TokenPair{externalOne}
+			},
+			true,
+		},
+		{
+			"ok - filter by disabled status",
+			func() {
+				req = &types.QueryTokenPairsByFilterRequest{Status: types.TOKEN_PAIR_STATUS_DISABLED}
+				expPairs = []types.TokenPair{disabled}
+			},
+			true,
+		},
+		{
+			"ok - filter by module owner, enabled status and IBC origin",
+			func() {
+				req = &types.QueryTokenPairsByFilterRequest{
+					ContractOwner: types.OWNER_MODULE,
+					Status:        types.TOKEN_PAIR_STATUS_ENABLED,
+					Origin:        types.TOKEN_PAIR_ORIGIN_IBC,
+				}
+				expPairs = []types.TokenPair{ibcPair}
+			},
+			true,
+		},
+		{
+			"ok - filter by native origin w/pagination",
+			func() {
+				req = &types.QueryTokenPairsByFilterRequest{
+					Origin:     types.TOKEN_PAIR_ORIGIN_NATIVE,
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				expPairs = append(testconstants.ExampleTokenPairs, externalOne, disabled)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			var err error
+			ibcPair, err = types.NewTokenPairSTRv2(ibcBase)
+			suite.Require().NoError(err)
+			externalOne = types.NewTokenPair(utiltx.GenerateAddress(), "erc20:external", types.OWNER_EXTERNAL)
+			disabled = types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+			disabled.Enabled = false
+			for _, pair := range []types.TokenPair{ibcPair, externalOne, disabled} {
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+			}
+
+			tc.malleate()
+
+			res, err := suite.queryClient.TokenPairsByFilter(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expPairs, res.TokenPairs)
+				if req.Pagination != nil && req.Pagination.CountTotal {
+					suite.Require().Equal(uint64(len(expPairs)), res.Pagination.Total)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestERC20AddressByIBCHash() {
+	var (
+		ctx    sdk.Context
+		req    *types.QueryERC20AddressByIBCHashRequest
+		expRes *types.QueryERC20AddressByIBCHashResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid hash",
+			func() {
+				req = &types.QueryERC20AddressByIBCHashRequest{Hash: "invalid"}
+			},
+			false,
+		},
+		{
+			"fail - token pair not found",
+			func() {
+				req = &types.QueryERC20AddressByIBCHashRequest{Hash: ibcBase}
+			},
+			false,
+		},
+		{
+			"ok - hash with ibc prefix",
+			func() {
+				pair, err := types.NewTokenPairSTRv2(ibcBase)
+				suite.Require().NoError(err)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+				req = &types.QueryERC20AddressByIBCHashRequest{Hash: ibcBase}
+				expRes = &types.QueryERC20AddressByIBCHashResponse{Erc20Address: pair.Erc20Address, Denom: pair.Denom}
+			},
+			true,
+		},
+		{
+			"ok - lowercase hash without ibc prefix",
+			func() {
+				pair, err := types.NewTokenPairSTRv2(ibcBase)
+				suite.Require().NoError(err)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+				req = &types.QueryERC20AddressByIBCHashRequest{Hash: strings.ToLower(strings.TrimPrefix(ibcBase, "ibc/"))}
+				expRes = &types.QueryERC20AddressByIBCHashResponse{Erc20Address: pair.Erc20Address, Denom: pair.Denom}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			res, err := suite.queryClient.ERC20AddressByIBCHash(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomByERC20Address() {
+	var (
+		ctx    sdk.Context
+		req    *types.QueryDenomByERC20AddressRequest
+		expRes *types.QueryDenomByERC20AddressResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid address",
+			func() {
+				req = &types.QueryDenomByERC20AddressRequest{Erc20Address: "invalid"}
+			},
+			false,
+		},
+		{
+			"fail - token pair not found",
+			func() {
+				req = &types.QueryDenomByERC20AddressRequest{Erc20Address: utiltx.GenerateAddress().Hex()}
+			},
+			false,
+		},
+		{
+			"ok - token pair found",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+				req = &types.QueryDenomByERC20AddressRequest{Erc20Address: pair.Erc20Address}
+				expRes = &types.QueryDenomByERC20AddressResponse{Denom: pair.Denom}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			res, err := suite.queryClient.DenomByERC20Address(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.SetupTest()
 	ctx := suite.network.GetContext()
@@ -32,6 +32,9 @@ import (
 // - ERC20s are disabled
 // - Denomination is native staking token
 // - The base denomination is not registered as ERC20
+//
+// After the conversion, the EVM call contained in the packet memo (if any) is
+// executed. An error acknowledgement is returned if the call fails.
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -42,6 +45,25 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	ack = k.convertReceivedCoin(ctx, packet, ack)
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := k.executeIBCHook(ctx, packet); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// convertReceivedCoin converts the IBC Coin received with the packet to its
+// ERC20 representation when applicable.
+func (k Keeper) convertReceivedCoin(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		// NOTE: shouldn't happen as the packet has already
@@ -160,26 +182,39 @@ func (k Keeper) OnRecvPacket(
 // acknowledgement written on the receiving chain. If the acknowledgement was a
 // success then nothing occurs. If the acknowledgement failed, then the sender
 // is refunded and then the IBC Coins are converted to ERC20.
+// In both cases, the contract that sent the packet through the ICS20 precompile
+// (if any) is notified with the onAckPacket callback.
 func (k Keeper) OnAcknowledgementPacket(
-	ctx sdk.Context, _ channeltypes.Packet,
+	ctx sdk.Context, packet channeltypes.Packet,
 	data transfertypes.FungibleTokenPacketData,
 	ack channeltypes.Acknowledgement,
 ) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		// convert the token from Cosmos Coin to its ERC20 representation
-		return k.ConvertCoinToERC20FromPacket(ctx, data)
+		if err := k.ConvertCoinToERC20FromPacket(ctx, data); err != nil {
+			return err
+		}
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing needs to
 		// be executed and no error needs to be returned
-		return nil
 	}
+
+	k.onAckPacketCallback(ctx, packet, ack)
+	return nil
 }
 
 // OnTimeoutPacket converts the IBC coin to ERC20 after refunding the sender
 // since the original packet sent was never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
-	return k.ConvertCoinToERC20FromPacket(ctx, data)
+// The contract that sent the packet through the ICS20 precompile (if any) is
+// notified with the onTimeoutPacket callback.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	if err := k.ConvertCoinToERC20FromPacket(ctx, data); err != nil {
+		return err
+	}
+
+	k.onTimeoutPacketCallback(ctx, packet)
+	return nil
 }
 
 // ConvertCoinToERC20FromPacket converts the IBC coin to ERC20 after refunding the sender
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// executeIBCHook executes the EVM call contained in the memo of the received
+// ICS20 packet, if any. The receiver of the packet must be the called contract,
+// so that the call is executed after the contract has been credited with the
+// transferred funds. The call is sent from an intermediary address derived from
+// the destination channel and the original sender of the packet.
+//
+// CONTRACT: the returned error must be turned into an error acknowledgement, so
+// that the state changes of the packet, including the transfer, are reverted.
+func (k Keeper) executeIBCHook(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+	}
+
+	hook, found, err := types.ParseIBCHook(data.Memo)
+	if err != nil || !found {
+		return err
+	}
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(data)
+	if err != nil {
+		return err
+	}
+
+	contract := common.HexToAddress(hook.Contract)
+	if contract != common.BytesToAddress(recipient.Bytes()) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidIBCHook,
+			"packet receiver %s must be the hook contract %s", data.Receiver, contract.Hex(),
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return errorsmod.Wrapf(types.ErrInvalidIBCHook, "account %s is not a contract", contract.Hex())
+	}
+
+	sender := types.DeriveIBCHookSender(packet.DestinationChannel, data.Sender)
+	if k.evmKeeper.GetAccountWithoutBalance(ctx, sender) == nil {
+		// the sender account must exist to execute the call
+		if err := k.evmKeeper.SetAccount(ctx, sender, *statedb.NewEmptyAccount()); err != nil {
+			return err
+		}
+	}
+
+	res, err := k.evmKeeper.CallEVMWithDataAndGasLimit(ctx, sender, &contract, hook.Calldata, hook.GasLimit)
+	// the EVM execution doesn't consume the gas of the context, so the gas used
+	// by the call is charged to the relayer of the packet
+	if res != nil {
+		ctx.GasMeter().ConsumeGas(res.GasUsed, "IBC hook EVM call")
+	}
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrEVMCall, "IBC hook call to %s failed: %s", contract.Hex(), err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCHook,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeCoinSourceChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketIBCHook() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		memo     string
+	)
+
+	sourceChannel := "channel-292"
+	cosmosEVMChannel := "channel-3"
+	selector := common.FromHex("0x12345678")
+
+	ethPk, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sender := sdk.AccAddress(ethPk.PubKey().Address()).String()
+
+	hookMemo := func(contract common.Address, gasLimit uint64) string {
+		return fmt.Sprintf(`{"evm":{"contract":"%s","calldata":"0x12345678","gas_limit":%d}}`, contract.Hex(), gasLimit)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		receiver   func() string
+		ackSuccess bool
+		expCalled  bool
+	}{
+		{
+			name: "pass - no hook in memo",
+			malleate: func() {
+				contract = suite.deployCode(ctx, selectorRecorderCode)
+				memo = `{"forward":{}}`
+			},
+			ackSuccess: true,
+		},
+		{
+			name: "pass - hook executed",
+			malleate: func() {
+				contract = suite.deployCode(ctx, selectorRecorderCode)
+				memo = hookMemo(contract, 100_000)
+			},
+			ackSuccess: true,
+			expCalled:  true,
+		},
+		{
+			name: "error - invalid hook",
+			malleate: func() {
+				contract = suite.deployCode(ctx, selectorRecorderCode)
+				memo = hookMemo(contract, 0)
+			},
+			ackSuccess: false,
+		},
+		{
+			name: "error - receiver is not the hook contract",
+			malleate: func() {
+				contract = suite.deployCode(ctx, selectorRecorderCode)
+				memo = hookMemo(contract, 100_000)
+			},
+			receiver: func() string {
+				return sdk.AccAddress(common.HexToAddress("0x1").Bytes()).String()
+			},
+			ackSuccess: false,
+		},
+		{
+			name: "error - hook contract has no code",
+			malleate: func() {
+				contract = common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7")
+				memo = hookMemo(contract, 100_000)
+			},
+			ackSuccess: false,
+		},
+		{
+			name: "error - hook call fails",
+			malleate: func() {
+				contract = suite.deployCode(ctx, infiniteLoopCode)
+				memo = hookMemo(contract, 100_000)
+			},
+			ackSuccess: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			receiver := sdk.AccAddress(contract.Bytes()).String()
+			if tc.receiver != nil {
+				receiver = tc.receiver()
+			}
+
+			transfer := transfertypes.NewFungibleTokenPacketData(erc20Denom, "100", sender, receiver, memo)
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, sourceChannel, transfertypes.PortID, cosmosEVMChannel, clienttypes.NewHeight(0, 100), 0)
+
+			ack := suite.network.App.Erc20Keeper.OnRecvPacket(ctx, packet, ibcmock.MockAcknowledgement)
+			suite.Require().Equal(tc.ackSuccess, ack.Success(), string(ack.Acknowledgement()))
+
+			slot := suite.network.App.EVMKeeper.GetState(ctx, contract, common.Hash{})
+			if !tc.expCalled {
+				suite.Require().Equal(common.Hash{}, slot)
+				return
+			}
+			suite.Require().Equal(common.BytesToHash(selector), slot)
+
+			// the account of the intermediary sender is created to send the call
+			hookSender := types.DeriveIBCHookSender(cosmosEVMChannel, sender)
+			suite.Require().NotNil(suite.network.App.EVMKeeper.GetAccountWithoutBalance(ctx, hookSender))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketIBCHookGas() {
+	ethPk, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sender := sdk.AccAddress(ethPk.PubKey().Address()).String()
+
+	// recvPacketGas returns the gas charged to the relayer for a packet whose
+	// hook calls a contract that loops until it runs out of gas
+	recvPacketGas := func(gasLimit uint64) uint64 {
+		suite.SetupTest()
+		ctx := suite.network.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		contract := suite.deployCode(ctx, infiniteLoopCode)
+		memo := fmt.Sprintf(`{"evm":{"contract":"%s","calldata":"0x12345678","gas_limit":%d}}`, contract.Hex(), gasLimit)
+		receiver := sdk.AccAddress(contract.Bytes()).String()
+
+		transfer := transfertypes.NewFungibleTokenPacketData(erc20Denom, "100", sender, receiver, memo)
+		bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+		packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, "channel-292", transfertypes.PortID, "channel-3", clienttypes.NewHeight(0, 100), 0)
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+		ack := suite.network.App.Erc20Keeper.OnRecvPacket(ctx, packet, ibcmock.MockAcknowledgement)
+		suite.Require().False(ack.Success())
+		return ctx.GasMeter().GasConsumed() - gasBefore
+	}
+
+	lowGas := recvPacketGas(100_000)
+	highGas := recvPacketGas(500_000)
+	suite.Require().GreaterOrEqual(lowGas, uint64(100_000))
+	suite.Require().GreaterOrEqual(highGas-lowGas, uint64(400_000))
+}
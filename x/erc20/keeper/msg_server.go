@@ -386,6 +386,41 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// UpdateTokenPairMetadata implements the gRPC MsgServer interface.
+//
+// After a successful governance vote it replaces the bank denom metadata of the
+// given token pair. Module-owned token pairs are backed by the ERC20 precompile,
+// which reads its name, symbol and decimals from the bank metadata, so the new
+// values are reflected on the EVM side as well.
+func (k *Keeper) UpdateTokenPairMetadata(goCtx context.Context, req *types.MsgUpdateTokenPairMetadata) (*types.MsgUpdateTokenPairMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("metadata update is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.updateTokenPairMetadata(ctx, req.Token, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenPairMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyName, req.Metadata.Name),
+			sdk.NewAttribute(types.AttributeKeySymbol, req.Metadata.Symbol),
+		),
+	)
+
+	return &types.MsgUpdateTokenPairMetadataResponse{}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPacketCallback registers the given contract to be called back on the
+// acknowledgement or timeout of the outgoing packet with the given sequence.
+func (k Keeper) SetPacketCallback(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+	contract common.Address,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	store.Set(types.PacketCallbackKey(portID, channelID, sequence), contract.Bytes())
+}
+
+// GetPacketCallback returns the contract registered to be called back for the
+// outgoing packet with the given sequence.
+func (k Keeper) GetPacketCallback(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	bz := store.Get(types.PacketCallbackKey(portID, channelID, sequence))
+	if bz == nil {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// DeletePacketCallback removes the callback registered for the outgoing packet
+// with the given sequence.
+func (k Keeper) DeletePacketCallback(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	store.Delete(types.PacketCallbackKey(portID, channelID, sequence))
+}
+
+// executePacketCallback calls the given callback method on the contract that
+// sent the packet, if any. The callback is executed with a gas limit of
+// PacketCallbackGasLimit, charged to the relayer, and its state changes are
+// discarded if it fails.
+//
+// NOTE: A failing callback never fails the acknowledgement or timeout of the
+// packet, so that a misbehaving contract cannot block the refund of the tokens.
+func (k Keeper) executePacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	method string,
+	args ...interface{},
+) {
+	contract, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	// the callback is only executed once
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		sdk.NewAttribute(types.AttributeKeyCallback, method),
+		sdk.NewAttribute(types.AttributeCoinSourceChannel, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+	}
+
+	args = append([]interface{}{packet.SourcePort, packet.SourceChannel, packet.Sequence}, args...)
+	data, err := types.ICS20CallbacksABI.Pack(method, args...)
+	if err == nil {
+		var res *evmtypes.MsgEthereumTxResponse
+		cacheCtx, writeFn := ctx.CacheContext()
+		res, err = k.evmKeeper.CallEVMWithDataAndGasLimit(
+			cacheCtx, types.ModuleAddress, &contract, data, types.PacketCallbackGasLimit,
+		)
+		// the EVM execution doesn't consume the gas of the context, so the gas used
+		// by the callback is charged to the relayer of the acknowledgement or timeout
+		if res != nil {
+			ctx.GasMeter().ConsumeGas(res.GasUsed, "packet callback EVM call")
+		}
+		if err == nil {
+			writeFn()
+		}
+	}
+	if err != nil {
+		k.Logger(ctx).Error(
+			fmt.Sprintf("packet callback failed: %s", err.Error()),
+			"contract", contract.Hex(),
+			"callback", method,
+			"source_channel", packet.SourceChannel,
+			"sequence", packet.Sequence,
+		)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePacketCallback, attrs...))
+}
+
+// onAckPacketCallback calls the onAckPacket method on the contract that sent the
+// acknowledged packet.
+func (k Keeper) onAckPacketCallback(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	k.executePacketCallback(ctx, packet, types.OnAckPacketMethod, ack.Success(), ack.Acknowledgement())
+}
+
+// onTimeoutPacketCallback calls the onTimeoutPacket method on the contract that
+// sent the timed out packet.
+func (k Keeper) onTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet) {
+	k.executePacketCallback(ctx, packet, types.OnTimeoutPacketMethod)
+}
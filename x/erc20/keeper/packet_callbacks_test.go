@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// selectorRecorderCode stores the 4-byte selector of the call in the storage slot 0:
+	// PUSH1 0 CALLDATALOAD PUSH1 0xe0 SHR PUSH1 0 SSTORE STOP
+	selectorRecorderCode = common.FromHex("0x60003560e01c60005500")
+	// infiniteLoopCode loops until it runs out of gas:
+	// JUMPDEST PUSH1 0 JUMP
+	infiniteLoopCode = common.FromHex("0x5b600056")
+)
+
+func (suite *KeeperTestSuite) deployCode(ctx sdk.Context, code []byte) common.Address {
+	addr := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+	suite.network.App.EVMKeeper.SetCode(ctx, codeHash, code)
+	err := suite.network.App.EVMKeeper.SetAccount(ctx, addr, statedb.Account{
+		Balance:  uint256.NewInt(0),
+		CodeHash: codeHash,
+	})
+	suite.Require().NoError(err)
+	return addr
+}
+
+func (suite *KeeperTestSuite) TestPacketCallback() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	contract := utiltx.GenerateAddress()
+	_, found := suite.network.App.Erc20Keeper.GetPacketCallback(ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().False(found)
+
+	suite.network.App.Erc20Keeper.SetPacketCallback(ctx, transfertypes.PortID, "channel-0", 1, contract)
+	got, found := suite.network.App.Erc20Keeper.GetPacketCallback(ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(contract, got)
+
+	// callbacks are tracked per channel and sequence
+	_, found = suite.network.App.Erc20Keeper.GetPacketCallback(ctx, transfertypes.PortID, "channel-1", 1)
+	suite.Require().False(found)
+	_, found = suite.network.App.Erc20Keeper.GetPacketCallback(ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().False(found)
+
+	suite.network.App.Erc20Keeper.DeletePacketCallback(ctx, transfertypes.PortID, "channel-0", 1)
+	_, found = suite.network.App.Erc20Keeper.GetPacketCallback(ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExecutePacketCallback() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+	)
+
+	packet := channeltypes.NewPacket(
+		nil, 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0,
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		timeout     bool
+		expSelector []byte
+		expErr      bool
+		expMinGas   uint64
+	}{
+		{
+			name:     "no-op - no callback registered",
+			malleate: func() {},
+		},
+		{
+			name: "pass - onAckPacket",
+			malleate: func() {
+				contract = suite.deployCode(ctx, selectorRecorderCode)
+				suite.network.App.Erc20Keeper.SetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, contract)
+			},
+			expSelector: types.ICS20CallbacksABI.Methods[types.OnAckPacketMethod].ID,
+		},
+		{
+			name: "pass - onTimeoutPacket",
+			malleate: func() {
+				contract = suite.deployCode(ctx, selectorRecorderCode)
+				suite.network.App.Erc20Keeper.SetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, contract)
+			},
+			timeout:     true,
+			expSelector: types.ICS20CallbacksABI.Methods[types.OnTimeoutPacketMethod].ID,
+		},
+		{
+			name: "pass - failing callback does not fail the acknowledgement",
+			malleate: func() {
+				contract = suite.deployCode(ctx, infiniteLoopCode)
+				suite.network.App.Erc20Keeper.SetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, contract)
+			},
+			expErr: true,
+			// the gas of the failed callback is still charged to the relayer
+			expMinGas: types.PacketCallbackGasLimit,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+			contract = common.Address{}
+
+			tc.malleate()
+
+			// any module account sender makes the conversion a no-op
+			moduleAcc := suite.network.App.AccountKeeper.GetModuleAccount(ctx, evmtypes.ModuleName)
+			data := transfertypes.NewFungibleTokenPacketData("", "10", moduleAcc.GetAddress().String(), "", "")
+
+			var err error
+			gasBefore := ctx.GasMeter().GasConsumed()
+			if tc.timeout {
+				err = suite.network.App.Erc20Keeper.OnTimeoutPacket(ctx, packet, data)
+			} else {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				err = suite.network.App.Erc20Keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+			}
+			suite.Require().NoError(err)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, tc.expMinGas)
+
+			// the callback is only executed once
+			_, found := suite.network.App.Erc20Keeper.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			if tc.expSelector != nil {
+				slot := suite.network.App.EVMKeeper.GetState(ctx, contract, common.Hash{})
+				suite.Require().Equal(common.BytesToHash(tc.expSelector), slot)
+			}
+
+			var callbackEvent *sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypePacketCallback {
+					callbackEvent = &event
+				}
+			}
+			if contract == (common.Address{}) {
+				suite.Require().Nil(callbackEvent)
+				return
+			}
+			suite.Require().NotNil(callbackEvent)
+			_, hasErr := callbackEvent.GetAttribute(types.AttributeKeyCallbackError)
+			suite.Require().Equal(tc.expErr, hasErr)
+		})
+	}
+}
@@ -20,7 +20,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
 	permissionlessRegistration := k.isPermissionlessRegistration(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles, permissionlessRegistration)
+	autoRegisterDenoms := k.isAutoRegisterDenoms(ctx)
+	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles, permissionlessRegistration, autoRegisterDenoms)
 }
 
 // UpdateCodeHash takes in the updated parameters and
@@ -89,6 +90,7 @@ func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	k.setDynamicPrecompiles(ctx, newParams.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, newParams.NativePrecompiles)
 	k.SetPermissionlessRegistration(ctx, newParams.PermissionlessRegistration)
+	k.setAutoRegisterDenoms(ctx, newParams.AutoRegisterDenoms)
 	return nil
 }
 
@@ -164,3 +166,20 @@ func (k Keeper) SetPermissionlessRegistration(ctx sdk.Context, permissionlessReg
 	}
 	store.Delete(types.ParamStoreKeyPermissionlessRegistration)
 }
+
+// isAutoRegisterDenoms returns true if the module enabled the automatic
+// registration of ERC20 precompiles for native coins
+func (k Keeper) isAutoRegisterDenoms(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyAutoRegisterDenoms)
+}
+
+// setAutoRegisterDenoms sets the AutoRegisterDenoms param in the store
+func (k Keeper) setAutoRegisterDenoms(ctx sdk.Context, autoRegisterDenoms bool) {
+	store := ctx.KVStore(k.storeKey)
+	if autoRegisterDenoms {
+		store.Set(types.ParamStoreKeyAutoRegisterDenoms, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeyAutoRegisterDenoms)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPermitNonce returns the current EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(types.PermitNonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementPermitNonce increments the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address and returns the nonce that was consumed.
+//
+// NOTE: Nonces are not removed when a token pair is deleted, so that permit
+// signatures cannot be replayed if the same precompile address is registered again.
+func (k Keeper) IncrementPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	nonce := k.GetPermitNonce(ctx, erc20, owner)
+
+	k.SetPermitNonce(ctx, erc20, owner, nonce+1)
+
+	return nonce
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the given owner on the given
+// erc20 precompile address.
+func (k Keeper) SetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	nonce uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(types.PermitNonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetPermitNonces returns all the EIP-2612 permit nonces.
+func (k Keeper) GetPermitNonces(ctx sdk.Context) []types.PermitNonce {
+	nonces := []types.PermitNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		owner := common.BytesToAddress(key[common.AddressLength:])
+		nonces = append(nonces, types.NewPermitNonce(erc20, owner, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return nonces
+}
@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	utiltx "github.com/cosmos/evm/testutil/tx"
+)
+
+func (suite *KeeperTestSuite) TestPermitNonce() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	erc20Addr := utiltx.GenerateAddress()
+	erc20Addr2 := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+	owner2 := utiltx.GenerateAddress()
+
+	suite.Require().Equal(uint64(0), suite.network.App.Erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+
+	consumed := suite.network.App.Erc20Keeper.IncrementPermitNonce(ctx, erc20Addr, owner)
+	suite.Require().Equal(uint64(0), consumed)
+	consumed = suite.network.App.Erc20Keeper.IncrementPermitNonce(ctx, erc20Addr, owner)
+	suite.Require().Equal(uint64(1), consumed)
+	suite.Require().Equal(uint64(2), suite.network.App.Erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+
+	// nonces are tracked per token and owner
+	suite.Require().Equal(uint64(0), suite.network.App.Erc20Keeper.GetPermitNonce(ctx, erc20Addr2, owner))
+	suite.Require().Equal(uint64(0), suite.network.App.Erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner2))
+}
@@ -3,8 +3,11 @@
 package keeper
 
 import (
+	"math"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/evm/ibc"
 	"github.com/cosmos/evm/x/erc20/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -133,3 +136,90 @@ func (k Keeper) toggleConversion(
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// updateTokenPairMetadata replaces the bank denom metadata for a given token pair.
+// The base denomination must match the token pair denom and the display exponent
+// must match the current decimals of the token, since changing it would alter the
+// balances reported on the EVM side.
+func (k Keeper) updateTokenPairMetadata(
+	ctx sdk.Context,
+	token string,
+	metadata banktypes.Metadata,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if metadata.Base != pair.Denom {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInvalidMetadata, "base denom mismatch; expected %s, got %s", pair.Denom, metadata.Base,
+		)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+
+	decimals, err := k.tokenPairDecimals(ctx, pair)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if exponent := displayExponent(metadata); exponent != uint32(decimals) {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInvalidMetadata, "display exponent cannot be changed; expected %d, got %d", decimals, exponent,
+		)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return pair, nil
+}
+
+// tokenPairDecimals returns the decimals currently exposed by the ERC20 side of
+// the token pair. It uses the existing bank metadata if found, the ERC20 contract
+// for native ERC20s and the IBC denomination prefix otherwise, mirroring the
+// behavior of the ERC20 precompile.
+func (k Keeper) tokenPairDecimals(ctx sdk.Context, pair types.TokenPair) (uint8, error) {
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom); found {
+		exponent := displayExponent(metadata)
+		if exponent > math.MaxUint8 {
+			return 0, errorsmod.Wrapf(types.ErrInvalidMetadata, "uint8 overflow: invalid decimals: %d", exponent)
+		}
+		return uint8(exponent), nil //#nosec G115 -- checked for overflow above
+	}
+
+	if pair.IsNativeERC20() {
+		erc20Data, err := k.QueryERC20(ctx, pair.GetERC20Contract())
+		if err != nil {
+			return 0, err
+		}
+		return erc20Data.Decimals, nil
+	}
+
+	denom, err := ibc.GetDenom(*k.transferKeeper, ctx, pair.Denom)
+	if err != nil {
+		return 0, err
+	}
+	return ibc.DeriveDecimalsFromDenom(denom.Base)
+}
+
+// displayExponent returns the exponent of the display denomination unit, or zero
+// if the display unit is not part of the denomination units.
+func displayExponent(metadata banktypes.Metadata) uint32 {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+	return 0
+}
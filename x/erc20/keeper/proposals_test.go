@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/evm/contracts"
 	testfactory "github.com/cosmos/evm/testutil/integration/os/factory"
 	testutils "github.com/cosmos/evm/testutil/integration/os/utils"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/erc20/keeper"
 	"github.com/cosmos/evm/x/erc20/types"
 	erc20mocks "github.com/cosmos/evm/x/erc20/types/mocks"
@@ -326,3 +327,122 @@ func (suite *KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadata() {
+	var (
+		ctx          sdk.Context
+		contractAddr common.Address
+		authority    string
+		metadata     banktypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token not registered",
+			func() {
+				contractAddr = utiltx.GenerateAddress()
+				metadata.Base = types.CreateDenom(contractAddr.String())
+			},
+			false,
+		},
+		{
+			"fail - invalid authority",
+			func() {
+				authority = suite.keyring.GetAccAddr(0).String()
+			},
+			false,
+		},
+		{
+			"fail - base denom mismatch",
+			func() {
+				metadata.Base = cosmosTokenBase
+				metadata.DenomUnits[0].Denom = cosmosTokenBase
+			},
+			false,
+		},
+		{
+			"fail - display exponent changed",
+			func() {
+				metadata.DenomUnits[1].Exponent = uint32(cosmosDecimals)
+			},
+			false,
+		},
+		{
+			"ok - update name, symbol and uri",
+			func() {
+				metadata.Name = "Updated Coin Token"
+				metadata.Symbol = "UCTKN"
+				metadata.URI = "https://example.com/logo.svg"
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			var err error
+			contractAddr, err = suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Require().NoError(err, "failed to register pair")
+			ctx = suite.network.GetContext()
+
+			var found bool
+			metadata, found = suite.network.App.BankKeeper.GetDenomMetaData(ctx, types.CreateDenom(contractAddr.String()))
+			suite.Require().True(found, "expected metadata to be registered")
+			authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+			tc.malleate()
+
+			_, err = suite.network.App.Erc20Keeper.UpdateTokenPairMetadata(ctx, &types.MsgUpdateTokenPairMetadata{
+				Authority: authority,
+				Token:     contractAddr.String(),
+				Metadata:  metadata,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				stored, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+				suite.Require().True(found)
+				suite.Require().Equal(metadata, stored)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadataModuleOwned() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	// register the ERC20 precompile of an IBC coin
+	suite.network.App.BankKeeper.SetDenomMetaData(ctx, metadataIbc)
+	pair, err := suite.network.App.Erc20Keeper.RegisterERC20Extension(ctx, ibcBase)
+	suite.Require().NoError(err)
+	suite.Require().True(pair.IsNativeCoin())
+
+	erc20Data, err := suite.network.App.Erc20Keeper.QueryERC20(ctx, pair.GetERC20Contract())
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadataIbc.Name, erc20Data.Name)
+	suite.Require().Equal(metadataIbc.Symbol, erc20Data.Symbol)
+
+	metadata := metadataIbc
+	metadata.Name = "Updated IBC Token"
+	metadata.Symbol = "UIBC"
+	_, err = suite.network.App.Erc20Keeper.UpdateTokenPairMetadata(ctx, &types.MsgUpdateTokenPairMetadata{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Token:     pair.GetERC20Contract().String(),
+		Metadata:  metadata,
+	})
+	suite.Require().NoError(err)
+
+	// the precompile name and symbol are resynced with the new metadata
+	erc20Data, err = suite.network.App.Erc20Keeper.QueryERC20(ctx, pair.GetERC20Contract())
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata.Name, erc20Data.Name)
+	suite.Require().Equal(metadata.Symbol, erc20Data.Symbol)
+	suite.Require().Equal(uint8(0), erc20Data.Decimals)
+}
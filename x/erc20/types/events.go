@@ -8,15 +8,26 @@ import (
 
 // erc20 events
 const (
-	EventTypeConvertERC20           = "convert_erc20"
-	EventTypeRegisterERC20          = "register_erc20"
-	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
-	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeConvertERC20            = "convert_erc20"
+	EventTypeRegisterERC20           = "register_erc20"
+	EventTypeToggleTokenConversion   = "toggle_token_conversion" // #nosec
+	EventTypeRegisterERC20Extension  = "register_erc20_extension"
+	EventTypeUpdateTokenPairMetadata = "update_token_pair_metadata"
+	EventTypePacketCallback          = "packet_callback"
+	EventTypeIBCHook                 = "ibc_hook"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyName           = "name"
+	AttributeKeySymbol         = "symbol"
+	AttributeKeyContract       = "contract"
+	AttributeKeyCallback       = "callback"
+	AttributeKeyPacketSequence = "packet_sequence"
+	AttributeKeyCallbackError  = "callback_error"
+	AttributeKeySender         = "sender"
+	AttributeKeyGasUsed        = "gas_used"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
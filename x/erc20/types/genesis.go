@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // NewGenesisState creates a new genesis state.
@@ -17,9 +19,10 @@ func NewGenesisState(params Params, pairs []TokenPair, allowances []Allowance) G
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		TokenPairs: []TokenPair{},
-		Allowances: []Allowance{},
+		Params:       DefaultParams(),
+		TokenPairs:   []TokenPair{},
+		Allowances:   []Allowance{},
+		PermitNonces: []PermitNonce{},
 	}
 }
 
@@ -78,6 +81,24 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	// Check if permit nonces are valid
+	//
+	// NOTE: permit nonces don't need a corresponding token pair, as they are kept
+	// when the token pair is deleted
+	seenPermitNonce := make(map[string]bool)
+	for _, n := range gs.PermitNonces {
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+
+		key := string(PermitNonceKey(common.HexToAddress(n.Erc20Address), common.HexToAddress(n.Owner)))
+		if seenPermitNonce[key] {
+			return fmt.Errorf("duplicated permit nonce on genesis: %s", n.Erc20Address+n.Owner)
+		}
+
+		seenPermitNonce[key] = true
+	}
+
 	return nil
 }
 
@@ -32,6 +32,8 @@ type GenesisState struct {
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
 	// allowances is a slice of the registered allowances at genesis
 	Allowances []Allowance `protobuf:"bytes,3,rep,name=allowances,proto3" json:"allowances"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,4,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +90,13 @@ func (m *GenesisState) GetAllowances() []Allowance {
 	return nil
 }
 
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -103,6 +112,13 @@ type Params struct {
 	// permissionless_registration is the parameter that allows ERC20s to be
 	// permissionlessly registered to be converted to bank tokens and vice versa
 	PermissionlessRegistration bool `protobuf:"varint,5,opt,name=permissionless_registration,json=permissionlessRegistration,proto3" json:"permissionless_registration,omitempty"`
+	// auto_register_denoms is the parameter that enables the automatic
+	// registration of a dynamic ERC20 precompile for every native Cosmos coin
+	// with bank metadata the first time it is minted. The registration only
+	// runs on mint: setting the bank metadata of a coin does not register it,
+	// so the coins minted before their metadata is set are registered at their
+	// next mint.
+	AutoRegisterDenoms bool `protobuf:"varint,6,opt,name=auto_register_denoms,json=autoRegisterDenoms,proto3" json:"auto_register_denoms,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -166,6 +182,13 @@ func (m *Params) GetPermissionlessRegistration() bool {
 	return false
 }
 
+func (m *Params) GetAutoRegisterDenoms() bool {
+	if m != nil {
+		return m.AutoRegisterDenoms
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "cosmos.evm.erc20.v1.Params")
@@ -174,32 +197,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0x86, 0x33, 0xdb, 0xb5, 0xec, 0x4e, 0xf6, 0xe0, 0xce, 0x7a, 0x08, 0x5d, 0xc8, 0x6e, 0xeb,
-	0xa5, 0x08, 0x66, 0x6c, 0x3d, 0xab, 0x58, 0x10, 0xb1, 0xa7, 0x12, 0x3d, 0x79, 0x09, 0xd3, 0xf8,
-	0x11, 0x07, 0x33, 0x33, 0x61, 0x66, 0x8c, 0xf6, 0x5f, 0xf8, 0x33, 0x3c, 0xfa, 0x33, 0x7a, 0x2c,
-	0x9e, 0x3c, 0x89, 0xb4, 0x88, 0x7f, 0x43, 0x3a, 0x93, 0xd2, 0x14, 0xca, 0x5e, 0xc2, 0xc7, 0xfb,
-	0x3d, 0xef, 0x9b, 0x77, 0xe0, 0xc3, 0xfd, 0x5c, 0x19, 0xa1, 0x0c, 0x85, 0x5a, 0x50, 0xd0, 0xf9,
-	0xf8, 0x09, 0xad, 0x47, 0xb4, 0x00, 0x09, 0x86, 0x9b, 0xa4, 0xd2, 0xca, 0x2a, 0x72, 0xe5, 0x91,
-	0x04, 0x6a, 0x91, 0x38, 0x24, 0xa9, 0x47, 0xbd, 0x4b, 0x26, 0xb8, 0x54, 0xd4, 0x7d, 0x3d, 0xd7,
-	0xbb, 0x39, 0x16, 0xe5, 0x0d, 0x1e, 0x78, 0x50, 0xa8, 0x42, 0xb9, 0x91, 0x6e, 0x27, 0xaf, 0x0e,
-	0xfe, 0x22, 0x7c, 0xf1, 0xda, 0xff, 0xf0, 0xad, 0x65, 0x16, 0xc8, 0x73, 0xdc, 0xad, 0x98, 0x66,
-	0xc2, 0x44, 0xe8, 0x16, 0x0d, 0xc3, 0xf1, 0x75, 0x72, 0xa4, 0x40, 0x32, 0x73, 0xc8, 0xe4, 0x7c,
-	0xf9, 0xfb, 0x26, 0xf8, 0xfe, 0xef, 0xc7, 0x23, 0x94, 0x36, 0x2e, 0x32, 0xc5, 0xa1, 0x55, 0x9f,
-	0x40, 0x66, 0x15, 0xe3, 0xda, 0x44, 0x27, 0xb7, 0x9d, 0x61, 0x38, 0x8e, 0x8f, 0x86, 0xbc, 0xdb,
-	0x72, 0x33, 0xc6, 0x75, 0x3b, 0x07, 0xdb, 0x9d, 0x6a, 0xc8, 0x1b, 0x8c, 0x59, 0x59, 0xaa, 0x2f,
-	0x4c, 0xe6, 0x60, 0xa2, 0xce, 0x1d, 0x51, 0x2f, 0x77, 0xd8, 0x41, 0xd4, 0xde, 0x3c, 0xf8, 0x89,
-	0x70, 0xd7, 0x97, 0x26, 0x7d, 0x7c, 0x01, 0x92, 0xcd, 0x4b, 0xc8, 0x9c, 0xdd, 0xbd, 0xf3, 0x2c,
-	0x0d, 0xbd, 0xf6, 0x6a, 0x2b, 0x91, 0xc7, 0x98, 0x48, 0x66, 0x79, 0x0d, 0x59, 0xa5, 0x21, 0x57,
-	0xa2, 0xe2, 0x65, 0x53, 0xe0, 0x3c, 0xbd, 0xf4, 0x9b, 0xd9, 0x7e, 0x41, 0x28, 0xbe, 0xfa, 0xb0,
-	0x90, 0x4c, 0xf0, 0xfc, 0x80, 0x3f, 0x75, 0x3c, 0x69, 0x56, 0x6d, 0xc3, 0x0b, 0x7c, 0x5d, 0x81,
-	0x16, 0xdc, 0x18, 0xae, 0x64, 0x09, 0xc6, 0x64, 0x1a, 0x0a, 0x6e, 0xac, 0x66, 0x96, 0x2b, 0x19,
-	0xdd, 0x73, 0x8d, 0x7a, 0x87, 0x48, 0xda, 0x22, 0xa6, 0xa7, 0x67, 0x27, 0xf7, 0x3b, 0x93, 0x67,
-	0xcb, 0x75, 0x8c, 0x56, 0xeb, 0x18, 0xfd, 0x59, 0xc7, 0xe8, 0xdb, 0x26, 0x0e, 0x56, 0x9b, 0x38,
-	0xf8, 0xb5, 0x89, 0x83, 0xf7, 0x0f, 0x0b, 0x6e, 0x3f, 0x7e, 0x9e, 0x27, 0xb9, 0x12, 0xb4, 0x75,
-	0x18, 0x5f, 0x9b, 0xd3, 0xb0, 0x8b, 0x0a, 0xcc, 0xbc, 0xeb, 0x4e, 0xe0, 0xe9, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x7f, 0x42, 0x06, 0x94, 0x86, 0x02, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x24, 0x44, 0xed, 0x25, 0x48, 0xf4, 0xda, 0xc1, 0x4a, 0x25, 0x37, 0x2d, 0x4b,
+	0x85, 0x84, 0xdd, 0x86, 0x19, 0x10, 0x15, 0x08, 0xd1, 0x01, 0x45, 0x86, 0x89, 0xc5, 0xba, 0xb8,
+	0x4f, 0xe6, 0x84, 0xef, 0x9e, 0x75, 0xef, 0x6a, 0xe8, 0xce, 0x07, 0xe0, 0x63, 0x30, 0xb2, 0xf1,
+	0x15, 0x3a, 0x76, 0x64, 0x42, 0x28, 0x19, 0xf8, 0x1a, 0x28, 0x77, 0xae, 0x92, 0xa0, 0xa8, 0x8b,
+	0x75, 0xfa, 0xff, 0x7f, 0xff, 0xbf, 0xdf, 0x9d, 0x1e, 0x3b, 0xcc, 0x91, 0x14, 0x52, 0x02, 0xb5,
+	0x4a, 0xc0, 0xe4, 0xe3, 0x93, 0xa4, 0x3e, 0x4d, 0x0a, 0xd0, 0x40, 0x92, 0xe2, 0xca, 0xa0, 0x45,
+	0xbe, 0xeb, 0x91, 0x18, 0x6a, 0x15, 0x3b, 0x24, 0xae, 0x4f, 0x87, 0x3b, 0x42, 0x49, 0x8d, 0x89,
+	0xfb, 0x7a, 0x6e, 0x78, 0xb0, 0xa9, 0xca, 0x07, 0x3c, 0xb0, 0x57, 0x60, 0x81, 0xee, 0x98, 0x2c,
+	0x4e, 0x5e, 0x3d, 0xfa, 0xd9, 0x66, 0x83, 0xd7, 0xfe, 0x87, 0xef, 0xac, 0xb0, 0xc0, 0x9f, 0xb1,
+	0x5e, 0x25, 0x8c, 0x50, 0x14, 0x06, 0xa3, 0xe0, 0xb8, 0x3f, 0xde, 0x8f, 0x37, 0x0c, 0x10, 0x4f,
+	0x1c, 0x72, 0xb6, 0x7d, 0xfd, 0xfb, 0xa0, 0xf5, 0xfd, 0xef, 0x8f, 0x47, 0x41, 0xda, 0xa4, 0xf8,
+	0x39, 0xeb, 0x5b, 0xfc, 0x04, 0x3a, 0xab, 0x84, 0x34, 0x14, 0xb6, 0x47, 0x9d, 0xe3, 0xfe, 0x38,
+	0xda, 0x58, 0xf2, 0x7e, 0xc1, 0x4d, 0x84, 0x34, 0xab, 0x3d, 0xcc, 0xde, 0xaa, 0xc4, 0xdf, 0x30,
+	0x26, 0xca, 0x12, 0x3f, 0x0b, 0x9d, 0x03, 0x85, 0x9d, 0x3b, 0xaa, 0x5e, 0xdc, 0x62, 0x6b, 0x55,
+	0xcb, 0x30, 0x9f, 0xb0, 0xfb, 0x15, 0x18, 0x25, 0x6d, 0xa6, 0xd1, 0xb5, 0x75, 0x5d, 0xdb, 0x68,
+	0xf3, 0xed, 0x1c, 0xf9, 0x16, 0xff, 0xeb, 0x1b, 0x54, 0x4b, 0x9d, 0x8e, 0xbe, 0xb6, 0x59, 0xcf,
+	0x3f, 0x03, 0x3f, 0x64, 0x03, 0xd0, 0x62, 0x5a, 0x42, 0xe6, 0x2a, 0xdc, 0xcb, 0x6d, 0xa5, 0x7d,
+	0xaf, 0xbd, 0x5a, 0x48, 0xfc, 0x31, 0xe3, 0x5a, 0x58, 0x59, 0x43, 0x56, 0x19, 0xc8, 0x51, 0x55,
+	0xb2, 0x6c, 0xae, 0xb4, 0x9d, 0xee, 0x78, 0x67, 0xb2, 0x34, 0x78, 0xc2, 0x76, 0x2f, 0xae, 0xb4,
+	0x50, 0x32, 0x5f, 0xe3, 0xbb, 0x8e, 0xe7, 0x8d, 0xb5, 0x1a, 0x78, 0xce, 0xf6, 0xdd, 0x74, 0x44,
+	0x12, 0x75, 0x09, 0x44, 0x99, 0x81, 0x42, 0x92, 0x35, 0xc2, 0x4a, 0xd4, 0xe1, 0x3d, 0x37, 0xd1,
+	0x70, 0x1d, 0x49, 0x57, 0x08, 0x7e, 0xc2, 0xf6, 0xc4, 0xa5, 0xc5, 0x26, 0x06, 0x26, 0xbb, 0x00,
+	0x8d, 0x8a, 0xc2, 0x9e, 0x4b, 0xf2, 0x85, 0x97, 0x36, 0xd6, 0x4b, 0xe7, 0x9c, 0x77, 0xb7, 0xda,
+	0x0f, 0x3a, 0x67, 0x4f, 0xaf, 0x67, 0x51, 0x70, 0x33, 0x8b, 0x82, 0x3f, 0xb3, 0x28, 0xf8, 0x36,
+	0x8f, 0x5a, 0x37, 0xf3, 0xa8, 0xf5, 0x6b, 0x1e, 0xb5, 0x3e, 0x3c, 0x2c, 0xa4, 0xfd, 0x78, 0x39,
+	0x8d, 0x73, 0x54, 0xc9, 0xca, 0x72, 0x7e, 0x69, 0xd6, 0xd3, 0x5e, 0x55, 0x40, 0xd3, 0x9e, 0x5b,
+	0xc3, 0x27, 0xff, 0x06, 0x00, 0x84, 0x59, 0xe6, 0x7d, 0x0a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -222,6 +248,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -283,6 +323,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRegisterDenoms {
+		i--
+		if m.AutoRegisterDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.PermissionlessRegistration {
 		i--
 		if m.PermissionlessRegistration {
@@ -355,6 +405,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -382,6 +438,9 @@ func (m *Params) Size() (n int) {
 	if m.PermissionlessRegistration {
 		n += 2
 	}
+	if m.AutoRegisterDenoms {
+		n += 2
+	}
 	return n
 }
 
@@ -521,6 +580,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -675,6 +768,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PermissionlessRegistration = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterDenoms = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// IBCHookMemoKey is the key of the ICS20 packet memo JSON object that
+	// contains the EVM call to execute on the receipt of the packet.
+	IBCHookMemoKey = "evm"
+	// IBCHookSenderPrefix is the prefix used to derive the address of the
+	// intermediary sender of the EVM calls executed by IBC hooks.
+	IBCHookSenderPrefix = "ibc-evm-hook-intermediary"
+	// IBCHookMaxGasLimit is the maximum gas limit of an EVM call executed by
+	// an IBC hook. The gas of the call is paid by the relayer of the packet.
+	IBCHookMaxGasLimit uint64 = 1_000_000
+)
+
+// IBCHook defines the EVM call contained in the memo of an ICS20 packet, e.g.:
+//
+//	{"evm": {"contract": "0x...", "calldata": "0x...", "gas_limit": 200000}}
+type IBCHook struct {
+	// Contract is the hex address of the contract to call. It must be the
+	// receiver of the ICS20 packet.
+	Contract string `json:"contract"`
+	// Calldata is the hex encoded input of the call.
+	Calldata hexutil.Bytes `json:"calldata"`
+	// GasLimit is the gas limit of the call.
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// ParseIBCHook parses the EVM call from the given ICS20 packet memo. It
+// returns false if the memo does not contain an EVM call.
+func ParseIBCHook(memo string) (*IBCHook, bool, error) {
+	if memo == "" {
+		return nil, false, nil
+	}
+
+	// the memo can be used for other purposes, so it is only an IBC hook if it
+	// is a JSON object that contains the IBCHookMemoKey
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, false, nil
+	}
+
+	raw, ok := fields[IBCHookMemoKey]
+	if !ok {
+		return nil, false, nil
+	}
+
+	var hook IBCHook
+	if err := json.Unmarshal(raw, &hook); err != nil {
+		return nil, true, errorsmod.Wrapf(ErrInvalidIBCHook, "failed to unmarshal memo: %s", err.Error())
+	}
+
+	if err := hook.Validate(); err != nil {
+		return nil, true, err
+	}
+
+	return &hook, true, nil
+}
+
+// Validate performs a stateless validation of the IBC hook.
+func (h IBCHook) Validate() error {
+	if !common.IsHexAddress(h.Contract) {
+		return errorsmod.Wrapf(ErrInvalidIBCHook, "invalid contract address %s", h.Contract)
+	}
+
+	if h.GasLimit == 0 || h.GasLimit > IBCHookMaxGasLimit {
+		return errorsmod.Wrapf(ErrInvalidIBCHook, "gas limit must be between 1 and %d, got %d", IBCHookMaxGasLimit, h.GasLimit)
+	}
+
+	return nil
+}
+
+// DeriveIBCHookSender returns the intermediary address used as the sender of
+// the EVM call of an IBC hook. It is derived from the destination channel and
+// the original sender of the packet, so that the called contract can not be
+// tricked into trusting a sender from another chain.
+func DeriveIBCHookSender(channel, originalSender string) common.Address {
+	return common.BytesToAddress(address.Hash(IBCHookSenderPrefix, []byte(fmt.Sprintf("%s/%s", channel, originalSender))))
+}
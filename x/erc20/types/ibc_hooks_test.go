@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+)
+
+func TestParseIBCHook(t *testing.T) {
+	contract := "0xdac17f958d2ee523a2206206994597c13d831ec7"
+
+	testCases := []struct {
+		name     string
+		memo     string
+		expFound bool
+		expHook  *types.IBCHook
+		expErr   bool
+	}{
+		{"empty memo", "", false, nil, false},
+		{"non JSON memo", "hello", false, nil, false},
+		{"memo without evm key", `{"forward":{"receiver":"cosmos1"}}`, false, nil, false},
+		{
+			"valid hook",
+			`{"evm":{"contract":"` + contract + `","calldata":"0x12345678","gas_limit":200000}}`,
+			true,
+			&types.IBCHook{Contract: contract, Calldata: common.FromHex("0x12345678"), GasLimit: 200_000},
+			false,
+		},
+		{"invalid calldata", `{"evm":{"contract":"` + contract + `","calldata":"xyz","gas_limit":200000}}`, true, nil, true},
+		{"invalid contract", `{"evm":{"contract":"0x1234","calldata":"0x","gas_limit":200000}}`, true, nil, true},
+		{"zero gas limit", `{"evm":{"contract":"` + contract + `","calldata":"0x"}}`, true, nil, true},
+		{"gas limit too high", `{"evm":{"contract":"` + contract + `","calldata":"0x","gas_limit":1000001}}`, true, nil, true},
+	}
+
+	for _, tc := range testCases {
+		hook, found, err := types.ParseIBCHook(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expErr {
+			require.ErrorIs(t, err, types.ErrInvalidIBCHook, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expHook, hook, tc.name)
+	}
+}
+
+func TestDeriveIBCHookSender(t *testing.T) {
+	sender := types.DeriveIBCHookSender("channel-0", "cosmos1sender")
+	require.NotEqual(t, common.Address{}, sender)
+	require.Equal(t, sender, types.DeriveIBCHookSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, types.DeriveIBCHookSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, types.DeriveIBCHookSender("channel-0", "cosmos1other"))
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -32,6 +35,8 @@ const (
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
 	prefixAllowance
+	prefixPermitNonce
+	prefixPacketCallback
 )
 
 // KVStore key prefixes
@@ -41,6 +46,8 @@ var (
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
 	KeyPrefixAllowance        = []byte{prefixAllowance}
+	KeyPrefixPermitNonce      = []byte{prefixPermitNonce}
+	KeyPrefixPacketCallback   = []byte{prefixPacketCallback}
 )
 
 func AllowanceKey(
@@ -50,3 +57,21 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+// PermitNonceKey returns the key of the EIP-2612 permit nonce of the given
+// owner on the given erc20 precompile address.
+func PermitNonceKey(
+	erc20 common.Address,
+	owner common.Address,
+) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
+
+// PacketCallbackKey returns the key of the contract to be called back on the
+// acknowledgement or timeout of the given outgoing packet.
+func PacketCallbackKey(
+	portID, channelID string,
+	sequence uint64,
+) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
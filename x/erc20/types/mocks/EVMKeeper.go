@@ -119,6 +119,36 @@ func (_m *EVMKeeper) CallEVMWithData(ctx types.Context, from common.Address, con
 	return r0, r1
 }
 
+// CallEVMWithDataAndGasLimit provides a mock function with given fields: ctx, from, contract, data, gasLimit
+func (_m *EVMKeeper) CallEVMWithDataAndGasLimit(ctx types.Context, from common.Address, contract *common.Address, data []byte, gasLimit uint64) (*vmtypes.MsgEthereumTxResponse, error) {
+	ret := _m.Called(ctx, from, contract, data, gasLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallEVMWithDataAndGasLimit")
+	}
+
+	var r0 *vmtypes.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, *common.Address, []byte, uint64) (*vmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, from, contract, data, gasLimit)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, *common.Address, []byte, uint64) *vmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(ctx, from, contract, data, gasLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vmtypes.MsgEthereumTxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, common.Address, *common.Address, []byte, uint64) error); ok {
+		r1 = rf(ctx, from, contract, data, gasLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAccount provides a mock function with given fields: ctx, addr
 func (_m *EVMKeeper) DeleteAccount(ctx types.Context, addr common.Address) error {
 	ret := _m.Called(ctx, addr)
@@ -13,6 +13,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -279,3 +280,70 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateTokenPairMetadataValidateBasic() {
+	metadata := banktypes.Metadata{
+		Description: "Test token",
+		Base:        "atest",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "atest", Exponent: 0},
+			{Denom: "test", Exponent: 18},
+		},
+		Name:    "Test Token",
+		Symbol:  "TEST",
+		Display: "test",
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateTokenPairMetadata
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateTokenPairMetadata{
+				Authority: "invalid",
+				Token:     "atest",
+				Metadata:  metadata,
+			},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgUpdateTokenPairMetadata{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Metadata:  metadata,
+			},
+			false,
+		},
+		{
+			"fail - invalid metadata",
+			&types.MsgUpdateTokenPairMetadata{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "atest",
+				Metadata:  banktypes.Metadata{Base: "atest"},
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateTokenPairMetadata{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "atest",
+				Metadata:  metadata,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"bytes"
+	_ "embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// PacketCallbackGasLimit is the maximum amount of gas that a contract can
+	// consume when it is called back on the acknowledgement or timeout of a packet.
+	// The gas used by the callback is paid by the relayer of the acknowledgement
+	// or timeout.
+	PacketCallbackGasLimit uint64 = 300_000
+
+	// OnAckPacketMethod defines the ABI method name of the callback executed on the
+	// sending contract when a packet is acknowledged.
+	OnAckPacketMethod = "onAckPacket"
+	// OnTimeoutPacketMethod defines the ABI method name of the callback executed on
+	// the sending contract when a packet times out.
+	OnTimeoutPacketMethod = "onTimeoutPacket"
+)
+
+var (
+	// ics20CallbacksJSON is the ABI of the ICS20CallbacksI interface that a contract
+	// implements to be notified of the lifecycle of the packets it sent.
+	//
+	//go:embed ics20_callbacks.json
+	ics20CallbacksJSON []byte
+
+	// ICS20CallbacksABI is the parsed ABI of the ICS20CallbacksI interface.
+	ICS20CallbacksABI abi.ABI
+)
+
+func init() {
+	var err error
+	if ICS20CallbacksABI, err = abi.JSON(bytes.NewReader(ics20CallbacksJSON)); err != nil {
+		panic(err)
+	}
+}
@@ -16,6 +16,7 @@ var (
 	ParamStoreKeyDynamicPrecompiles         = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles          = []byte("NativePrecompiles")
 	ParamStoreKeyPermissionlessRegistration = []byte("PermissionlessRegistration")
+	ParamStoreKeyAutoRegisterDenoms         = []byte("AutoRegisterDenoms")
 )
 
 var (
@@ -34,6 +35,7 @@ func NewParams(
 	nativePrecompiles []string,
 	dynamicPrecompiles []string,
 	permissionlessRegistration bool,
+	autoRegisterDenoms bool,
 ) Params {
 	slices.Sort(nativePrecompiles)
 	slices.Sort(dynamicPrecompiles)
@@ -42,6 +44,7 @@ func NewParams(
 		NativePrecompiles:          nativePrecompiles,
 		DynamicPrecompiles:         dynamicPrecompiles,
 		PermissionlessRegistration: permissionlessRegistration,
+		AutoRegisterDenoms:         autoRegisterDenoms,
 	}
 }
 
@@ -51,6 +54,7 @@ func DefaultParams() Params {
 		NativePrecompiles:          DefaultNativePrecompiles,
 		DynamicPrecompiles:         DefaultDynamicPrecompiles,
 		PermissionlessRegistration: true,
+		AutoRegisterDenoms:         false,
 	}
 }
 
@@ -82,6 +86,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateBool(p.AutoRegisterDenoms); err != nil {
+		return err
+	}
+
 	combined := dpAddrs
 	combined = append(combined, npAddrs...)
 	return validatePrecompilesUniqueness(combined)
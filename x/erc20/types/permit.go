@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewPermitNonce(erc20 common.Address, owner common.Address, nonce uint64) PermitNonce {
+	return PermitNonce{
+		Erc20Address: erc20.Hex(),
+		Owner:        owner.Hex(),
+		Nonce:        nonce,
+	}
+}
+
+func (n PermitNonce) Validate() error {
+	if !common.IsHexAddress(n.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", n.Erc20Address)
+	}
+
+	if !common.IsHexAddress(n.Owner) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid owner hex address %s", n.Owner)
+	}
+
+	if n.Nonce == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidSequence, "permit nonce must be positive")
+	}
+
+	return nil
+}
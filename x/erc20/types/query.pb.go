@@ -31,6 +31,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenPairStatus enumerates the conversion status used to filter token pairs.
+type TokenPairStatus int32
+
+const (
+	// TOKEN_PAIR_STATUS_UNSPECIFIED matches token pairs with any status.
+	TOKEN_PAIR_STATUS_UNSPECIFIED TokenPairStatus = 0
+	// TOKEN_PAIR_STATUS_ENABLED matches token pairs with conversions enabled.
+	TOKEN_PAIR_STATUS_ENABLED TokenPairStatus = 1
+	// TOKEN_PAIR_STATUS_DISABLED matches token pairs with conversions disabled.
+	TOKEN_PAIR_STATUS_DISABLED TokenPairStatus = 2
+)
+
+var TokenPairStatus_name = map[int32]string{
+	0: "TOKEN_PAIR_STATUS_UNSPECIFIED",
+	1: "TOKEN_PAIR_STATUS_ENABLED",
+	2: "TOKEN_PAIR_STATUS_DISABLED",
+}
+
+var TokenPairStatus_value = map[string]int32{
+	"TOKEN_PAIR_STATUS_UNSPECIFIED": 0,
+	"TOKEN_PAIR_STATUS_ENABLED":     1,
+	"TOKEN_PAIR_STATUS_DISABLED":    2,
+}
+
+func (x TokenPairStatus) String() string {
+	return proto.EnumName(TokenPairStatus_name, int32(x))
+}
+
+func (TokenPairStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{0}
+}
+
+// TokenPairOrigin enumerates the origin of the Cosmos coin used to filter token
+// pairs.
+type TokenPairOrigin int32
+
+const (
+	// TOKEN_PAIR_ORIGIN_UNSPECIFIED matches token pairs with any origin.
+	TOKEN_PAIR_ORIGIN_UNSPECIFIED TokenPairOrigin = 0
+	// TOKEN_PAIR_ORIGIN_IBC matches token pairs whose denomination is an IBC
+	// voucher.
+	TOKEN_PAIR_ORIGIN_IBC TokenPairOrigin = 1
+	// TOKEN_PAIR_ORIGIN_NATIVE matches token pairs whose denomination is not an
+	// IBC voucher.
+	TOKEN_PAIR_ORIGIN_NATIVE TokenPairOrigin = 2
+)
+
+var TokenPairOrigin_name = map[int32]string{
+	0: "TOKEN_PAIR_ORIGIN_UNSPECIFIED",
+	1: "TOKEN_PAIR_ORIGIN_IBC",
+	2: "TOKEN_PAIR_ORIGIN_NATIVE",
+}
+
+var TokenPairOrigin_value = map[string]int32{
+	"TOKEN_PAIR_ORIGIN_UNSPECIFIED": 0,
+	"TOKEN_PAIR_ORIGIN_IBC":         1,
+	"TOKEN_PAIR_ORIGIN_NATIVE":      2,
+}
+
+func (x TokenPairOrigin) String() string {
+	return proto.EnumName(TokenPairOrigin_name, int32(x))
+}
+
+func (TokenPairOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{1}
+}
+
 // QueryTokenPairsRequest is the request type for the Query/TokenPairs RPC
 // method.
 type QueryTokenPairsRequest struct {
@@ -313,52 +380,416 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryTokenPairsByFilterRequest is the request type for the
+// Query/TokenPairsByFilter RPC method.
+type QueryTokenPairsByFilterRequest struct {
+	// contract_owner filters the token pairs by owner type. OWNER_UNSPECIFIED
+	// matches any owner.
+	ContractOwner Owner `protobuf:"varint,1,opt,name=contract_owner,json=contractOwner,proto3,enum=cosmos.evm.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// status filters the token pairs by conversion status.
+	Status TokenPairStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.evm.erc20.v1.TokenPairStatus" json:"status,omitempty"`
+	// origin filters the token pairs by the origin of the Cosmos coin.
+	Origin TokenPairOrigin `protobuf:"varint,3,opt,name=origin,proto3,enum=cosmos.evm.erc20.v1.TokenPairOrigin" json:"origin,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenPairsByFilterRequest) Reset()         { *m = QueryTokenPairsByFilterRequest{} }
+func (m *QueryTokenPairsByFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairsByFilterRequest) ProtoMessage()    {}
+func (*QueryTokenPairsByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{6}
+}
+func (m *QueryTokenPairsByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairsByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairsByFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairsByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairsByFilterRequest.Merge(m, src)
+}
+func (m *QueryTokenPairsByFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairsByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairsByFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenPairsByFilterRequest proto.InternalMessageInfo
+
+func (m *QueryTokenPairsByFilterRequest) GetContractOwner() Owner {
+	if m != nil {
+		return m.ContractOwner
+	}
+	return OWNER_UNSPECIFIED
+}
+
+func (m *QueryTokenPairsByFilterRequest) GetStatus() TokenPairStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TOKEN_PAIR_STATUS_UNSPECIFIED
+}
+
+func (m *QueryTokenPairsByFilterRequest) GetOrigin() TokenPairOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return TOKEN_PAIR_ORIGIN_UNSPECIFIED
+}
+
+func (m *QueryTokenPairsByFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenPairsByFilterResponse is the response type for the
+// Query/TokenPairsByFilter RPC method.
+type QueryTokenPairsByFilterResponse struct {
+	// token_pairs is a slice of the registered token pairs that match the filters
+	TokenPairs []TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenPairsByFilterResponse) Reset()         { *m = QueryTokenPairsByFilterResponse{} }
+func (m *QueryTokenPairsByFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairsByFilterResponse) ProtoMessage()    {}
+func (*QueryTokenPairsByFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{7}
+}
+func (m *QueryTokenPairsByFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairsByFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairsByFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairsByFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairsByFilterResponse.Merge(m, src)
+}
+func (m *QueryTokenPairsByFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairsByFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairsByFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenPairsByFilterResponse proto.InternalMessageInfo
+
+func (m *QueryTokenPairsByFilterResponse) GetTokenPairs() []TokenPair {
+	if m != nil {
+		return m.TokenPairs
+	}
+	return nil
+}
+
+func (m *QueryTokenPairsByFilterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryERC20AddressByIBCHashRequest is the request type for the
+// Query/ERC20AddressByIBCHash RPC method.
+type QueryERC20AddressByIBCHashRequest struct {
+	// hash is the hex encoded IBC denomination hash, with or without the "ibc/"
+	// prefix
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryERC20AddressByIBCHashRequest) Reset()         { *m = QueryERC20AddressByIBCHashRequest{} }
+func (m *QueryERC20AddressByIBCHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20AddressByIBCHashRequest) ProtoMessage()    {}
+func (*QueryERC20AddressByIBCHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{8}
+}
+func (m *QueryERC20AddressByIBCHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20AddressByIBCHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20AddressByIBCHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20AddressByIBCHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20AddressByIBCHashRequest.Merge(m, src)
+}
+func (m *QueryERC20AddressByIBCHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20AddressByIBCHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20AddressByIBCHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20AddressByIBCHashRequest proto.InternalMessageInfo
+
+func (m *QueryERC20AddressByIBCHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryERC20AddressByIBCHashResponse is the response type for the
+// Query/ERC20AddressByIBCHash RPC method.
+type QueryERC20AddressByIBCHashResponse struct {
+	// erc20_address is the hex address of the ERC20 contract or precompile
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// denom is the IBC voucher denomination of the token pair
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryERC20AddressByIBCHashResponse) Reset()         { *m = QueryERC20AddressByIBCHashResponse{} }
+func (m *QueryERC20AddressByIBCHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20AddressByIBCHashResponse) ProtoMessage()    {}
+func (*QueryERC20AddressByIBCHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{9}
+}
+func (m *QueryERC20AddressByIBCHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20AddressByIBCHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20AddressByIBCHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20AddressByIBCHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20AddressByIBCHashResponse.Merge(m, src)
+}
+func (m *QueryERC20AddressByIBCHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20AddressByIBCHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20AddressByIBCHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20AddressByIBCHashResponse proto.InternalMessageInfo
+
+func (m *QueryERC20AddressByIBCHashResponse) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *QueryERC20AddressByIBCHashResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomByERC20AddressRequest is the request type for the
+// Query/DenomByERC20Address RPC method.
+type QueryDenomByERC20AddressRequest struct {
+	// erc20_address is the hex address of the ERC20 contract or precompile
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *QueryDenomByERC20AddressRequest) Reset()         { *m = QueryDenomByERC20AddressRequest{} }
+func (m *QueryDenomByERC20AddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomByERC20AddressRequest) ProtoMessage()    {}
+func (*QueryDenomByERC20AddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{10}
+}
+func (m *QueryDenomByERC20AddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomByERC20AddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomByERC20AddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomByERC20AddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomByERC20AddressRequest.Merge(m, src)
+}
+func (m *QueryDenomByERC20AddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomByERC20AddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomByERC20AddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomByERC20AddressRequest proto.InternalMessageInfo
+
+func (m *QueryDenomByERC20AddressRequest) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+// QueryDenomByERC20AddressResponse is the response type for the
+// Query/DenomByERC20Address RPC method.
+type QueryDenomByERC20AddressResponse struct {
+	// denom is the Cosmos denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomByERC20AddressResponse) Reset()         { *m = QueryDenomByERC20AddressResponse{} }
+func (m *QueryDenomByERC20AddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomByERC20AddressResponse) ProtoMessage()    {}
+func (*QueryDenomByERC20AddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{11}
+}
+func (m *QueryDenomByERC20AddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomByERC20AddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomByERC20AddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomByERC20AddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomByERC20AddressResponse.Merge(m, src)
+}
+func (m *QueryDenomByERC20AddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomByERC20AddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomByERC20AddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomByERC20AddressResponse proto.InternalMessageInfo
+
+func (m *QueryDenomByERC20AddressResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.erc20.v1.TokenPairStatus", TokenPairStatus_name, TokenPairStatus_value)
+	proto.RegisterEnum("cosmos.evm.erc20.v1.TokenPairOrigin", TokenPairOrigin_name, TokenPairOrigin_value)
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenPairsByFilterRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsByFilterRequest")
+	proto.RegisterType((*QueryTokenPairsByFilterResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsByFilterResponse")
+	proto.RegisterType((*QueryERC20AddressByIBCHashRequest)(nil), "cosmos.evm.erc20.v1.QueryERC20AddressByIBCHashRequest")
+	proto.RegisterType((*QueryERC20AddressByIBCHashResponse)(nil), "cosmos.evm.erc20.v1.QueryERC20AddressByIBCHashResponse")
+	proto.RegisterType((*QueryDenomByERC20AddressRequest)(nil), "cosmos.evm.erc20.v1.QueryDenomByERC20AddressRequest")
+	proto.RegisterType((*QueryDenomByERC20AddressResponse)(nil), "cosmos.evm.erc20.v1.QueryDenomByERC20AddressResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/query.proto", fileDescriptor_f1630a6677a16bf4) }
 
 var fileDescriptor_f1630a6677a16bf4 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0xbb, 0x6c, 0xa1, 0xaf, 0x27, 0x67, 0xab, 0x2e, 0x5d, 0xcd, 0xd6, 0x2c, 0xb8,
-	0xa1, 0xab, 0x33, 0xdb, 0x7a, 0xd6, 0xc3, 0x1e, 0x54, 0x3c, 0xd5, 0xa2, 0x17, 0x2f, 0x3a, 0x29,
-	0x43, 0x0c, 0x9a, 0x4c, 0x36, 0x33, 0x0d, 0x2e, 0x22, 0x88, 0x9f, 0x40, 0x11, 0xfc, 0x0c, 0x9e,
-	0xc4, 0x9b, 0x5f, 0x61, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x5a, 0xc1, 0xaf, 0x21, 0x99, 0x99, 0xa6,
-	0x8d, 0x46, 0x5a, 0x2f, 0x25, 0x79, 0xfd, 0xbf, 0xff, 0xff, 0xf7, 0xde, 0x0c, 0x81, 0xbd, 0x91,
-	0x90, 0x91, 0x90, 0x94, 0x67, 0x11, 0xe5, 0xe9, 0xa8, 0x7f, 0x44, 0xb3, 0x1e, 0x3d, 0x19, 0xf3,
-	0xf4, 0x94, 0x24, 0xa9, 0x50, 0x02, 0x6f, 0x1b, 0x01, 0xe1, 0x59, 0x44, 0xb4, 0x80, 0x64, 0xbd,
-	0xf6, 0x39, 0x16, 0x85, 0xb1, 0xa0, 0xfa, 0xd7, 0xe8, 0xda, 0x5d, 0x6b, 0xe4, 0x33, 0xc9, 0x8d,
-	0x01, 0xcd, 0x7a, 0x3e, 0x57, 0xac, 0x47, 0x13, 0x16, 0x84, 0x31, 0x53, 0xa1, 0x88, 0xad, 0xb6,
-	0x15, 0x88, 0x40, 0xe8, 0x47, 0x9a, 0x3f, 0xd9, 0xea, 0xa5, 0x40, 0x88, 0xe0, 0x39, 0xa7, 0x2c,
-	0x09, 0x29, 0x8b, 0x63, 0xa1, 0x74, 0x8b, 0xb4, 0xff, 0x56, 0x82, 0x1a, 0x20, 0x23, 0xb8, 0x52,
-	0x25, 0x08, 0x78, 0xcc, 0x65, 0x68, 0x3d, 0xdc, 0x27, 0x70, 0xe1, 0x7e, 0x4e, 0xf6, 0x40, 0x3c,
-	0xe3, 0xf1, 0x80, 0x85, 0xa9, 0x1c, 0xf2, 0x93, 0x31, 0x97, 0x0a, 0xdf, 0x06, 0x58, 0x50, 0xee,
-	0xa0, 0x0e, 0xf2, 0x9a, 0xfd, 0xab, 0xc4, 0x8e, 0x9e, 0x8f, 0x44, 0xcc, 0x4e, 0xec, 0x48, 0x64,
-	0xc0, 0x02, 0x6e, 0x7b, 0x87, 0x4b, 0x9d, 0xee, 0x27, 0x04, 0x17, 0xff, 0x8a, 0x90, 0x89, 0x88,
-	0x25, 0xc7, 0xf7, 0xa0, 0xa9, 0xf2, 0xea, 0xe3, 0x24, 0x2f, 0xef, 0xa0, 0xce, 0xa6, 0xd7, 0xec,
-	0x3b, 0xa4, 0x62, 0xbf, 0xa4, 0xe8, 0x3e, 0x6e, 0x9c, 0x7d, 0xdf, 0xab, 0x7d, 0xfc, 0xf5, 0xb9,
-	0x8b, 0x86, 0xa0, 0x0a, 0x4f, 0x7c, 0xa7, 0xc4, 0xbb, 0xa1, 0x79, 0x0f, 0x56, 0xf2, 0x1a, 0x90,
-	0x12, 0xf0, 0x75, 0x38, 0x5f, 0xe6, 0x9d, 0x6f, 0xa4, 0x05, 0x5b, 0x3a, 0x4f, 0x2f, 0xa3, 0x31,
-	0x34, 0x2f, 0xae, 0xff, 0xe7, 0x06, 0x8b, 0xe9, 0xee, 0x02, 0x2c, 0xa6, 0xb3, 0x1b, 0xfc, 0x8f,
-	0xe1, 0x1a, 0xc5, 0x70, 0x6e, 0x0b, 0xb0, 0xce, 0x18, 0xb0, 0x94, 0x45, 0xf3, 0x13, 0x72, 0x1f,
-	0xc2, 0x76, 0xa9, 0x6a, 0x63, 0x6f, 0x41, 0x3d, 0xd1, 0x15, 0x1b, 0xb9, 0x5b, 0x19, 0x69, 0x9a,
-	0x96, 0xf3, 0x6c, 0x57, 0xff, 0xcb, 0x26, 0x6c, 0x69, 0x5f, 0xfc, 0x0e, 0x01, 0x2c, 0x4e, 0x0d,
-	0x1f, 0x56, 0x1a, 0x55, 0x5f, 0x9f, 0xf6, 0xb5, 0xf5, 0xc4, 0x86, 0xd9, 0xf5, 0xde, 0x7c, 0xfd,
-	0xf9, 0x7e, 0xc3, 0xc5, 0x1d, 0x5a, 0x75, 0x65, 0x97, 0xee, 0x08, 0xfe, 0x80, 0xa0, 0x51, 0x18,
-	0xe0, 0xee, 0x1a, 0x29, 0x73, 0xa2, 0xc3, 0xb5, 0xb4, 0x16, 0xe8, 0x48, 0x03, 0x75, 0xb1, 0xb7,
-	0x0a, 0x88, 0xbe, 0xd4, 0x2f, 0xaf, 0xf0, 0x6b, 0x04, 0x75, 0xb3, 0x54, 0x7c, 0xf0, 0xef, 0xa4,
-	0xd2, 0x09, 0xb6, 0xbd, 0xd5, 0x42, 0xcb, 0xb3, 0xaf, 0x79, 0x2e, 0xe3, 0xdd, 0x4a, 0x1e, 0x73,
-	0x72, 0xc7, 0x37, 0xcf, 0xa6, 0x0e, 0x9a, 0x4c, 0x1d, 0xf4, 0x63, 0xea, 0xa0, 0xb7, 0x33, 0xa7,
-	0x36, 0x99, 0x39, 0xb5, 0x6f, 0x33, 0xa7, 0xf6, 0x68, 0x3f, 0x08, 0xd5, 0xd3, 0xb1, 0x4f, 0x46,
-	0x22, 0x5a, 0x36, 0x78, 0x61, 0x2d, 0xd4, 0x69, 0xc2, 0xa5, 0x5f, 0xd7, 0x9f, 0x84, 0x1b, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x69, 0xda, 0x20, 0x01, 0x05, 0x00, 0x00,
+	// 956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x69, 0x1a, 0xe4, 0x17, 0x9a, 0x86, 0x49, 0x02, 0xe9, 0xb6, 0xd9, 0x24, 0x1b,
+	0x44, 0x2d, 0xb7, 0xec, 0x26, 0x6e, 0x69, 0x11, 0x0a, 0x48, 0xde, 0xc4, 0x69, 0x17, 0x90, 0x63,
+	0xd6, 0x0e, 0x07, 0x2e, 0xcb, 0xd8, 0x19, 0x36, 0x2b, 0xea, 0x1d, 0xd7, 0xb3, 0x31, 0x58, 0x55,
+	0x24, 0xc4, 0x89, 0x23, 0x08, 0x89, 0x7f, 0x80, 0x03, 0x9c, 0x10, 0x12, 0x12, 0x7f, 0x02, 0xea,
+	0xb1, 0x12, 0x17, 0x4e, 0x08, 0x25, 0x48, 0xfc, 0x19, 0xa0, 0x9d, 0x19, 0x3b, 0xfe, 0xb1, 0x4e,
+	0x1c, 0x4e, 0xbd, 0x24, 0x3b, 0x33, 0xef, 0xfb, 0xde, 0xe7, 0xbd, 0x79, 0x7e, 0xbb, 0xb0, 0x5c,
+	0x63, 0xbc, 0xce, 0xb8, 0x45, 0x5b, 0x75, 0x8b, 0x36, 0x6b, 0xb9, 0x75, 0xab, 0xb5, 0x61, 0x3d,
+	0x3e, 0xa4, 0xcd, 0xb6, 0xd9, 0x68, 0xb2, 0x88, 0xe1, 0x39, 0x69, 0x60, 0xd2, 0x56, 0xdd, 0x14,
+	0x06, 0x66, 0x6b, 0x43, 0x7b, 0x89, 0xd4, 0x83, 0x90, 0x59, 0xe2, 0xaf, 0xb4, 0xd3, 0xb2, 0xca,
+	0x51, 0x95, 0x70, 0x2a, 0x1d, 0x58, 0xad, 0x8d, 0x2a, 0x8d, 0xc8, 0x86, 0xd5, 0x20, 0x7e, 0x10,
+	0x92, 0x28, 0x60, 0xa1, 0xb2, 0x9d, 0xf7, 0x99, 0xcf, 0xc4, 0xa3, 0x15, 0x3f, 0xa9, 0xdd, 0x1b,
+	0x3e, 0x63, 0xfe, 0x23, 0x6a, 0x91, 0x46, 0x60, 0x91, 0x30, 0x64, 0x91, 0x90, 0x70, 0x75, 0x9a,
+	0x08, 0x2a, 0x81, 0xa4, 0xc1, 0x6a, 0x92, 0x81, 0x4f, 0x43, 0xca, 0x03, 0xe5, 0xc3, 0xf8, 0x18,
+	0x5e, 0xfe, 0x20, 0x26, 0xab, 0xb0, 0x4f, 0x69, 0x58, 0x22, 0x41, 0x93, 0xbb, 0xf4, 0xf1, 0x21,
+	0xe5, 0x11, 0xde, 0x01, 0x38, 0xa5, 0x5c, 0x44, 0x2b, 0x28, 0x33, 0x9d, 0x7b, 0xcd, 0x54, 0xa9,
+	0xc7, 0x29, 0x99, 0xb2, 0x26, 0x2a, 0x25, 0xb3, 0x44, 0x7c, 0xaa, 0xb4, 0x6e, 0x8f, 0xd2, 0xf8,
+	0x09, 0xc1, 0x2b, 0x43, 0x21, 0x78, 0x83, 0x85, 0x9c, 0xe2, 0x77, 0x61, 0x3a, 0x8a, 0x77, 0xbd,
+	0x46, 0xbc, 0xbd, 0x88, 0x56, 0x2e, 0x65, 0xa6, 0x73, 0xba, 0x99, 0x50, 0x5f, 0xb3, 0xab, 0xb6,
+	0xd3, 0x4f, 0xff, 0x5c, 0x4e, 0xfd, 0xf8, 0xcf, 0xcf, 0x59, 0xe4, 0x42, 0xd4, 0xf5, 0x89, 0x1f,
+	0xf4, 0xf1, 0x4e, 0x08, 0xde, 0x9b, 0xe7, 0xf2, 0x4a, 0x90, 0x3e, 0xe0, 0xd7, 0x61, 0xa1, 0x9f,
+	0xb7, 0x53, 0x91, 0x79, 0xb8, 0x2c, 0xe2, 0x89, 0x62, 0xa4, 0x5d, 0xb9, 0x30, 0xaa, 0x83, 0x15,
+	0xec, 0x66, 0xf7, 0x10, 0xe0, 0x34, 0x3b, 0x55, 0xc1, 0x0b, 0x24, 0x97, 0xee, 0x26, 0x67, 0xcc,
+	0x03, 0x16, 0x31, 0x4a, 0xa4, 0x49, 0xea, 0x9d, 0x1b, 0x32, 0xf6, 0x60, 0xae, 0x6f, 0x57, 0x85,
+	0x7d, 0x07, 0xa6, 0x1a, 0x62, 0x47, 0x85, 0xbc, 0x9e, 0x18, 0x52, 0x8a, 0x7a, 0xe3, 0x29, 0x95,
+	0xf1, 0xc3, 0x04, 0xe8, 0x03, 0x17, 0x66, 0xb7, 0x77, 0x82, 0x47, 0x11, 0xed, 0x56, 0x22, 0x0f,
+	0x33, 0x35, 0x16, 0x46, 0x4d, 0x52, 0x8b, 0x3c, 0xf6, 0x59, 0x48, 0x65, 0x76, 0x33, 0x39, 0x2d,
+	0x31, 0xd4, 0x6e, 0x6c, 0xe1, 0x5e, 0xe9, 0x28, 0xc4, 0x12, 0x6f, 0xc2, 0x14, 0x8f, 0x48, 0x74,
+	0xc8, 0xc5, 0x55, 0xcd, 0xe4, 0x5e, 0x3d, 0xbb, 0x30, 0x65, 0x61, 0xeb, 0x2a, 0x4d, 0xac, 0x66,
+	0xcd, 0xc0, 0x0f, 0xc2, 0xc5, 0x4b, 0xe3, 0xa8, 0x77, 0x85, 0xad, 0xab, 0x34, 0x03, 0xad, 0x3d,
+	0xf9, 0xbf, 0x5b, 0xfb, 0x57, 0x04, 0xcb, 0x23, 0x2b, 0xf5, 0x3c, 0xb7, 0xf8, 0x7d, 0x58, 0x15,
+	0xdc, 0x05, 0x77, 0x2b, 0xb7, 0x9e, 0xdf, 0xdf, 0x6f, 0x52, 0xce, 0xed, 0xb6, 0x63, 0x6f, 0x3d,
+	0x24, 0xfc, 0xa0, 0x73, 0xc9, 0x18, 0x26, 0x0f, 0x08, 0x3f, 0x50, 0xdd, 0x2e, 0x9e, 0x0d, 0x0f,
+	0x8c, 0xb3, 0x84, 0x2a, 0xe7, 0x35, 0xb8, 0x22, 0x92, 0xf2, 0x88, 0xb4, 0x50, 0x2e, 0x5e, 0x14,
+	0x9b, 0x4a, 0x15, 0xff, 0x9a, 0xf6, 0x69, 0xc8, 0xea, 0x22, 0x8f, 0xb4, 0x2b, 0x17, 0xc6, 0x8e,
+	0xaa, 0xe8, 0x76, 0xbc, 0xb2, 0xfb, 0xe2, 0x74, 0xb8, 0xc6, 0xf1, 0x6e, 0xbc, 0x09, 0x2b, 0xa3,
+	0xfd, 0x28, 0xcc, 0x2e, 0x01, 0xea, 0x21, 0xc8, 0xb6, 0xe1, 0xea, 0x40, 0xd7, 0xe1, 0x55, 0x58,
+	0xaa, 0xec, 0xbe, 0x57, 0x28, 0x7a, 0xa5, 0xbc, 0xe3, 0x7a, 0xe5, 0x4a, 0xbe, 0xb2, 0x57, 0xf6,
+	0xf6, 0x8a, 0xe5, 0x52, 0x61, 0xcb, 0xd9, 0x71, 0x0a, 0xdb, 0xb3, 0x29, 0xbc, 0x04, 0xd7, 0x86,
+	0x4d, 0x0a, 0xc5, 0xbc, 0xfd, 0x7e, 0x61, 0x7b, 0x16, 0x61, 0x1d, 0xb4, 0xe1, 0xe3, 0x6d, 0xa7,
+	0x2c, 0xcf, 0x27, 0xb4, 0xc9, 0xaf, 0xbe, 0xd7, 0x53, 0x59, 0x0e, 0x57, 0x07, 0x5a, 0x76, 0x20,
+	0xf4, 0xae, 0xeb, 0x3c, 0x70, 0x8a, 0x03, 0xa1, 0xaf, 0xc1, 0xc2, 0xb0, 0x89, 0x63, 0x6f, 0xcd,
+	0x22, 0x7c, 0x03, 0x16, 0x87, 0x8f, 0x8a, 0xf9, 0x8a, 0xf3, 0x61, 0xa1, 0x13, 0x34, 0xf7, 0xef,
+	0x0b, 0x70, 0x59, 0x94, 0x0a, 0x7f, 0x83, 0x00, 0x4e, 0x3b, 0x19, 0xdf, 0x4a, 0x6c, 0xd2, 0xe4,
+	0xb7, 0x85, 0x76, 0x7b, 0x3c, 0x63, 0x59, 0x79, 0x23, 0xf3, 0xe5, 0xef, 0x7f, 0x7f, 0x3b, 0x61,
+	0xe0, 0x15, 0x2b, 0xe9, 0x0d, 0xd5, 0xf3, 0x7b, 0xc1, 0xdf, 0x21, 0x48, 0x77, 0x1d, 0xe0, 0xec,
+	0x18, 0x51, 0x3a, 0x44, 0xb7, 0xc6, 0xb2, 0x55, 0x40, 0xeb, 0x02, 0x28, 0x8b, 0x33, 0xe7, 0x01,
+	0x59, 0x4f, 0xc4, 0xe2, 0x08, 0x7f, 0x81, 0x60, 0x4a, 0xce, 0x50, 0x7c, 0x73, 0x74, 0xa4, 0xbe,
+	0x81, 0xad, 0x65, 0xce, 0x37, 0x54, 0x3c, 0x6b, 0x82, 0x67, 0x09, 0x5f, 0x4f, 0xe4, 0x91, 0x83,
+	0x1a, 0xff, 0x82, 0x00, 0x0f, 0x4f, 0x1e, 0x7c, 0x67, 0x9c, 0xab, 0x18, 0x98, 0xe8, 0xda, 0xdd,
+	0x8b, 0x89, 0x14, 0x66, 0x4e, 0x60, 0xde, 0xc6, 0xd9, 0xf3, 0xca, 0xe6, 0x55, 0xdb, 0xde, 0x27,
+	0x12, 0xef, 0x37, 0x04, 0x0b, 0x89, 0xe3, 0x03, 0xdf, 0x1b, 0xcd, 0x70, 0xd6, 0xa0, 0xd2, 0xee,
+	0x5f, 0x58, 0xa7, 0xf0, 0x37, 0x05, 0xfe, 0x3d, 0x7c, 0x37, 0x11, 0x3f, 0xa8, 0xd6, 0xbc, 0x78,
+	0xe8, 0x51, 0x6e, 0x3d, 0x89, 0xff, 0x1f, 0x59, 0x7d, 0x63, 0x27, 0x4e, 0x64, 0x2e, 0x61, 0xbc,
+	0xe0, 0x33, 0x4a, 0x39, 0x7a, 0xaa, 0x69, 0x6f, 0x5c, 0x50, 0xa5, 0x52, 0xb0, 0x45, 0x0a, 0x9b,
+	0xf8, 0x2d, 0x6b, 0xe4, 0xc7, 0x60, 0x07, 0x38, 0xce, 0xa3, 0x6f, 0xe3, 0xc8, 0x12, 0x13, 0xcf,
+	0x7e, 0xfb, 0xe9, 0xb1, 0x8e, 0x9e, 0x1d, 0xeb, 0xe8, 0xaf, 0x63, 0x1d, 0x7d, 0x7d, 0xa2, 0xa7,
+	0x9e, 0x9d, 0xe8, 0xa9, 0x3f, 0x4e, 0xf4, 0xd4, 0x47, 0x6b, 0x7e, 0x10, 0x1d, 0x1c, 0x56, 0xcd,
+	0x1a, 0xab, 0xf7, 0xfa, 0xff, 0x5c, 0x45, 0x88, 0xda, 0x0d, 0xca, 0xab, 0x53, 0xe2, 0x4b, 0xf2,
+	0xce, 0x7f, 0x03, 0x00, 0x43, 0x78, 0x23, 0x91, 0x38, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -379,6 +810,15 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenPairsByFilter retrieves the registered token pairs that match the
+	// given owner, status and origin filters
+	TokenPairsByFilter(ctx context.Context, in *QueryTokenPairsByFilterRequest, opts ...grpc.CallOption) (*QueryTokenPairsByFilterResponse, error)
+	// ERC20AddressByIBCHash retrieves the ERC20 contract address of the token
+	// pair registered for the given IBC denomination hash
+	ERC20AddressByIBCHash(ctx context.Context, in *QueryERC20AddressByIBCHashRequest, opts ...grpc.CallOption) (*QueryERC20AddressByIBCHashResponse, error)
+	// DenomByERC20Address retrieves the Cosmos denomination of the token pair
+	// registered for the given ERC20 contract or precompile address
+	DenomByERC20Address(ctx context.Context, in *QueryDenomByERC20AddressRequest, opts ...grpc.CallOption) (*QueryDenomByERC20AddressResponse, error)
 }
 
 type queryClient struct {
@@ -416,6 +856,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenPairsByFilter(ctx context.Context, in *QueryTokenPairsByFilterRequest, opts ...grpc.CallOption) (*QueryTokenPairsByFilterResponse, error) {
+	out := new(QueryTokenPairsByFilterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/TokenPairsByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20AddressByIBCHash(ctx context.Context, in *QueryERC20AddressByIBCHashRequest, opts ...grpc.CallOption) (*QueryERC20AddressByIBCHashResponse, error) {
+	out := new(QueryERC20AddressByIBCHashResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/ERC20AddressByIBCHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomByERC20Address(ctx context.Context, in *QueryDenomByERC20AddressRequest, opts ...grpc.CallOption) (*QueryDenomByERC20AddressResponse, error) {
+	out := new(QueryDenomByERC20AddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/DenomByERC20Address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs
@@ -424,6 +891,15 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenPairsByFilter retrieves the registered token pairs that match the
+	// given owner, status and origin filters
+	TokenPairsByFilter(context.Context, *QueryTokenPairsByFilterRequest) (*QueryTokenPairsByFilterResponse, error)
+	// ERC20AddressByIBCHash retrieves the ERC20 contract address of the token
+	// pair registered for the given IBC denomination hash
+	ERC20AddressByIBCHash(context.Context, *QueryERC20AddressByIBCHashRequest) (*QueryERC20AddressByIBCHashResponse, error)
+	// DenomByERC20Address retrieves the Cosmos denomination of the token pair
+	// registered for the given ERC20 contract or precompile address
+	DenomByERC20Address(context.Context, *QueryDenomByERC20AddressRequest) (*QueryDenomByERC20AddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -439,6 +915,15 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TokenPairsByFilter(ctx context.Context, req *QueryTokenPairsByFilterRequest) (*QueryTokenPairsByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairsByFilter not implemented")
+}
+func (*UnimplementedQueryServer) ERC20AddressByIBCHash(ctx context.Context, req *QueryERC20AddressByIBCHashRequest) (*QueryERC20AddressByIBCHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20AddressByIBCHash not implemented")
+}
+func (*UnimplementedQueryServer) DenomByERC20Address(ctx context.Context, req *QueryDenomByERC20AddressRequest) (*QueryDenomByERC20AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomByERC20Address not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -498,32 +983,99 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.evm.erc20.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TokenPairs",
-			Handler:    _Query_TokenPairs_Handler,
-		},
-		{
-			MethodName: "TokenPair",
-			Handler:    _Query_TokenPair_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/evm/erc20/v1/query.proto",
-}
-
-func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TokenPairsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/TokenPairsByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairsByFilter(ctx, req.(*QueryTokenPairsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20AddressByIBCHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20AddressByIBCHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20AddressByIBCHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/ERC20AddressByIBCHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20AddressByIBCHash(ctx, req.(*QueryERC20AddressByIBCHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomByERC20Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomByERC20AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomByERC20Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/DenomByERC20Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomByERC20Address(ctx, req.(*QueryDenomByERC20AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.erc20.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TokenPairs",
+			Handler:    _Query_TokenPairs_Handler,
+		},
+		{
+			MethodName: "TokenPair",
+			Handler:    _Query_TokenPair_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TokenPairsByFilter",
+			Handler:    _Query_TokenPairsByFilter_Handler,
+		},
+		{
+			MethodName: "ERC20AddressByIBCHash",
+			Handler:    _Query_ERC20AddressByIBCHash_Handler,
+		},
+		{
+			MethodName: "DenomByERC20Address",
+			Handler:    _Query_DenomByERC20Address_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/erc20/v1/query.proto",
+}
+
+func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -722,6 +1274,232 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTokenPairsByFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsByFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairsByFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsByFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsByFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20AddressByIBCHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20AddressByIBCHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20AddressByIBCHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20AddressByIBCHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20AddressByIBCHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20AddressByIBCHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomByERC20AddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomByERC20AddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomByERC20AddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomByERC20AddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomByERC20AddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomByERC20AddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -793,29 +1571,630 @@ func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenPairsByFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractOwner != 0 {
+		n += 1 + sovQuery(uint64(m.ContractOwner))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsByFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20AddressByIBCHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20AddressByIBCHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomByERC20AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomByERC20AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenPairsByFilterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -838,13 +2217,70 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenPairsByFilterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenPairsByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TokenPairStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= TokenPairOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -901,7 +2337,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenPairsByFilterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,10 +2360,10 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenPairsByFilterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenPairsByFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1021,7 +2457,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryERC20AddressByIBCHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,15 +2480,15 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryERC20AddressByIBCHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryERC20AddressByIBCHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1080,7 +2516,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1103,7 +2539,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryERC20AddressByIBCHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,17 +2562,17 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryERC20AddressByIBCHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryERC20AddressByIBCHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1146,24 +2582,55 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1186,7 +2653,7 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomByERC20AddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1209,12 +2676,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomByERC20AddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomByERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1236,7 +2735,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomByERC20AddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1259,17 +2758,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomByERC20AddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomByERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1279,24 +2778,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -29,6 +31,24 @@ func NewTokenPairSTRv2(denom string) (TokenPair, error) {
 	}, nil
 }
 
+// NewNativeTokenPair creates a new module-owned TokenPair instance for a native
+// Cosmos coin (i.e. not an IBC voucher).
+//
+// It derives the ERC-20 address deterministically from the hash of the
+// denomination (see utils.GetNativeDenomAddress).
+func NewNativeTokenPair(denom string) (TokenPair, error) {
+	address, err := utils.GetNativeDenomAddress(denom)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	return TokenPair{
+		Erc20Address:  address.String(),
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: OWNER_MODULE,
+	}, nil
+}
+
 // NewTokenPair returns an instance of TokenPair
 func NewTokenPair(erc20Address common.Address, denom string, contractOwner Owner) TokenPair {
 	return TokenPair{
@@ -69,3 +89,37 @@ func (tp TokenPair) IsNativeCoin() bool {
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// IsIBCVoucher returns true if the Cosmos coin of the token pair is an IBC
+// voucher (i.e. its denomination has the "ibc/" prefix).
+func (tp TokenPair) IsIBCVoucher() bool {
+	return strings.HasPrefix(tp.Denom, "ibc/")
+}
+
+// MatchesFilter returns true if the token pair matches the given owner, status
+// and origin filters. Unspecified filters match any token pair.
+func (tp TokenPair) MatchesFilter(owner Owner, status TokenPairStatus, origin TokenPairOrigin) bool {
+	if owner != OWNER_UNSPECIFIED && tp.ContractOwner != owner {
+		return false
+	}
+
+	switch status {
+	case TOKEN_PAIR_STATUS_ENABLED:
+		if !tp.Enabled {
+			return false
+		}
+	case TOKEN_PAIR_STATUS_DISABLED:
+		if tp.Enabled {
+			return false
+		}
+	}
+
+	switch origin {
+	case TOKEN_PAIR_ORIGIN_IBC:
+		return tp.IsIBCVoucher()
+	case TOKEN_PAIR_ORIGIN_NATIVE:
+		return !tp.IsIBCVoucher()
+	}
+
+	return true
+}
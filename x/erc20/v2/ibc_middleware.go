@@ -86,13 +86,18 @@ func (im IBCMiddleware) OnRecvPacket(
 			Status: channeltypesv2.PacketStatus_Failure,
 		}
 	}
-	im.keeper.OnRecvPacket(ctx, packet, ack)
+	if ack := im.keeper.OnRecvPacket(ctx, packet, ack); !ack.Success() {
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
 	return recvResult
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// Cosmos Coin to their ERC20 token representation. The contract that sent the
+// packet through the ICS20 precompile (if any) is notified as for v1 packets.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	sourceClient string,
@@ -131,7 +136,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 
 // OnTimeoutPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// Cosmos Coin to their ERC20 token representation. The contract that sent the
+// packet through the ICS20 precompile (if any) is notified as for v1 packets.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	sourceClient string,
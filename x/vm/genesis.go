@@ -57,6 +57,10 @@ func InitGenesis(
 		}
 	}
 
+	for _, fg := range data.FeeGranters {
+		k.SetFeeGranter(ctx, common.HexToAddress(fg.Contract), common.HexToAddress(fg.Granter))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -77,7 +81,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:    ethGenAccounts,
+		Params:      k.GetParams(ctx),
+		FeeGranters: k.GetFeeGranters(ctx),
 	}
 }
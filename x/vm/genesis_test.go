@@ -16,6 +16,7 @@ import (
 	testhandler "github.com/cosmos/evm/testutil/integration/os/grpc"
 	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
 	testnetwork "github.com/cosmos/evm/testutil/integration/os/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
@@ -248,3 +249,31 @@ func TestExportGenesis(t *testing.T) {
 	require.Contains(t, genAddresses, contractAddr2.Hex(), "expected contract 2 address in exported genesis")
 	require.Contains(t, genAddresses, testconstants.WEVMOSContractMainnet, "expected mainnet aedgens contract address in exported genesis")
 }
+
+func TestGenesisFeeGranters(t *testing.T) {
+	ts := SetupTest()
+	ctx := ts.network.GetContext()
+
+	contract, contract2 := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	granter := ts.keyring.GetAddr(0)
+	ts.network.App.EVMKeeper.SetFeeGranter(ctx, contract, granter)
+	ts.network.App.EVMKeeper.SetFeeGranter(ctx, contract2, granter)
+
+	genState := vm.ExportGenesis(ctx, ts.network.App.EVMKeeper)
+	require.ElementsMatch(t, []types.FeeGranter{
+		types.NewFeeGranter(contract, granter),
+		types.NewFeeGranter(contract2, granter),
+	}, genState.FeeGranters)
+	require.NoError(t, genState.Validate())
+
+	// import the exported fee granters on a fresh chain
+	ts = SetupTest()
+	ctx = ts.network.GetContext()
+	_ = vm.InitGenesis(ctx, ts.network.App.EVMKeeper, ts.network.App.AccountKeeper, *genState)
+
+	for _, addr := range []common.Address{contract, contract2} {
+		feeGranter, found := ts.network.App.EVMKeeper.GetFeeGranter(ctx, addr)
+		require.True(t, found, "fee granter not imported for contract %s", addr)
+		require.Equal(t, granter, feeGranter)
+	}
+}
@@ -10,7 +10,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock emits a base fee event which will be adjusted to the evm decimals and
+// collects the system logs of the slashing and jailing events emitted by the modules
+// that ran before it.
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
@@ -31,16 +33,24 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 			),
 		})
 	}
+
+	k.CollectSystemLogs(ctx, ctx.EventManager().Events())
 	return nil
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock emits the system logs collected during the block, including the unbonding
+// completions of the modules that ran before it, and also retrieves the bloom filter
+// value from the transient store and commits it to the KVStore. The EVM end block logic
+// doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
+	k.CollectSystemLogs(infCtx, ctx.EventManager().Events())
+	if err := k.EmitSystemLogs(infCtx); err != nil {
+		return err
+	}
+
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
@@ -1,9 +1,19 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
 	"github.com/cosmos/evm/testutil/integration/os/network"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlock() {
@@ -23,3 +33,102 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(postEventManager.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, postEventManager.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestEndBlockSystemLogs() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	ctx := unitNetwork.GetContext()
+
+	validator := unitNetwork.GetValidators()[0]
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	suite.Require().NoError(err)
+
+	// jailing the validator emits a slash event collected as a system log
+	err = unitNetwork.App.SlashingKeeper.Jail(ctx, consAddr)
+	suite.Require().NoError(err)
+	unitNetwork.App.EVMKeeper.CollectSystemLogs(ctx, ctx.EventManager().Events())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = unitNetwork.App.EVMKeeper.EndBlock(ctx)
+	suite.Require().NoError(err)
+
+	// should emit the EventTypeSystemLog event before the EventTypeBlockBloom event
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(2, len(events))
+	suite.Require().Equal(evmtypes.EventTypeSystemLog, events[0].Type)
+	suite.Require().Equal(evmtypes.EventTypeBlockBloom, events[1].Type)
+
+	suite.Require().Equal(1, len(events[0].Attributes))
+	var log evmtypes.Log
+	err = json.Unmarshal([]byte(events[0].Attributes[0].Value), &log)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(evmtypes.SystemLogsAddress.Hex(), log.Address)
+	suite.Require().Equal([]string{
+		evmtypes.ValidatorJailedEvent.ID.String(),
+		common.BytesToHash(valAddr.Bytes()).String(),
+	}, log.Topics)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), log.BlockNumber) //nolint:gosec // G115
+
+	// the system log is added to the block bloom
+	bloom := ethtypes.BytesToBloom([]byte(events[1].Attributes[0].Value))
+	suite.Require().True(bloom.Test(evmtypes.SystemLogsAddress.Bytes()))
+	suite.Require().True(bloom.Test(evmtypes.ValidatorJailedEvent.ID.Bytes()))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockSystemLogs() {
+	unitNetwork := network.NewUnitTestNetwork()
+	ctx := unitNetwork.GetContext()
+
+	validator := unitNetwork.GetValidators()[0]
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	suite.Require().NoError(err)
+	power := validator.GetConsensusPower(unitNetwork.App.StakingKeeper.PowerReduction(ctx))
+
+	// the evidence BeginBlocker jails the validator that double signed, which is
+	// collected as a system log by the EVM BeginBlocker that runs after it on a
+	// chain started from genesis
+	res, err := unitNetwork.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:          ctx.BlockHeight() + 1,
+		Time:            ctx.BlockTime().Add(time.Second),
+		ProposerAddress: ctx.BlockHeader().ProposerAddress,
+		Misbehavior: []abci.Misbehavior{
+			{
+				Type:             abci.MisbehaviorType_DUPLICATE_VOTE,
+				Validator:        abci.Validator{Address: consAddr, Power: power},
+				Height:           ctx.BlockHeight(),
+				Time:             ctx.BlockTime(),
+				TotalVotingPower: power,
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	var jailedLogs []evmtypes.Log
+	for _, event := range res.Events {
+		if event.Type != evmtypes.EventTypeSystemLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+			var log evmtypes.Log
+			err = json.Unmarshal([]byte(attr.Value), &log)
+			suite.Require().NoError(err)
+			if log.Topics[0] == evmtypes.ValidatorJailedEvent.ID.String() {
+				jailedLogs = append(jailedLogs, log)
+			}
+		}
+	}
+
+	suite.Require().Len(jailedLogs, 1)
+	suite.Require().Equal(evmtypes.SystemLogsAddress.Hex(), jailedLogs[0].Address)
+	suite.Require().Equal(common.BytesToHash(valAddr.Bytes()).String(), jailedLogs[0].Topics[1])
+}
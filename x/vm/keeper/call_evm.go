@@ -50,6 +50,39 @@ func (k Keeper) CallEVMWithData(
 	contract *common.Address,
 	data []byte,
 	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	res, err := k.callEVMWithData(ctx, from, contract, data, commit, 0)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CallEVMWithDataAndGasLimit performs a smart contract method call using
+// contract data, committing the state changes. The execution is limited to the
+// given amount of gas.
+//
+// NOTE: the response is also returned when the call fails in the EVM, so that
+// the caller can charge the gas used by the call.
+func (k Keeper) CallEVMWithDataAndGasLimit(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	gasLimit uint64,
+) (*types.MsgEthereumTxResponse, error) {
+	return k.callEVMWithData(ctx, from, contract, data, true, gasLimit)
+}
+
+// callEVMWithData performs a smart contract method call using contract data.
+// If the gas limit is zero, the gas of committed calls is estimated.
+func (k Keeper) callEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+	gasLimit uint64,
 ) (*types.MsgEthereumTxResponse, error) {
 	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
 	if err != nil {
@@ -57,7 +90,10 @@ func (k Keeper) CallEVMWithData(
 	}
 
 	gasCap := config.DefaultGasCap
-	if commit {
+	switch {
+	case gasLimit > 0:
+		gasCap = gasLimit
+	case commit:
 		args, err := json.Marshal(types.TransactionArgs{
 			From: &from,
 			To:   contract,
@@ -96,7 +132,7 @@ func (k Keeper) CallEVMWithData(
 	}
 
 	if res.Failed() {
-		return nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+		return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
 	}
 
 	return res, nil
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFeeGranter registers the fee granter that sponsors the fees of the Ethereum
+// transactions calling the given contract.
+func (k Keeper) SetFeeGranter(ctx sdk.Context, contract, granter common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeGranter)
+	store.Set(contract.Bytes(), granter.Bytes())
+}
+
+// GetFeeGranter returns the fee granter registered for the given contract.
+func (k Keeper) GetFeeGranter(ctx sdk.Context, contract common.Address) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeGranter)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// GetFeeGranters returns all the fee granters registered for the contracts.
+func (k Keeper) GetFeeGranters(ctx sdk.Context) []types.FeeGranter {
+	feeGranters := []types.FeeGranter{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeGranter)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract := common.BytesToAddress(iterator.Key())
+		granter := common.BytesToAddress(iterator.Value())
+		feeGranters = append(feeGranters, types.NewFeeGranter(contract, granter))
+	}
+
+	return feeGranters
+}
+
+// DeleteFeeGranter removes the fee granter registered for the given contract.
+func (k Keeper) DeleteFeeGranter(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeGranter)
+	store.Delete(contract.Bytes())
+}
+
+// SetTransientFeePayer sets the account that paid the fees of the given Ethereum
+// transaction when it differs from the sender, so that the leftover gas is refunded
+// to it. This value is reset on every block.
+func (k Keeper) SetTransientFeePayer(ctx sdk.Context, txHash common.Hash, feePayer common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), feePayer.Bytes())
+}
+
+// GetTransientFeePayer returns the account that paid the fees of the given Ethereum
+// transaction, if it is not the sender.
+func (k Keeper) GetTransientFeePayer(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
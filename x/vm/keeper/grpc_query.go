@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -179,6 +180,59 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageRoot implements the Query/StorageRoot gRPC method. The iteration is
+// capped at MaxStorageRootSlots storage slots.
+func (k Keeper) StorageRoot(c context.Context, req *types.QueryStorageRootRequest) (*types.QueryStorageRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+
+	// NOTE: the trie is built over the raw storage keys and values, so the root
+	// is a commitment to the account storage but not the Ethereum MPT root.
+	var (
+		slots   int
+		tooMany bool
+		trieErr error
+	)
+	sr := trie.NewStackTrie(nil)
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		if slots == types.MaxStorageRootSlots {
+			tooMany = true
+			return false
+		}
+		slots++
+		if trieErr = sr.Update(key.Bytes(), value.Bytes()); trieErr != nil {
+			return false
+		}
+		return true
+	})
+
+	if tooMany {
+		return nil, status.Errorf(
+			codes.ResourceExhausted,
+			"account %s has more than %d storage slots", address, types.MaxStorageRootSlots,
+		)
+	}
+	if trieErr != nil {
+		return nil, status.Error(codes.Internal, trieErr.Error())
+	}
+
+	return &types.QueryStorageRootResponse{
+		StorageRoot: sr.Hash().Hex(),
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -8,16 +8,19 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
 
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/os/factory"
 	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
 	"github.com/cosmos/evm/testutil/integration/os/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm/keeper/testdata"
 	"github.com/cosmos/evm/x/vm/statedb"
@@ -313,6 +316,98 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryStorageRoot() {
+	testCases := []struct {
+		msg           string
+		getReqAndResp func() (*types.QueryStorageRootRequest, *types.QueryStorageRootResponse)
+		expPass       bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryStorageRootRequest, *types.QueryStorageRootResponse) {
+				req := &types.QueryStorageRootRequest{
+					Address: invalidAddress,
+				}
+				return req, nil
+			},
+			false,
+		},
+		{
+			"success - empty storage",
+			func() (*types.QueryStorageRootRequest, *types.QueryStorageRootResponse) {
+				req := &types.QueryStorageRootRequest{
+					Address: utiltx.GenerateAddress().String(),
+				}
+				return req, &types.QueryStorageRootResponse{
+					StorageRoot: ethtypes.EmptyRootHash.String(),
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() (*types.QueryStorageRootRequest, *types.QueryStorageRootResponse) {
+				key := common.BytesToHash([]byte("key"))
+				value := []byte("value")
+
+				newIndex := suite.keyring.AddKey()
+				addr := suite.keyring.GetAddr(newIndex)
+
+				suite.network.App.EVMKeeper.SetState(
+					suite.network.GetContext(),
+					addr,
+					key,
+					value,
+				)
+
+				expRoot := trie.NewStackTrie(nil)
+				suite.Require().NoError(expRoot.Update(key.Bytes(), common.BytesToHash(value).Bytes()))
+
+				req := &types.QueryStorageRootRequest{
+					Address: addr.String(),
+				}
+				return req, &types.QueryStorageRootResponse{
+					StorageRoot: expRoot.Hash().String(),
+				}
+			},
+			true,
+		},
+		{
+			"fail - too many storage slots",
+			func() (*types.QueryStorageRootRequest, *types.QueryStorageRootResponse) {
+				addr := utiltx.GenerateAddress()
+				ctx := suite.network.GetContext()
+				for i := 0; i <= types.MaxStorageRootSlots; i++ {
+					suite.network.App.EVMKeeper.SetState(ctx, addr, common.BigToHash(big.NewInt(int64(i))), []byte{1})
+				}
+
+				req := &types.QueryStorageRootRequest{
+					Address: addr.String(),
+				}
+				return req, nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req, expectedResp := tc.getReqAndResp()
+
+			ctx := suite.network.GetContext()
+			res, err := suite.network.GetEvmClient().StorageRoot(ctx, req)
+
+			suite.Require().Equal(expectedResp, res)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
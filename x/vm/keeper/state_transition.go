@@ -247,8 +247,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	if msg.GasLimit > res.GasUsed {
 		remainingGas = msg.GasLimit - res.GasUsed
 	}
-	if err = k.RefundGas(ctx, *msg, remainingGas, evmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
+	// sponsored transactions refund the leftover gas to the fee granter that paid for it
+	refundMsg := *msg
+	if feePayer, found := k.GetTransientFeePayer(ctx, tx.Hash()); found {
+		refundMsg.From = feePayer
+	}
+	if err = k.RefundGas(ctx, refundMsg, remainingGas, evmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", refundMsg.From)
 	}
 
 	if len(logs) > 0 {
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CollectSystemLogs converts the slashing, jailing and unbonding completion events
+// emitted by the modules that ran before the EVM module in the current BeginBlock
+// or EndBlock into system logs, and stores them until the end of the block.
+// Events that cannot be converted are skipped.
+func (k Keeper) CollectSystemLogs(ctx sdk.Context, events sdk.Events) {
+	var logs []*types.Log
+	for _, event := range events {
+		var (
+			eventLogs []*types.Log
+			err       error
+		)
+
+		switch event.Type {
+		case slashingtypes.EventTypeSlash:
+			eventLogs, err = k.slashSystemLogs(ctx, event)
+		case stakingtypes.EventTypeCompleteUnbonding:
+			eventLogs, err = k.completeUnbondingSystemLogs(ctx, event)
+		default:
+			continue
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error("failed to convert event into system log", "event", event.Type, "error", err.Error())
+			continue
+		}
+		logs = append(logs, eventLogs...)
+	}
+
+	if len(logs) == 0 {
+		return
+	}
+
+	k.SetTransientSystemLogs(ctx, append(k.GetTransientSystemLogs(ctx), logs...))
+}
+
+// EmitSystemLogs emits the system logs collected during the block after the logs of
+// the Ethereum transactions, as if they were emitted by an additional transaction at
+// the end of the block, and adds them to the block bloom filter.
+func (k Keeper) EmitSystemLogs(ctx sdk.Context) error {
+	logs := k.GetTransientSystemLogs(ctx)
+	if len(logs) == 0 {
+		return nil
+	}
+
+	txIndex := k.GetTxIndexTransient(ctx)
+	logIndex := k.GetLogSizeTransient(ctx)
+	blockHash := common.BytesToHash(ctx.HeaderHash())
+
+	attrs := make([]sdk.Attribute, len(logs))
+	for i, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight()) //nolint:gosec // G115 // won't exceed uint64
+		log.BlockHash = blockHash.Hex()
+		log.TxHash = common.Hash{}.Hex()
+		log.TxIndex = txIndex
+		log.Index = logIndex + uint64(i)
+
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode system log")
+		}
+		attrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	bloom := k.GetBlockBloomTransient(ctx)
+	bloom.Or(bloom, new(big.Int).SetBytes(ethtypes.CreateBloom(&ethtypes.Receipt{Logs: types.LogsToEthereum(logs)}).Bytes()))
+	k.SetBlockBloomTransient(ctx, bloom)
+	k.SetLogSizeTransient(ctx, logIndex+uint64(len(logs)))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSystemLog, attrs...))
+	return nil
+}
+
+// GetTransientSystemLogs returns the system logs collected during the current block.
+func (k Keeper) GetTransientSystemLogs(ctx sdk.Context) []*types.Log {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSystemLogs)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))) //nolint:gosec // G115 // won't exceed uint64
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var txLogs types.TransactionLogs
+	k.cdc.MustUnmarshal(bz, &txLogs)
+	return txLogs.Logs
+}
+
+// SetTransientSystemLogs sets the system logs collected during the current block.
+// This value is reset on every block.
+func (k Keeper) SetTransientSystemLogs(ctx sdk.Context, logs []*types.Log) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSystemLogs)
+	bz := k.cdc.MustMarshal(&types.TransactionLogs{Logs: logs})
+	store.Set(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), bz) //nolint:gosec // G115 // won't exceed uint64
+}
+
+// slashSystemLogs converts a slashing module event into the ValidatorSlashed and
+// ValidatorJailed system logs. The downtime slashing emits a single event for both.
+func (k Keeper) slashSystemLogs(ctx sdk.Context, event sdk.Event) ([]*types.Log, error) {
+	attrs := eventAttributes(event)
+
+	var logs []*types.Log
+	if consAddr, ok := attrs[slashingtypes.AttributeKeyAddress]; ok {
+		validator, err := k.validatorHexAddress(ctx, consAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		power := parseInt(attrs[slashingtypes.AttributeKeyPower])
+		burned := parseInt(attrs[slashingtypes.AttributeKeyBurnedCoins])
+		log, err := types.NewSystemLog(
+			types.ValidatorSlashedEvent,
+			[]common.Address{validator},
+			power.BigInt(), burned.BigInt(), attrs[slashingtypes.AttributeKeyReason],
+		)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	if consAddr, ok := attrs[slashingtypes.AttributeKeyJailed]; ok {
+		validator, err := k.validatorHexAddress(ctx, consAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		log, err := types.NewSystemLog(types.ValidatorJailedEvent, []common.Address{validator})
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+// completeUnbondingSystemLogs converts a staking module complete unbonding event
+// into an UnbondingCompleted system log.
+func (k Keeper) completeUnbondingSystemLogs(ctx sdk.Context, event sdk.Event) ([]*types.Log, error) {
+	attrs := eventAttributes(event)
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(attrs[stakingtypes.AttributeKeyValidator])
+	if err != nil {
+		return nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(attrs[stakingtypes.AttributeKeyDelegator])
+	if err != nil {
+		return nil, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+	if err != nil {
+		return nil, err
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := types.NewSystemLog(
+		types.UnbondingCompletedEvent,
+		[]common.Address{common.BytesToAddress(valAddr), common.BytesToAddress(delAddr)},
+		coins.AmountOf(bondDenom).BigInt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.Log{log}, nil
+}
+
+// validatorHexAddress returns the hex operator address of the validator with the
+// given bech32 consensus address.
+func (k Keeper) validatorHexAddress(ctx sdk.Context, consAddr string) (common.Address, error) {
+	addr, err := sdk.ConsAddressFromBech32(consAddr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, addr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(valAddr), nil
+}
+
+// eventAttributes returns the attributes of the event indexed by key.
+func eventAttributes(event sdk.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+// parseInt parses an integer event attribute, defaulting to zero.
+func parseInt(value string) math.Int {
+	i, ok := math.NewIntFromString(value)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return i
+}
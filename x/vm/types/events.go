@@ -5,6 +5,7 @@ const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
+	EventTypeSystemLog  = "system_log"
 	EventTypeFeeMarket  = "evm_fee_market"
 
 	AttributeKeyBaseFee         = "base_fee"
@@ -74,6 +74,12 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// allowed_cosmos_messages defines the type URLs of the Cosmos SDK messages
+	// that can be executed through the cosmos precompile
+	AllowedCosmosMessages []string `protobuf:"bytes,10,rep,name=allowed_cosmos_messages,json=allowedCosmosMessages,proto3" json:"allowed_cosmos_messages,omitempty"`
+	// allowed_stargate_queries defines the fully qualified gRPC method paths of
+	// the Cosmos SDK queries that can be executed through the stargate precompile
+	AllowedStargateQueries []string `protobuf:"bytes,11,rep,name=allowed_stargate_queries,json=allowedStargateQueries,proto3" json:"allowed_stargate_queries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -151,6 +157,20 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetAllowedCosmosMessages() []string {
+	if m != nil {
+		return m.AllowedCosmosMessages
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedStargateQueries() []string {
+	if m != nil {
+		return m.AllowedStargateQueries
+	}
+	return nil
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -327,7 +347,8 @@ type ChainConfig struct {
 	PragueTime *cosmossdk_io_math.Int `protobuf:"bytes,29,opt,name=prague_time,json=pragueTime,proto3,customtype=cosmossdk.io/math.Int" json:"prague_time,omitempty" yaml:"prague_time"`
 	// verkle_time: Verkle switch time (nil = no fork, 0 = already on verkle)
 	VerkleTime *cosmossdk_io_math.Int `protobuf:"bytes,30,opt,name=verkle_time,json=verkleTime,proto3,customtype=cosmossdk.io/math.Int" json:"verkle_time,omitempty" yaml:"verkle_time"`
-	OsakaTime  *cosmossdk_io_math.Int `protobuf:"bytes,31,opt,name=osaka_time,json=osakaTime,proto3,customtype=cosmossdk.io/math.Int" json:"osaka_time,omitempty" yaml:"osaka_time"`
+	// osaka_time: Osaka switch time (nil = no fork, 0 = already on osaka)
+	OsakaTime *cosmossdk_io_math.Int `protobuf:"bytes,31,opt,name=osaka_time,json=osakaTime,proto3,customtype=cosmossdk.io/math.Int" json:"osaka_time,omitempty" yaml:"osaka_time"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
@@ -875,129 +896,132 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1953 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xd5, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0xed, 0x68, 0xf9, 0xee,
-	0xdb, 0x0b, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xc3, 0xe9, 0x07, 0x44, 0x89, 0x69, 0xa5, 0xca,
-	0x8e, 0x30, 0x54, 0x12, 0xa4, 0x68, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x1b, 0xee, 0xee, 0x10, 0x3b,
-	0x4b, 0x86, 0xea, 0x2f, 0x08, 0x7c, 0x95, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0x93, 0x4b, 0xff, 0x84,
-	0x5e, 0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0xfd, 0x82, 0x62,
-	0x3e, 0xf8, 0x29, 0x85, 0x55, 0x01, 0xc1, 0x9e, 0xe7, 0xcc, 0x39, 0xcf, 0x73, 0x66, 0xe6, 0xec,
-	0xce, 0x59, 0x82, 0x8a, 0x43, 0x59, 0x48, 0xd9, 0x2e, 0xe9, 0x85, 0xbb, 0xfc, 0x6f, 0x8f, 0x8f,
-	0x76, 0x3a, 0x31, 0x4d, 0x28, 0x34, 0xe4, 0xdc, 0x0e, 0xb7, 0xf0, 0xbf, 0xbd, 0xca, 0x3d, 0x1c,
-	0xfa, 0x11, 0xdd, 0x15, 0xff, 0x4a, 0xa7, 0x4a, 0xc9, 0xa3, 0x1e, 0x15, 0xc3, 0x5d, 0x3e, 0x92,
-	0x56, 0xeb, 0x6d, 0x16, 0x2c, 0x9f, 0xe3, 0x18, 0x87, 0x0c, 0xee, 0x81, 0x1c, 0xe9, 0x85, 0xb6,
-	0x4b, 0x22, 0x1a, 0x96, 0x33, 0xd5, 0xcc, 0x76, 0xae, 0x56, 0xba, 0x4e, 0x4d, 0xe3, 0x12, 0x87,
-	0xc1, 0x73, 0x6b, 0x34, 0x65, 0x21, 0x9d, 0xf4, 0xc2, 0x63, 0x3e, 0x84, 0x87, 0x00, 0x90, 0x7e,
-	0x12, 0x63, 0x9b, 0xf8, 0x1d, 0x56, 0xd6, 0xaa, 0xd9, 0xed, 0x6c, 0xcd, 0x1a, 0xa4, 0x66, 0xae,
-	0xce, 0xad, 0xf5, 0x93, 0x73, 0x76, 0x9d, 0x9a, 0xf7, 0x14, 0xc1, 0xc8, 0xd1, 0x42, 0x39, 0x01,
-	0xea, 0x7e, 0x87, 0xc1, 0x7d, 0xb0, 0x81, 0x83, 0x80, 0x7e, 0x69, 0x77, 0x23, 0x9e, 0x11, 0x71,
-	0x12, 0xe2, 0xda, 0x49, 0x9f, 0x95, 0x97, 0xaa, 0x99, 0x6d, 0x1d, 0xad, 0x8b, 0xc9, 0x4f, 0xc6,
-	0x73, 0x17, 0x7d, 0x1e, 0x53, 0xe0, 0xe9, 0x38, 0x2d, 0x1c, 0x45, 0x24, 0x60, 0xe5, 0x95, 0x6a,
-	0x76, 0x3b, 0x57, 0x5b, 0x1b, 0xa4, 0x66, 0xbe, 0xfe, 0xe9, 0x8b, 0x23, 0x65, 0x46, 0x79, 0xd2,
-	0x0b, 0x87, 0x00, 0xfe, 0x09, 0x14, 0xb1, 0xe3, 0x10, 0xc6, 0x6c, 0x87, 0x46, 0x49, 0x4c, 0x83,
-	0xb2, 0x5e, 0xcd, 0x6c, 0xe7, 0xf7, 0xcd, 0x9d, 0xd9, 0xcd, 0xdb, 0x39, 0x14, 0x7e, 0x47, 0xd2,
-	0xad, 0xb6, 0xf1, 0x5d, 0x6a, 0x2e, 0x0c, 0x52, 0x73, 0x75, 0xca, 0x8c, 0x56, 0xf1, 0x24, 0x84,
-	0xcf, 0xc1, 0x03, 0xec, 0x24, 0x7e, 0x8f, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0x9d, 0x98, 0x38,
-	0x34, 0xec, 0xf8, 0x01, 0x61, 0xe5, 0x1c, 0xcf, 0x0f, 0xdd, 0x97, 0x0e, 0x0d, 0x31, 0x7f, 0x3e,
-	0x9e, 0x7e, 0xfe, 0xf0, 0xf5, 0x0f, 0x6f, 0x1f, 0x6f, 0x4e, 0x9c, 0x6f, 0x9f, 0x9f, 0xb0, 0x3c,
-	0x95, 0x53, 0x4d, 0x5f, 0x34, 0xb2, 0xa7, 0x9a, 0x9e, 0x35, 0xb4, 0x53, 0x4d, 0x5f, 0x36, 0x56,
-	0xac, 0xbf, 0x64, 0xc0, 0x74, 0x2e, 0xf0, 0x10, 0x2c, 0x3b, 0x31, 0xc1, 0x09, 0x11, 0xc7, 0x96,
-	0xdf, 0xff, 0xff, 0xff, 0xb2, 0xa6, 0x8b, 0xcb, 0x0e, 0xa9, 0x69, 0x7c, 0x5d, 0x48, 0x05, 0xc2,
-	0x5f, 0x01, 0xcd, 0xc1, 0x41, 0x50, 0x5e, 0xfc, 0x5f, 0x09, 0x44, 0x98, 0xf5, 0xaf, 0x0c, 0xb8,
-	0x77, 0xc3, 0x03, 0x3a, 0x20, 0xaf, 0xf6, 0x3c, 0xb9, 0xec, 0xc8, 0xe4, 0x8a, 0xfb, 0x8f, 0x7e,
-	0x8c, 0x5b, 0x90, 0xfe, 0x64, 0x90, 0x9a, 0x60, 0x8c, 0xaf, 0x53, 0x13, 0xca, 0xf2, 0x99, 0x20,
-	0xb2, 0x10, 0xc0, 0x23, 0x0f, 0xe8, 0x80, 0xf5, 0xe9, 0x83, 0xb5, 0x03, 0x9f, 0x25, 0xe5, 0x45,
-	0x51, 0x13, 0x4f, 0x07, 0xa9, 0x39, 0x9d, 0xd8, 0x99, 0xcf, 0x92, 0xeb, 0xd4, 0xac, 0x4c, 0xb1,
-	0x4e, 0x46, 0x5a, 0xe8, 0x1e, 0x9e, 0x0d, 0xb0, 0xbe, 0x35, 0x40, 0xfe, 0xa8, 0x85, 0xfd, 0xe8,
-	0x88, 0x46, 0xaf, 0x7c, 0x0f, 0xfe, 0x11, 0xac, 0xb5, 0x68, 0x48, 0x58, 0x42, 0xb0, 0x6b, 0x37,
-	0x03, 0xea, 0xb4, 0xd5, 0x13, 0xf3, 0xf4, 0x9f, 0xa9, 0xb9, 0x21, 0x17, 0xc8, 0xdc, 0xf6, 0x8e,
-	0x4f, 0x77, 0x43, 0x9c, 0xb4, 0x76, 0x4e, 0x22, 0x2e, 0xba, 0x29, 0x45, 0x67, 0x22, 0x2d, 0x54,
-	0x1c, 0x59, 0x6a, 0xdc, 0x00, 0x5b, 0xa0, 0xe8, 0x62, 0x6a, 0xbf, 0xa2, 0x71, 0x5b, 0x91, 0x2f,
-	0x0a, 0xf2, 0xda, 0x8f, 0x92, 0x0f, 0x52, 0xb3, 0x70, 0x7c, 0xf8, 0xf1, 0x47, 0x34, 0x6e, 0x0b,
-	0x8a, 0xeb, 0xd4, 0xdc, 0x90, 0x62, 0xd3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdc, 0xe0, 0x67,
-	0xc0, 0x18, 0x39, 0xb0, 0x6e, 0xa7, 0x43, 0xe3, 0xa4, 0x9c, 0xe5, 0x0f, 0x5e, 0xed, 0x67, 0x83,
-	0xd4, 0x2c, 0x2a, 0xca, 0x86, 0x9c, 0xb9, 0x4e, 0xcd, 0xfb, 0x33, 0xa4, 0x2a, 0xc6, 0x42, 0x45,
-	0x45, 0xab, 0x5c, 0x61, 0x13, 0x14, 0x88, 0xdf, 0xd9, 0x3b, 0x78, 0xa2, 0x16, 0xa0, 0x89, 0x05,
-	0xfc, 0x66, 0xde, 0x02, 0xf2, 0xf5, 0x93, 0xf3, 0xbd, 0x83, 0x27, 0xc3, 0xfc, 0xd7, 0xd5, 0x6b,
-	0x63, 0x82, 0xc5, 0x42, 0x79, 0x09, 0x65, 0xf2, 0x43, 0x8d, 0x03, 0xa5, 0xb1, 0x7c, 0x57, 0x8d,
-	0x83, 0xdb, 0x34, 0x0e, 0xa6, 0x35, 0x0e, 0xa6, 0x35, 0x9e, 0x29, 0x8d, 0x95, 0xbb, 0x6a, 0x3c,
-	0xbb, 0x4d, 0xe3, 0xd9, 0xb4, 0x86, 0xf4, 0xe1, 0xc5, 0xd4, 0xbc, 0xfc, 0x33, 0x8e, 0x12, 0xbf,
-	0x1b, 0x2a, 0x19, 0xfd, 0xce, 0xc5, 0x34, 0x13, 0x69, 0xa1, 0xe2, 0xc8, 0x22, 0xd9, 0xdb, 0xa0,
-	0xe4, 0xd0, 0x88, 0x25, 0xdc, 0x16, 0xd1, 0x4e, 0x40, 0x94, 0x44, 0x4e, 0x48, 0x3c, 0x9b, 0x27,
-	0xf1, 0x50, 0x4a, 0xdc, 0x16, 0x6e, 0xa1, 0xf5, 0x69, 0xb3, 0x14, 0xb3, 0x81, 0xd1, 0x21, 0x09,
-	0x89, 0x59, 0xb3, 0x1b, 0x7b, 0x4a, 0x08, 0x08, 0xa1, 0x0f, 0xe6, 0x09, 0xa9, 0xb2, 0x9a, 0x0d,
-	0xb5, 0xd0, 0xda, 0xd8, 0x24, 0x05, 0x3e, 0x07, 0x45, 0x9f, 0xab, 0x36, 0xbb, 0x81, 0xa2, 0xcf,
-	0x0b, 0xfa, 0xfd, 0x79, 0xf4, 0xea, 0x51, 0x98, 0x0e, 0xb4, 0xd0, 0xea, 0xd0, 0x20, 0xa9, 0x5d,
-	0x00, 0xc3, 0xae, 0x1f, 0xdb, 0x5e, 0x80, 0x1d, 0x9f, 0xc4, 0x8a, 0xbe, 0x20, 0xe8, 0x7f, 0x3e,
-	0x8f, 0xfe, 0x81, 0xa4, 0xbf, 0x19, 0x6c, 0x21, 0x83, 0x1b, 0x7f, 0x2b, 0x6d, 0x52, 0xa5, 0x01,
-	0x0a, 0x4d, 0x12, 0x07, 0x7e, 0xa4, 0xf8, 0x57, 0x05, 0xff, 0x93, 0x79, 0xfc, 0xaa, 0x82, 0x26,
-	0xc3, 0x2c, 0x94, 0x97, 0x70, 0x44, 0x1a, 0xd0, 0xc8, 0xa5, 0x43, 0xd2, 0x7b, 0x77, 0x26, 0x9d,
-	0x0c, 0xb3, 0x50, 0x5e, 0x42, 0x49, 0xea, 0x81, 0x75, 0x1c, 0xc7, 0xf4, 0xcb, 0x99, 0x0d, 0x81,
-	0x82, 0xfb, 0x17, 0xf3, 0xb8, 0x87, 0x2f, 0xd7, 0x9b, 0xd1, 0xfc, 0xe5, 0xca, 0xad, 0x53, 0x5b,
-	0xe2, 0x02, 0xe8, 0xc5, 0xf8, 0x72, 0x46, 0xa7, 0x74, 0xe7, 0x8d, 0xbf, 0x19, 0x6c, 0x21, 0x83,
-	0x1b, 0xa7, 0x54, 0xbe, 0x00, 0xa5, 0x90, 0xc4, 0x1e, 0xb1, 0x23, 0x92, 0xb0, 0x4e, 0xe0, 0x27,
-	0x4a, 0x67, 0xe3, 0xce, 0xcf, 0xc1, 0x6d, 0xe1, 0x16, 0x82, 0xc2, 0xfc, 0x52, 0x59, 0xa5, 0xd6,
-	0x03, 0xa0, 0x3b, 0xfc, 0xb6, 0xb0, 0x7d, 0xb7, 0x5c, 0xae, 0x66, 0xb6, 0x35, 0xb4, 0x22, 0xf0,
-	0x89, 0x0b, 0x4b, 0x60, 0x49, 0x76, 0x58, 0x0f, 0xb8, 0x2e, 0x92, 0x00, 0x56, 0x80, 0xee, 0x12,
-	0xc7, 0x0f, 0x71, 0xc0, 0xca, 0x15, 0x11, 0x30, 0xc2, 0xf0, 0x53, 0xb0, 0xca, 0x5a, 0x38, 0xf2,
-	0x5a, 0xd8, 0xb7, 0x13, 0x3f, 0x24, 0xe5, 0x87, 0x22, 0xe3, 0xbd, 0x79, 0x19, 0x97, 0x64, 0xc6,
-	0x53, 0x71, 0x16, 0x2a, 0x0c, 0xf1, 0x85, 0x1f, 0x12, 0x78, 0x0e, 0xf2, 0x0e, 0x8e, 0x9c, 0x6e,
-	0x24, 0x59, 0x1f, 0x09, 0xd6, 0xdd, 0x79, 0xac, 0xea, 0x2a, 0x9e, 0x88, 0xb2, 0x10, 0x90, 0x68,
-	0xc8, 0xd8, 0x89, 0xb1, 0xd7, 0x25, 0x92, 0xf1, 0xbd, 0x3b, 0x33, 0x4e, 0x44, 0x59, 0x08, 0x48,
-	0x34, 0x64, 0xec, 0x91, 0xb8, 0x1d, 0x28, 0xc6, 0xad, 0x3b, 0x33, 0x4e, 0x44, 0x59, 0x08, 0x48,
-	0x24, 0x18, 0x5f, 0x00, 0x40, 0x19, 0x6e, 0x63, 0x49, 0x68, 0x0a, 0xc2, 0x9d, 0x79, 0x84, 0xaa,
-	0x7d, 0x1d, 0x07, 0x59, 0x28, 0x27, 0x00, 0xa7, 0x3b, 0xd5, 0xf4, 0x25, 0x63, 0xf9, 0x54, 0xd3,
-	0x37, 0x8d, 0xfb, 0xa7, 0x9a, 0x7e, 0xdf, 0x28, 0x5b, 0xbb, 0x60, 0x89, 0xb7, 0x78, 0x04, 0x1a,
-	0x20, 0xdb, 0x26, 0x97, 0xb2, 0x2f, 0x40, 0x7c, 0xc8, 0xcf, 0xbe, 0x87, 0x83, 0x2e, 0x91, 0xd7,
-	0x39, 0x92, 0xc0, 0x3a, 0x07, 0x6b, 0x17, 0x31, 0x8e, 0x18, 0x6f, 0x0f, 0x69, 0x74, 0x46, 0x3d,
-	0x06, 0x21, 0xd0, 0x5a, 0x98, 0xb5, 0x54, 0xac, 0x18, 0xc3, 0x9f, 0x02, 0x2d, 0xa0, 0x1e, 0x13,
-	0x8d, 0x4d, 0x7e, 0x7f, 0xe3, 0x66, 0x17, 0x75, 0x46, 0x3d, 0x24, 0x5c, 0xac, 0xbf, 0x2f, 0x82,
-	0xec, 0x19, 0xf5, 0x60, 0x19, 0xac, 0x60, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0x69, 0x08, 0xe1, 0x26,
-	0x58, 0x4e, 0x68, 0xc7, 0x77, 0x24, 0x5d, 0x0e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03,
-	0x14, 0x90, 0x18, 0xf3, 0x6e, 0x5b, 0x94, 0xba, 0x1d, 0x75, 0xc3, 0x26, 0x89, 0xc5, 0x55, 0xae,
-	0xd5, 0xd6, 0xae, 0x52, 0x33, 0x2f, 0xec, 0x2f, 0x85, 0x19, 0x4d, 0x02, 0xf8, 0x3e, 0x58, 0x49,
-	0xfa, 0xb6, 0x58, 0xc3, 0x92, 0xd8, 0xe2, 0xf5, 0xab, 0xd4, 0x5c, 0x4b, 0xc6, 0xcb, 0xfc, 0x1d,
-	0x66, 0x2d, 0xb4, 0x9c, 0xf4, 0xf9, 0xff, 0x70, 0x17, 0xe8, 0x49, 0xdf, 0xf6, 0x23, 0x97, 0xf4,
-	0xc5, 0x25, 0xae, 0xd5, 0x4a, 0x57, 0xa9, 0x69, 0x4c, 0xb8, 0x9f, 0xf0, 0x39, 0xb4, 0x92, 0xf4,
-	0xc5, 0x00, 0xbe, 0x0f, 0x80, 0x4c, 0x49, 0x28, 0xc8, 0x3b, 0x79, 0xf5, 0x2a, 0x35, 0x73, 0xc2,
-	0x2a, 0xb8, 0xc7, 0x43, 0x68, 0x81, 0x25, 0xc9, 0xad, 0x0b, 0xee, 0xc2, 0x55, 0x6a, 0xea, 0x01,
-	0xf5, 0x24, 0xa7, 0x9c, 0xe2, 0x5b, 0x15, 0x93, 0x90, 0xf6, 0x88, 0x2b, 0x2e, 0x46, 0x1d, 0x0d,
-	0xa1, 0xf5, 0xf5, 0x22, 0xd0, 0x2f, 0xfa, 0x88, 0xb0, 0x6e, 0x90, 0xc0, 0x8f, 0x80, 0x21, 0x7a,
-	0x45, 0xec, 0x24, 0xf6, 0xd4, 0xd6, 0xd6, 0x1e, 0x8e, 0xaf, 0xb1, 0x59, 0x0f, 0x0b, 0xad, 0x0d,
-	0x4d, 0x87, 0x6a, 0xff, 0x4b, 0x60, 0xa9, 0x19, 0x50, 0x1a, 0x8a, 0x4a, 0x28, 0x20, 0x09, 0xe0,
-	0x67, 0x62, 0xd7, 0xc4, 0x29, 0x67, 0x45, 0x1f, 0xfe, 0x7f, 0x37, 0x4f, 0x79, 0xa6, 0x54, 0x6a,
-	0x0f, 0x79, 0x17, 0x7e, 0x9d, 0x9a, 0x45, 0xa9, 0xad, 0xe2, 0xad, 0x6f, 0x7f, 0x78, 0xfb, 0x38,
-	0xc3, 0x37, 0x58, 0xd4, 0x93, 0x01, 0xb2, 0x31, 0x49, 0xc4, 0xc9, 0x15, 0x10, 0x1f, 0xf2, 0x17,
-	0x4e, 0x4c, 0x7a, 0x24, 0x4e, 0x88, 0xab, 0xbe, 0xb4, 0x46, 0x98, 0xbf, 0xbd, 0x3c, 0xcc, 0xec,
-	0x2e, 0x23, 0xae, 0x3c, 0x0e, 0xb4, 0xe2, 0x61, 0xf6, 0x09, 0x23, 0xee, 0x73, 0xed, 0xab, 0x6f,
-	0xcc, 0x05, 0x0b, 0x83, 0xbc, 0x6a, 0xd1, 0xbb, 0x9d, 0x80, 0xcc, 0x29, 0xb3, 0x7d, 0x50, 0x60,
-	0x09, 0x8d, 0xb1, 0x47, 0xec, 0x36, 0xb9, 0x54, 0xc5, 0x26, 0x4b, 0x47, 0xd9, 0x7f, 0x4f, 0x2e,
-	0x19, 0x9a, 0x04, 0x4a, 0xe2, 0x1b, 0x0d, 0xe4, 0x2f, 0x62, 0xec, 0x10, 0xd5, 0x70, 0xf3, 0x82,
-	0xe5, 0x30, 0x56, 0x12, 0x0a, 0x71, 0x6d, 0xfe, 0x4c, 0xd2, 0x6e, 0xa2, 0x1e, 0xaa, 0x21, 0xe4,
-	0x11, 0x31, 0x21, 0x7d, 0xe2, 0x88, 0xbd, 0xd4, 0x90, 0x42, 0xf0, 0x00, 0xac, 0xba, 0x3e, 0xc3,
-	0xcd, 0x40, 0x7c, 0xaa, 0x39, 0x6d, 0xb9, 0xfc, 0x9a, 0x71, 0x95, 0x9a, 0x05, 0x35, 0xd1, 0xe0,
-	0x76, 0x34, 0x85, 0xe0, 0x87, 0x60, 0x6d, 0x1c, 0x26, 0xb2, 0x15, 0x7b, 0xa3, 0xd7, 0xe0, 0x55,
-	0x6a, 0x16, 0x47, 0xae, 0x62, 0x06, 0xcd, 0x60, 0xf9, 0xd2, 0x6f, 0x76, 0x3d, 0x51, 0x81, 0x3a,
-	0x92, 0x80, 0x5b, 0x03, 0x3f, 0xf4, 0x13, 0x51, 0x71, 0x4b, 0x48, 0x02, 0xf8, 0x21, 0xc8, 0xd1,
-	0x1e, 0x89, 0x63, 0xdf, 0x25, 0x4c, 0xf4, 0x4e, 0xf9, 0xfd, 0xf7, 0x6e, 0x96, 0xc1, 0xc4, 0xc7,
-	0x08, 0x1a, 0xfb, 0xf3, 0xc5, 0x91, 0x48, 0x24, 0x19, 0x92, 0x90, 0xc6, 0x97, 0xa2, 0x3b, 0x52,
-	0x8b, 0x93, 0x13, 0x2f, 0x84, 0x1d, 0x4d, 0x21, 0x58, 0x03, 0x50, 0x85, 0xc5, 0x24, 0xe9, 0xc6,
-	0x91, 0x2d, 0x5e, 0x02, 0x05, 0x11, 0x2b, 0x1e, 0x45, 0x39, 0x8b, 0xc4, 0xe4, 0x31, 0x4e, 0x30,
-	0xba, 0x61, 0x81, 0xbf, 0x06, 0x50, 0x9e, 0x89, 0xfd, 0x05, 0xa3, 0x11, 0xff, 0xa4, 0x7a, 0xe5,
-	0x7b, 0xaa, 0xbd, 0x11, 0xfa, 0x72, 0x56, 0xe5, 0x6c, 0x48, 0x74, 0xca, 0xa8, 0x5a, 0xc5, 0xa9,
-	0xa6, 0x6b, 0xc6, 0xd2, 0xa9, 0xa6, 0xaf, 0x18, 0xfa, 0x68, 0xff, 0xd4, 0x2a, 0xd0, 0xfa, 0x10,
-	0x4f, 0xa4, 0xf7, 0xf8, 0x6f, 0x19, 0x30, 0xf1, 0xa5, 0x08, 0x7f, 0x09, 0x2a, 0x87, 0x47, 0x47,
-	0xf5, 0x46, 0xc3, 0xbe, 0xf8, 0xfc, 0xbc, 0x6e, 0x9f, 0xd7, 0xd1, 0x8b, 0x93, 0x46, 0xe3, 0xe4,
-	0xe3, 0x97, 0x67, 0xf5, 0x46, 0xc3, 0x58, 0xa8, 0x3c, 0x7a, 0xfd, 0xa6, 0x5a, 0x1e, 0xfb, 0x9f,
-	0x93, 0x38, 0xf4, 0x19, 0xf3, 0x69, 0x14, 0xf0, 0x4a, 0xfd, 0x00, 0x6c, 0x4e, 0x46, 0xa3, 0x7a,
-	0xe3, 0x02, 0x9d, 0x1c, 0x5d, 0xd4, 0x8f, 0x8d, 0x4c, 0xa5, 0xfc, 0xfa, 0x4d, 0xb5, 0x34, 0x8e,
-	0x44, 0x84, 0x25, 0xb1, 0xef, 0xf0, 0x27, 0xe5, 0x19, 0x28, 0xdf, 0xae, 0x59, 0x3f, 0x36, 0x16,
-	0x2b, 0x95, 0xd7, 0x6f, 0xaa, 0x9b, 0xb7, 0x29, 0x12, 0xb7, 0xa2, 0x7d, 0xf5, 0xd7, 0xad, 0x85,
-	0xda, 0xf3, 0xef, 0x06, 0x5b, 0x99, 0xef, 0x07, 0x5b, 0x99, 0x7f, 0x0f, 0xb6, 0x32, 0x5f, 0xbf,
-	0xdb, 0x5a, 0xf8, 0xfe, 0xdd, 0xd6, 0xc2, 0x3f, 0xde, 0x6d, 0x2d, 0xfc, 0xa1, 0xea, 0xf9, 0x49,
-	0xab, 0xdb, 0xdc, 0x71, 0x68, 0xb8, 0x3b, 0xfb, 0xcb, 0x00, 0xff, 0x06, 0x66, 0xcd, 0x65, 0xf1,
-	0x03, 0xce, 0xd3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x39, 0x31, 0x60, 0x2c, 0x19, 0x12, 0x00,
+	// 2001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0xdc, 0xc6,
+	0x19, 0xd6, 0x4a, 0x94, 0xc4, 0x9d, 0x5d, 0x49, 0xf4, 0xe8, 0xc3, 0xf4, 0xda, 0x11, 0x55, 0xb6,
+	0x07, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x52, 0xc3, 0xe9, 0x07, 0xb4, 0xf2, 0xa6, 0x95, 0x6a, 0x39,
+	0xea, 0xac, 0x92, 0x20, 0x45, 0x0b, 0x62, 0x96, 0x1c, 0x73, 0x19, 0x91, 0x9c, 0x2d, 0x67, 0x56,
+	0x59, 0xf5, 0x17, 0x04, 0x3e, 0xa5, 0x3f, 0xc0, 0x40, 0xd0, 0x5e, 0x72, 0xcc, 0x4f, 0xe8, 0x31,
+	0xe8, 0x29, 0xc7, 0xa2, 0x40, 0x89, 0x62, 0x7d, 0x08, 0xa0, 0xa3, 0x7e, 0x41, 0x31, 0x1f, 0xfb,
+	0x29, 0x65, 0xab, 0x00, 0x82, 0x3d, 0xcf, 0xfb, 0xbe, 0xcf, 0xf3, 0xcc, 0x0c, 0x5f, 0x92, 0xc3,
+	0x05, 0x15, 0x9f, 0xb2, 0x84, 0xb2, 0x6d, 0x72, 0x9e, 0x6c, 0x8b, 0xbf, 0x1d, 0x31, 0xda, 0x6a,
+	0x65, 0x94, 0x53, 0x68, 0xa9, 0xdc, 0x96, 0x88, 0x88, 0xbf, 0x9d, 0xca, 0x1d, 0x9c, 0x44, 0x29,
+	0xdd, 0x96, 0xff, 0xaa, 0xa2, 0xca, 0x4a, 0x48, 0x43, 0x2a, 0x87, 0xdb, 0x62, 0xa4, 0xa2, 0xee,
+	0xdf, 0x0c, 0x30, 0x77, 0x82, 0x33, 0x9c, 0x30, 0xb8, 0x03, 0x8a, 0xe4, 0x3c, 0xf1, 0x02, 0x92,
+	0xd2, 0xc4, 0x2e, 0x6c, 0x14, 0x36, 0x8b, 0xd5, 0x95, 0xab, 0xdc, 0xb1, 0x2e, 0x70, 0x12, 0x3f,
+	0x75, 0xfb, 0x29, 0x17, 0x99, 0xe4, 0x3c, 0x79, 0x26, 0x86, 0x70, 0x1f, 0x00, 0xd2, 0xe1, 0x19,
+	0xf6, 0x48, 0xd4, 0x62, 0xb6, 0xb1, 0x31, 0xb3, 0x39, 0x53, 0x75, 0xbb, 0xb9, 0x53, 0xac, 0x89,
+	0x68, 0xed, 0xf0, 0x84, 0x5d, 0xe5, 0xce, 0x1d, 0x2d, 0xd0, 0x2f, 0x74, 0x51, 0x51, 0x82, 0x5a,
+	0xd4, 0x62, 0x70, 0x17, 0xac, 0xe2, 0x38, 0xa6, 0x9f, 0x79, 0xed, 0x54, 0xcc, 0x88, 0xf8, 0x9c,
+	0x04, 0x1e, 0xef, 0x30, 0x7b, 0x76, 0xa3, 0xb0, 0x69, 0xa2, 0x65, 0x99, 0xfc, 0x70, 0x90, 0x3b,
+	0xed, 0x08, 0x4e, 0x59, 0x4c, 0xc7, 0x6f, 0xe2, 0x34, 0x25, 0x31, 0xb3, 0xe7, 0x37, 0x66, 0x36,
+	0x8b, 0xd5, 0xa5, 0x6e, 0xee, 0x94, 0x6a, 0x1f, 0x1d, 0x1f, 0xe8, 0x30, 0x2a, 0x91, 0xf3, 0xa4,
+	0x07, 0xe0, 0x9f, 0xc0, 0x22, 0xf6, 0x7d, 0xc2, 0x98, 0xe7, 0xd3, 0x94, 0x67, 0x34, 0xb6, 0xcd,
+	0x8d, 0xc2, 0x66, 0x69, 0xd7, 0xd9, 0x1a, 0xdf, 0xbc, 0xad, 0x7d, 0x59, 0x77, 0xa0, 0xca, 0xaa,
+	0xab, 0xdf, 0xe4, 0xce, 0x54, 0x37, 0x77, 0x16, 0x46, 0xc2, 0x68, 0x01, 0x0f, 0x43, 0xf8, 0x14,
+	0xdc, 0xc3, 0x3e, 0x8f, 0xce, 0x89, 0xc7, 0x38, 0xe6, 0x91, 0xef, 0xb5, 0x32, 0xe2, 0xd3, 0xa4,
+	0x15, 0xc5, 0x84, 0xd9, 0x45, 0x31, 0x3f, 0x74, 0x57, 0x15, 0xd4, 0x65, 0xfe, 0x64, 0x90, 0x86,
+	0xef, 0x82, 0xbb, 0x72, 0x95, 0x24, 0xf0, 0xd4, 0x5c, 0xbc, 0x84, 0x30, 0x86, 0x43, 0xc2, 0x6c,
+	0x20, 0x99, 0xab, 0x3a, 0x7d, 0x20, 0xb3, 0xc7, 0x3a, 0x09, 0x9f, 0x00, 0xbb, 0xc7, 0x63, 0x1c,
+	0x67, 0x21, 0xe6, 0xc4, 0xfb, 0x73, 0x9b, 0x64, 0x11, 0x61, 0x76, 0x49, 0x12, 0xd7, 0x74, 0xbe,
+	0xae, 0xd3, 0xbf, 0x57, 0xd9, 0xa7, 0xf7, 0x5f, 0x7d, 0xf7, 0xf5, 0xc3, 0xb5, 0xa1, 0x8e, 0xea,
+	0x88, 0x9e, 0x52, 0x7d, 0x70, 0x64, 0x98, 0xd3, 0xd6, 0xcc, 0x91, 0x61, 0xce, 0x58, 0xc6, 0x91,
+	0x61, 0xce, 0x59, 0xf3, 0xee, 0x5f, 0x0b, 0x60, 0x74, 0xf5, 0x70, 0x1f, 0xcc, 0xf9, 0x19, 0xc1,
+	0x9c, 0xc8, 0x46, 0x29, 0xed, 0xfe, 0xf8, 0xff, 0xec, 0xe2, 0xe9, 0x45, 0x8b, 0x54, 0x0d, 0xb1,
+	0x93, 0x48, 0x13, 0xe1, 0x2f, 0x81, 0xe1, 0xe3, 0x38, 0xb6, 0xa7, 0x7f, 0xa8, 0x80, 0xa4, 0xb9,
+	0xff, 0x29, 0x80, 0x3b, 0xd7, 0x2a, 0xa0, 0x0f, 0x4a, 0xfa, 0x2a, 0xf3, 0x8b, 0x96, 0x9a, 0xdc,
+	0xe2, 0xee, 0x83, 0xef, 0xd3, 0x96, 0xa2, 0x3f, 0xe9, 0xe6, 0x0e, 0x18, 0xe0, 0xab, 0xdc, 0x81,
+	0xaa, 0x61, 0x87, 0x84, 0x5c, 0x04, 0x70, 0xbf, 0x02, 0xfa, 0x60, 0x79, 0xb4, 0x95, 0xbc, 0x38,
+	0x62, 0xdc, 0x9e, 0x96, 0x5d, 0xf8, 0xb8, 0x9b, 0x3b, 0xa3, 0x13, 0x7b, 0x1e, 0x31, 0x7e, 0x95,
+	0x3b, 0x95, 0x11, 0xd5, 0x61, 0xa6, 0x8b, 0xee, 0xe0, 0x71, 0x82, 0xfb, 0x95, 0x05, 0x4a, 0x07,
+	0x4d, 0x1c, 0xa5, 0x07, 0x34, 0x7d, 0x19, 0x85, 0xf0, 0x8f, 0x60, 0xa9, 0x49, 0x13, 0xc2, 0x38,
+	0xc1, 0x81, 0xd7, 0x88, 0xa9, 0x7f, 0xa6, 0xef, 0xd1, 0xc7, 0xff, 0xce, 0x9d, 0x55, 0xb5, 0x40,
+	0x16, 0x9c, 0x6d, 0x45, 0x74, 0x3b, 0xc1, 0xbc, 0xb9, 0x75, 0x98, 0x0a, 0xd3, 0x35, 0x65, 0x3a,
+	0xc6, 0x74, 0xd1, 0x62, 0x3f, 0x52, 0x15, 0x01, 0xd8, 0x04, 0x8b, 0x01, 0xa6, 0xde, 0x4b, 0x9a,
+	0x9d, 0x69, 0xf1, 0x69, 0x29, 0x5e, 0xfd, 0x5e, 0xf1, 0x6e, 0xee, 0x94, 0x9f, 0xed, 0x7f, 0xf0,
+	0x3e, 0xcd, 0xce, 0xa4, 0xc4, 0x55, 0xee, 0xac, 0x2a, 0xb3, 0x51, 0x21, 0x17, 0x95, 0x03, 0x4c,
+	0xfb, 0x65, 0xf0, 0x63, 0x60, 0xf5, 0x0b, 0x58, 0xbb, 0xd5, 0xa2, 0x19, 0xb7, 0x67, 0xc4, 0xad,
+	0x5e, 0xfd, 0x59, 0x37, 0x77, 0x16, 0xb5, 0x64, 0x5d, 0x65, 0xae, 0x72, 0xe7, 0xee, 0x98, 0xa8,
+	0xe6, 0xb8, 0x68, 0x51, 0xcb, 0xea, 0x52, 0xd8, 0x00, 0x65, 0x12, 0xb5, 0x76, 0xf6, 0x1e, 0xe9,
+	0x05, 0x18, 0x72, 0x01, 0xbf, 0x9e, 0xb4, 0x80, 0x52, 0xed, 0xf0, 0x64, 0x67, 0xef, 0x51, 0x6f,
+	0xfe, 0xcb, 0xca, 0x6a, 0x58, 0xc5, 0x45, 0x25, 0x05, 0xd5, 0xe4, 0x7b, 0x1e, 0x7b, 0xda, 0x63,
+	0xee, 0xb6, 0x1e, 0x7b, 0x37, 0x79, 0xec, 0x8d, 0x7a, 0xec, 0x8d, 0x7a, 0x3c, 0xd1, 0x1e, 0xf3,
+	0xb7, 0xf5, 0x78, 0x72, 0x93, 0xc7, 0x93, 0x51, 0x0f, 0x55, 0x23, 0x9a, 0xa9, 0x71, 0xf1, 0x17,
+	0x9c, 0xf2, 0xa8, 0x9d, 0x68, 0x1b, 0xf3, 0xd6, 0xcd, 0x34, 0xc6, 0x74, 0xd1, 0x62, 0x3f, 0xa2,
+	0xd4, 0xcf, 0xc0, 0x8a, 0x4f, 0x53, 0xc6, 0x45, 0x2c, 0xa5, 0xad, 0x98, 0x68, 0x8b, 0xa2, 0xb4,
+	0x78, 0x32, 0xc9, 0xe2, 0xbe, 0xb2, 0xb8, 0x89, 0xee, 0xa2, 0xe5, 0xd1, 0xb0, 0x32, 0xf3, 0x80,
+	0xd5, 0x22, 0x9c, 0x64, 0xac, 0xd1, 0xce, 0x42, 0x6d, 0x04, 0xa4, 0xd1, 0x3b, 0x93, 0x8c, 0x74,
+	0x5b, 0x8d, 0x53, 0x5d, 0xb4, 0x34, 0x08, 0x29, 0x83, 0x4f, 0xc0, 0x62, 0x24, 0x5c, 0x1b, 0xed,
+	0x58, 0xcb, 0x97, 0xa4, 0xfc, 0xee, 0x24, 0x79, 0x7d, 0x2b, 0x8c, 0x12, 0x5d, 0xb4, 0xd0, 0x0b,
+	0x28, 0xe9, 0x00, 0xc0, 0xa4, 0x1d, 0x65, 0x5e, 0x18, 0x63, 0x3f, 0x22, 0x99, 0x96, 0x2f, 0x4b,
+	0xf9, 0x77, 0x27, 0xc9, 0xdf, 0x53, 0xf2, 0xd7, 0xc9, 0x2e, 0xb2, 0x44, 0xf0, 0x37, 0x2a, 0xa6,
+	0x5c, 0xea, 0xa0, 0xdc, 0x20, 0x59, 0x1c, 0xa5, 0x5a, 0x7f, 0x41, 0xea, 0x3f, 0x9a, 0xa4, 0xaf,
+	0x3b, 0x68, 0x98, 0xe6, 0xa2, 0x92, 0x82, 0x7d, 0xd1, 0x98, 0xa6, 0x01, 0xed, 0x89, 0xde, 0xb9,
+	0xb5, 0xe8, 0x30, 0xcd, 0x45, 0x25, 0x05, 0x95, 0x68, 0x08, 0x96, 0x71, 0x96, 0xd1, 0xcf, 0xc6,
+	0x36, 0x04, 0x4a, 0xed, 0x9f, 0x4f, 0xd2, 0xee, 0x3d, 0x5c, 0xaf, 0xb3, 0xc5, 0xc3, 0x55, 0x44,
+	0x47, 0xb6, 0x24, 0x00, 0x30, 0xcc, 0xf0, 0xc5, 0x98, 0xcf, 0xca, 0xad, 0x37, 0xfe, 0x3a, 0xd9,
+	0x45, 0x96, 0x08, 0x8e, 0xb8, 0x7c, 0x0a, 0x56, 0x12, 0x92, 0x85, 0xc4, 0x4b, 0x09, 0x67, 0xad,
+	0x38, 0xe2, 0xda, 0x67, 0xf5, 0xd6, 0xf7, 0xc1, 0x4d, 0x74, 0x17, 0x41, 0x19, 0x7e, 0xa1, 0xa3,
+	0xca, 0xeb, 0x1e, 0x30, 0x7d, 0xf1, 0xb6, 0xf0, 0xa2, 0xc0, 0xb6, 0x37, 0x0a, 0x9b, 0x06, 0x9a,
+	0x97, 0xf8, 0x30, 0x80, 0x2b, 0x60, 0x56, 0x9d, 0xe9, 0xee, 0x09, 0x5f, 0xa4, 0x00, 0xac, 0x00,
+	0x33, 0x20, 0x7e, 0x94, 0xe0, 0x98, 0xd9, 0x15, 0x49, 0xe8, 0x63, 0xf8, 0x11, 0x58, 0x60, 0x4d,
+	0x9c, 0x86, 0x4d, 0x1c, 0x79, 0x3c, 0x4a, 0x88, 0x7d, 0x5f, 0xce, 0x78, 0x67, 0xd2, 0x8c, 0x57,
+	0xd4, 0x8c, 0x47, 0x78, 0x2e, 0x2a, 0xf7, 0xf0, 0x69, 0x94, 0x10, 0x78, 0x02, 0x4a, 0x3e, 0x4e,
+	0xfd, 0x76, 0xaa, 0x54, 0x1f, 0x48, 0xd5, 0xed, 0x49, 0xaa, 0xfa, 0x55, 0x3c, 0xc4, 0x72, 0x11,
+	0x50, 0xa8, 0xa7, 0xd8, 0xca, 0x70, 0xd8, 0x26, 0x4a, 0xf1, 0xad, 0x5b, 0x2b, 0x0e, 0xb1, 0x5c,
+	0x04, 0x14, 0xea, 0x29, 0x9e, 0x93, 0xec, 0x2c, 0xd6, 0x8a, 0xeb, 0xb7, 0x56, 0x1c, 0x62, 0xb9,
+	0x08, 0x28, 0x24, 0x15, 0x8f, 0x01, 0xa0, 0x0c, 0x9f, 0x61, 0x25, 0xe8, 0x48, 0xc1, 0xad, 0x49,
+	0x82, 0xfa, 0xc0, 0x3c, 0x20, 0xb9, 0xa8, 0x28, 0x81, 0x90, 0x3b, 0x32, 0xcc, 0x59, 0x6b, 0xee,
+	0xc8, 0x30, 0xd7, 0xac, 0xbb, 0x47, 0x86, 0x79, 0xd7, 0xb2, 0xdd, 0x6d, 0x30, 0x2b, 0x0e, 0x95,
+	0x04, 0x5a, 0x60, 0xe6, 0x8c, 0x5c, 0xa8, 0x73, 0x01, 0x12, 0x43, 0x71, 0xed, 0xcf, 0x71, 0xdc,
+	0x26, 0xea, 0x75, 0x8e, 0x14, 0x70, 0x4f, 0xc0, 0xd2, 0x69, 0x86, 0x53, 0x26, 0x0e, 0xa4, 0x34,
+	0x7d, 0x4e, 0x43, 0x06, 0x21, 0x30, 0x9a, 0x98, 0x35, 0x35, 0x57, 0x8e, 0xe1, 0x4f, 0x81, 0x11,
+	0xd3, 0x90, 0xc9, 0x83, 0x4d, 0x69, 0x77, 0xf5, 0xfa, 0x29, 0xea, 0x39, 0x0d, 0x91, 0x2c, 0x71,
+	0xff, 0x39, 0x0d, 0x66, 0x9e, 0xd3, 0x10, 0xda, 0x60, 0x1e, 0x07, 0x41, 0x46, 0x18, 0xd3, 0x4a,
+	0x3d, 0x08, 0xd7, 0xc0, 0x1c, 0xa7, 0xad, 0xc8, 0x57, 0x72, 0x45, 0xa4, 0x91, 0x30, 0x0e, 0x30,
+	0xc7, 0xf2, 0x0c, 0x50, 0x46, 0x72, 0x2c, 0xce, 0xf7, 0xb2, 0xd5, 0xbd, 0xb4, 0x9d, 0x34, 0x48,
+	0x26, 0x5f, 0xe5, 0x46, 0x75, 0xe9, 0x32, 0x77, 0x4a, 0x32, 0xfe, 0x42, 0x86, 0xd1, 0x30, 0x80,
+	0x6f, 0x83, 0x79, 0xde, 0xf1, 0xe4, 0x1a, 0x66, 0xe5, 0x16, 0x2f, 0x5f, 0xe6, 0xce, 0x12, 0x1f,
+	0x2c, 0xf3, 0xb7, 0x98, 0x35, 0xd1, 0x1c, 0xef, 0x88, 0xff, 0xe1, 0x36, 0x30, 0x79, 0xc7, 0x8b,
+	0xd2, 0x80, 0x74, 0xe4, 0x4b, 0xdc, 0xa8, 0xae, 0x5c, 0xe6, 0x8e, 0x35, 0x54, 0x7e, 0x28, 0x72,
+	0x68, 0x9e, 0x77, 0xe4, 0x00, 0xbe, 0x0d, 0x80, 0x9a, 0x92, 0x74, 0x50, 0xef, 0xe4, 0x85, 0xcb,
+	0xdc, 0x29, 0xca, 0xa8, 0xd4, 0x1e, 0x0c, 0xa1, 0x0b, 0x66, 0x95, 0xb6, 0x29, 0xb5, 0xcb, 0x97,
+	0xb9, 0x63, 0xc6, 0x34, 0x54, 0x9a, 0x2a, 0x25, 0xb6, 0x2a, 0x23, 0x09, 0x3d, 0x27, 0x81, 0x7c,
+	0x31, 0x9a, 0xa8, 0x07, 0xdd, 0x2f, 0xa6, 0x81, 0x79, 0xda, 0x41, 0x84, 0xb5, 0x63, 0x0e, 0xdf,
+	0x07, 0x96, 0x3c, 0x2b, 0x62, 0x9f, 0x7b, 0x23, 0x5b, 0x5b, 0xbd, 0x3f, 0x78, 0x8d, 0x8d, 0x57,
+	0xb8, 0x68, 0xa9, 0x17, 0xda, 0xd7, 0xfb, 0xbf, 0x02, 0x66, 0x1b, 0x31, 0xa5, 0x89, 0xec, 0x84,
+	0x32, 0x52, 0x00, 0x7e, 0x2c, 0x77, 0x4d, 0x5e, 0xe5, 0x19, 0x79, 0x0e, 0xff, 0xd1, 0xf5, 0xab,
+	0x3c, 0xd6, 0x2a, 0xd5, 0xfb, 0xe2, 0x14, 0x7e, 0x95, 0x3b, 0x8b, 0xca, 0x5b, 0xf3, 0xdd, 0xaf,
+	0xbe, 0xfb, 0xfa, 0x61, 0x41, 0x6c, 0xb0, 0xec, 0x27, 0x0b, 0xcc, 0x64, 0x84, 0xcb, 0x2b, 0x57,
+	0x46, 0x62, 0x28, 0x1e, 0x38, 0x19, 0x39, 0x27, 0x19, 0x27, 0x81, 0xfe, 0xb6, 0xeb, 0x63, 0xf1,
+	0xf4, 0x0a, 0x31, 0xf3, 0xda, 0x8c, 0x04, 0xea, 0x72, 0xa0, 0xf9, 0x10, 0xb3, 0x0f, 0x19, 0x09,
+	0x9e, 0x1a, 0x9f, 0x7f, 0xe9, 0x4c, 0xb9, 0x18, 0x94, 0xf4, 0x11, 0xbd, 0xdd, 0x8a, 0xc9, 0x84,
+	0x36, 0xdb, 0x05, 0x65, 0xc6, 0x69, 0x86, 0x43, 0xe2, 0x9d, 0x91, 0x0b, 0xdd, 0x6c, 0xaa, 0x75,
+	0x74, 0xfc, 0x77, 0xe4, 0x82, 0xa1, 0x61, 0xa0, 0x2d, 0xbe, 0x34, 0x40, 0xe9, 0x34, 0xc3, 0x3e,
+	0xd1, 0x07, 0x6e, 0xd1, 0xb0, 0x02, 0x66, 0xda, 0x42, 0x23, 0xe1, 0x2d, 0xee, 0x49, 0xda, 0xe6,
+	0xfa, 0xa6, 0xea, 0x41, 0xc1, 0xc8, 0x08, 0xe9, 0x10, 0x5f, 0xee, 0xa5, 0x81, 0x34, 0x82, 0x7b,
+	0x60, 0x21, 0x88, 0x18, 0x6e, 0xc4, 0xf2, 0xe3, 0xd0, 0x3f, 0x53, 0xcb, 0xaf, 0x5a, 0x97, 0xb9,
+	0x53, 0xd6, 0x89, 0xba, 0x88, 0xa3, 0x11, 0x04, 0xdf, 0x03, 0x4b, 0x03, 0x9a, 0x9c, 0xad, 0xdc,
+	0x1b, 0xb3, 0x0a, 0x2f, 0x73, 0x67, 0xb1, 0x5f, 0x2a, 0x33, 0x68, 0x0c, 0xab, 0x87, 0x7e, 0xa3,
+	0x1d, 0xca, 0x0e, 0x34, 0x91, 0x02, 0x22, 0x1a, 0x47, 0x49, 0xc4, 0x65, 0xc7, 0xcd, 0x22, 0x05,
+	0xe0, 0x7b, 0xa0, 0x48, 0xcf, 0x49, 0x96, 0x45, 0x81, 0xfc, 0xe2, 0x14, 0x6d, 0xf0, 0xd6, 0xf5,
+	0x36, 0x18, 0xfa, 0x18, 0x41, 0x83, 0x7a, 0xb1, 0x38, 0x92, 0xca, 0x49, 0x26, 0x24, 0xa1, 0xd9,
+	0x85, 0x5d, 0x1a, 0x2c, 0x4e, 0x25, 0x8e, 0x65, 0x1c, 0x8d, 0x20, 0x58, 0x05, 0x50, 0xd3, 0x32,
+	0xc2, 0xdb, 0x59, 0xea, 0xc9, 0x87, 0x40, 0x59, 0x72, 0xe5, 0xad, 0xa8, 0xb2, 0x48, 0x26, 0x9f,
+	0x61, 0x8e, 0xd1, 0xb5, 0x08, 0xfc, 0x15, 0x80, 0xea, 0x9a, 0x78, 0x9f, 0x32, 0x9a, 0x8a, 0x4f,
+	0xaa, 0x97, 0x51, 0xa8, 0x8f, 0x37, 0xd2, 0x5f, 0x65, 0xf5, 0x9c, 0x2d, 0x85, 0x8e, 0x18, 0xd5,
+	0xab, 0x38, 0x32, 0x4c, 0xc3, 0x9a, 0x3d, 0x32, 0xcc, 0x79, 0xcb, 0xec, 0xef, 0x9f, 0x5e, 0x05,
+	0x5a, 0xee, 0xe1, 0xa1, 0xe9, 0x3d, 0xfc, 0x47, 0x01, 0x0c, 0x7d, 0x29, 0xc2, 0x5f, 0x80, 0xca,
+	0xfe, 0xc1, 0x41, 0xad, 0x5e, 0xf7, 0x4e, 0x3f, 0x39, 0xa9, 0x79, 0x27, 0x35, 0x74, 0x7c, 0x58,
+	0xaf, 0x1f, 0x7e, 0xf0, 0xe2, 0x79, 0xad, 0x5e, 0xb7, 0xa6, 0x2a, 0x0f, 0x5e, 0xbd, 0xde, 0xb0,
+	0x07, 0xf5, 0x27, 0x24, 0x4b, 0x22, 0xc6, 0x22, 0x9a, 0xc6, 0xa2, 0x53, 0xdf, 0x01, 0x6b, 0xc3,
+	0x6c, 0x54, 0xab, 0x9f, 0xa2, 0xc3, 0x83, 0xd3, 0xda, 0x33, 0xab, 0x50, 0xb1, 0x5f, 0xbd, 0xde,
+	0x58, 0x19, 0x30, 0x11, 0x61, 0x3c, 0x8b, 0xc4, 0xaf, 0x1f, 0xe2, 0x9b, 0xff, 0x66, 0xcf, 0xda,
+	0x33, 0x6b, 0xba, 0x52, 0x79, 0xf5, 0x7a, 0x63, 0xed, 0x26, 0x47, 0x12, 0x54, 0x8c, 0xcf, 0xff,
+	0xbe, 0x3e, 0x55, 0x7d, 0xfa, 0x4d, 0x77, 0xbd, 0xf0, 0x6d, 0x77, 0xbd, 0xf0, 0xdf, 0xee, 0x7a,
+	0xe1, 0x8b, 0x37, 0xeb, 0x53, 0xdf, 0xbe, 0x59, 0x9f, 0xfa, 0xd7, 0x9b, 0xf5, 0xa9, 0x3f, 0x6c,
+	0x84, 0x11, 0x6f, 0xb6, 0x1b, 0x5b, 0x3e, 0x4d, 0xb6, 0xc7, 0x7f, 0x19, 0x10, 0xdf, 0xc0, 0xac,
+	0x31, 0x27, 0x7f, 0x32, 0x7a, 0xfc, 0xbf, 0x01, 0x00, 0x28, 0x9f, 0x36, 0xc9, 0x8b, 0x12, 0x00,
 	0x00,
 }
 
@@ -1021,6 +1045,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedStargateQueries) > 0 {
+		for iNdEx := len(m.AllowedStargateQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedStargateQueries[iNdEx])
+			copy(dAtA[i:], m.AllowedStargateQueries[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedStargateQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AllowedCosmosMessages) > 0 {
+		for iNdEx := len(m.AllowedCosmosMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCosmosMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedCosmosMessages[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedCosmosMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1917,6 +1959,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.AllowedCosmosMessages) > 0 {
+		for _, s := range m.AllowedCosmosMessages {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.AllowedStargateQueries) > 0 {
+		for _, s := range m.AllowedStargateQueries {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2486,6 +2540,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCosmosMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCosmosMessages = append(m.AllowedCosmosMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedStargateQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedStargateQueries = append(m.AllowedStargateQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
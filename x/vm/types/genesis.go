@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/types"
 )
 
@@ -14,12 +16,29 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// NewFeeGranter creates a new fee granter registration for a contract.
+func NewFeeGranter(contract, granter common.Address) FeeGranter {
+	return FeeGranter{
+		Contract: contract.Hex(),
+		Granter:  granter.Hex(),
+	}
+}
+
+// Validate performs a basic validation of a FeeGranter fields.
+func (fg FeeGranter) Validate() error {
+	if err := types.ValidateNonZeroAddress(fg.Contract); err != nil {
+		return err
+	}
+	return types.ValidateNonZeroAddress(fg.Granter)
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:    []GenesisAccount{},
+		Params:      DefaultParams(),
+		FeeGranters: []FeeGranter{},
 	}
 }
 
@@ -45,5 +64,18 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenFeeGranters := make(map[common.Address]bool)
+	for _, fg := range gs.FeeGranters {
+		if err := fg.Validate(); err != nil {
+			return fmt.Errorf("invalid fee granter of contract %s: %w", fg.Contract, err)
+		}
+
+		contract := common.HexToAddress(fg.Contract)
+		if seenFeeGranters[contract] {
+			return fmt.Errorf("duplicated fee granter of contract %s", fg.Contract)
+		}
+		seenFeeGranters[contract] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,9 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// fee_granters is an array containing the fee granters registered for the
+	// contracts, which sponsor the fees of the Ethereum transactions calling them.
+	FeeGranters []FeeGranter `protobuf:"bytes,3,rep,name=fee_granters,json=feeGranters,proto3" json:"fee_granters"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +82,68 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFeeGranters() []FeeGranter {
+	if m != nil {
+		return m.FeeGranters
+	}
+	return nil
+}
+
+// FeeGranter defines the fee granter registered for a contract.
+type FeeGranter struct {
+	// contract defines the ethereum hex formated address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// granter defines the ethereum hex formated address of the fee granter
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *FeeGranter) Reset()         { *m = FeeGranter{} }
+func (m *FeeGranter) String() string { return proto.CompactTextString(m) }
+func (*FeeGranter) ProtoMessage()    {}
+func (*FeeGranter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b6f3a3ceb84d18, []int{1}
+}
+func (m *FeeGranter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeGranter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeGranter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeGranter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeGranter.Merge(m, src)
+}
+func (m *FeeGranter) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeGranter) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeGranter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeGranter proto.InternalMessageInfo
+
+func (m *FeeGranter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *FeeGranter) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -95,7 +160,7 @@ func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
 func (m *GenesisAccount) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccount) ProtoMessage()    {}
 func (*GenesisAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b6f3a3ceb84d18, []int{1}
+	return fileDescriptor_e6b6f3a3ceb84d18, []int{2}
 }
 func (m *GenesisAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,33 +212,37 @@ func (m *GenesisAccount) GetStorage() Storage {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.vm.v1.GenesisState")
+	proto.RegisterType((*FeeGranter)(nil), "cosmos.evm.vm.v1.FeeGranter")
 	proto.RegisterType((*GenesisAccount)(nil), "cosmos.evm.vm.v1.GenesisAccount")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0x61, 0x18, 0x0d, 0x32,
-	0x04, 0x2c, 0xa7, 0x34, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x51, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x3b, 0x17, 0x47, 0x62, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x49, 0xb1, 0x04, 0xa3, 0x02, 0xb3,
-	0x06, 0xb7, 0x91, 0x82, 0x1e, 0xba, 0xd5, 0x7a, 0x50, 0x1d, 0x8e, 0x10, 0x85, 0x4e, 0x9c, 0x27,
-	0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xae, 0x59, 0xc8, 0x9a, 0x8b, 0xad,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x02, 0xd3, 0x98,
-	0x00, 0xb0, 0x3c, 0xb2, 0x76, 0xa8, 0x16, 0xa5, 0x76, 0x46, 0x2e, 0x3e, 0x54, 0x4b, 0x84, 0x24,
-	0xb8, 0xd8, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0xee, 0x62, 0xd4, 0xe0, 0x0c, 0x82, 0x71,
-	0x85, 0x84, 0xb8, 0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0xf6, 0x70, 0x06, 0x81, 0xd9, 0x42, 0xee,
-	0x5c, 0xec, 0xc5, 0x25, 0xf9, 0x45, 0x89, 0xe9, 0xa9, 0x12, 0xcc, 0x60, 0x5f, 0x88, 0x63, 0x5a,
-	0x0f, 0xf6, 0xb0, 0x93, 0x08, 0xc8, 0xf6, 0x55, 0xf7, 0xe5, 0xd9, 0x83, 0x21, 0xea, 0x21, 0x0e,
-	0x81, 0xe9, 0x76, 0xb2, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x85,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa4, 0x10, 0xae, 0x00, 0x85,
-	0x71, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x8c, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0x4f, 0x90, 0xb3, 0xdc, 0x01, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xbd, 0x4e, 0x32, 0x41,
+	0x14, 0xdd, 0xf9, 0xf8, 0xc2, 0xcf, 0x40, 0x8c, 0x4e, 0x48, 0xdc, 0x6c, 0xcc, 0xb2, 0xa1, 0x22,
+	0x16, 0xbb, 0x01, 0x3b, 0xad, 0xdc, 0xc2, 0x4d, 0xac, 0x0c, 0x74, 0x36, 0x66, 0x58, 0x2e, 0x2b,
+	0xc5, 0xee, 0x90, 0x9d, 0x81, 0xe8, 0x13, 0xd8, 0xfa, 0x18, 0xc6, 0xca, 0xc7, 0xa0, 0xa4, 0xb4,
+	0x52, 0x02, 0x85, 0xaf, 0x61, 0xe6, 0x07, 0x44, 0x37, 0x99, 0x4c, 0xee, 0x9d, 0x7b, 0xce, 0xb9,
+	0x73, 0x72, 0xb0, 0x1b, 0x33, 0x9e, 0x32, 0x1e, 0xc0, 0x3c, 0x0d, 0xe4, 0xe9, 0x06, 0x09, 0x64,
+	0xc0, 0x27, 0xdc, 0x9f, 0xe6, 0x4c, 0x30, 0x72, 0xa8, 0xe7, 0x3e, 0xcc, 0x53, 0x5f, 0x9e, 0xae,
+	0x73, 0x44, 0xd3, 0x49, 0xc6, 0x02, 0x75, 0x6b, 0x90, 0xd3, 0x4c, 0x58, 0xc2, 0x54, 0x19, 0xc8,
+	0xca, 0xbc, 0x3a, 0x05, 0x69, 0x29, 0xa2, 0x66, 0xed, 0x15, 0xc2, 0x8d, 0x48, 0x2f, 0x1a, 0x08,
+	0x2a, 0x80, 0x44, 0xb8, 0x4a, 0xe3, 0x98, 0xcd, 0x32, 0xc1, 0x6d, 0xe4, 0x95, 0x3a, 0xf5, 0x9e,
+	0xe7, 0xff, 0x5d, 0xed, 0x1b, 0xc6, 0xa5, 0x06, 0x86, 0xb5, 0xc5, 0x47, 0xcb, 0x7a, 0xf9, 0x7a,
+	0x3b, 0x45, 0xfd, 0x1d, 0x99, 0x5c, 0xe0, 0xf2, 0x94, 0xe6, 0x34, 0xe5, 0xf6, 0x3f, 0x0f, 0x75,
+	0xea, 0x3d, 0xbb, 0x28, 0x73, 0xa3, 0xe6, 0xfb, 0x74, 0x43, 0x21, 0xd7, 0xb8, 0x31, 0x06, 0xb8,
+	0x4b, 0x72, 0x9a, 0x09, 0xc8, 0xb9, 0x5d, 0x52, 0x3f, 0x39, 0x29, 0x4a, 0x5c, 0x01, 0x44, 0x1a,
+	0xb4, 0x2f, 0x53, 0x1f, 0xef, 0x9e, 0x79, 0x3b, 0xc4, 0xf8, 0x07, 0x45, 0x1c, 0x5c, 0x8d, 0x59,
+	0x26, 0x72, 0x1a, 0x0b, 0x1b, 0x79, 0xa8, 0x53, 0xeb, 0xef, 0x7a, 0x62, 0xe3, 0x8a, 0xd9, 0xa8,
+	0xfe, 0x5c, 0xeb, 0x6f, 0xdb, 0xf6, 0x13, 0xc2, 0x07, 0xbf, 0x4d, 0x4b, 0x30, 0x1d, 0x8d, 0x72,
+	0xe0, 0xdc, 0xe8, 0x6c, 0x5b, 0x42, 0xf0, 0xff, 0x98, 0x8d, 0xc0, 0x68, 0xa8, 0x9a, 0x44, 0xb8,
+	0xc2, 0x05, 0xcb, 0x69, 0x02, 0xc6, 0xcb, 0x71, 0xd1, 0x8b, 0x0a, 0x20, 0x6c, 0x4a, 0x1b, 0xaf,
+	0x9f, 0xad, 0xca, 0x40, 0xe3, 0xb5, 0xa3, 0x2d, 0x3b, 0x3c, 0x5f, 0xac, 0x5d, 0xb4, 0x5c, 0xbb,
+	0x68, 0xb5, 0x76, 0xd1, 0xf3, 0xc6, 0xb5, 0x96, 0x1b, 0xd7, 0x7a, 0xdf, 0xb8, 0xd6, 0xad, 0x97,
+	0x4c, 0xc4, 0xfd, 0x6c, 0xe8, 0xc7, 0x2c, 0x0d, 0xf6, 0x12, 0x7f, 0x90, 0x99, 0x8b, 0xc7, 0x29,
+	0xf0, 0x61, 0x59, 0x65, 0x7e, 0xf6, 0x3d, 0x00, 0x09, 0x2f, 0x1d, 0x50, 0x6c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +265,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeGranters) > 0 {
+		for iNdEx := len(m.FeeGranters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGranters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -223,6 +306,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeGranter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeGranter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeGranter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -299,6 +419,29 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeGranters) > 0 {
+		for _, e := range m.FeeGranters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeGranter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -427,6 +570,154 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranters = append(m.FeeGranters, FeeGranter{})
+			if err := m.FeeGranters[len(m.FeeGranters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeGranter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeGranter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeGranter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
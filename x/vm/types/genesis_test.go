@@ -157,6 +157,48 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid fee granters",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FeeGranters: []FeeGranter{
+					NewFeeGranter(common.HexToAddress(suite.address), common.HexToAddress("0x01")),
+					NewFeeGranter(common.HexToAddress("0x02"), common.HexToAddress("0x01")),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid fee granter contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FeeGranters: []FeeGranter{
+					{Contract: "123456", Granter: suite.address},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "zero fee granter address",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FeeGranters: []FeeGranter{
+					NewFeeGranter(common.HexToAddress(suite.address), common.Address{}),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated fee granter contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FeeGranters: []FeeGranter{
+					NewFeeGranter(common.HexToAddress(suite.address), common.HexToAddress("0x01")),
+					NewFeeGranter(common.HexToAddress(suite.address), common.HexToAddress("0x02")),
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -14,6 +15,8 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -30,7 +33,13 @@ var (
 	// DefaultExtraEIPs defines the default extra EIPs to be included.
 	DefaultExtraEIPs []int64
 	// DefaultEVMChannels defines a list of IBC channels that connect to EVM chains like injective or cronos.
-	DefaultEVMChannels              []string
+	DefaultEVMChannels []string
+	// DefaultAllowedCosmosMessages defines the default Cosmos SDK messages that can be
+	// executed through the cosmos precompile.
+	DefaultAllowedCosmosMessages []string
+	// DefaultAllowedStargateQueries defines the default Cosmos SDK gRPC queries that can be
+	// executed through the stargate precompile.
+	DefaultAllowedStargateQueries   []string
 	DefaultCreateAllowlistAddresses []string
 	DefaultCallAllowlistAddresses   []string
 	DefaultAccessControl            = AccessControl{
@@ -71,6 +80,8 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		AllowedCosmosMessages:   DefaultAllowedCosmosMessages,
+		AllowedStargateQueries:  DefaultAllowedStargateQueries,
 	}
 }
 
@@ -110,6 +121,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCosmosMessages(p.AllowedCosmosMessages); err != nil {
+		return err
+	}
+
+	if err := validateStargateQueries(p.AllowedStargateQueries); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -138,6 +157,18 @@ func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// IsAllowedCosmosMessage returns true if the Cosmos SDK message with the provided
+// type URL can be executed through the cosmos precompile
+func (p Params) IsAllowedCosmosMessage(typeURL string) bool {
+	return slices.Contains(p.AllowedCosmosMessages, typeURL)
+}
+
+// IsAllowedStargateQuery returns true if the Cosmos SDK gRPC query with the provided
+// method path can be executed through the stargate precompile
+func (p Params) IsAllowedStargateQuery(path string) bool {
+	return slices.Contains(p.AllowedStargateQueries, path)
+}
+
 func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
@@ -211,6 +242,58 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// validateCosmosMessages checks if the Cosmos SDK message type URLs are valid and unique.
+// Ethereum transactions cannot be executed through the cosmos precompile.
+func validateCosmosMessages(i interface{}) error {
+	typeURLs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid cosmos messages slice type: %T", i)
+	}
+
+	ethTxTypeURL := sdk.MsgTypeURL(&MsgEthereumTx{})
+	seenTypeURLs := make(map[string]struct{})
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid cosmos message type URL: %q", typeURL)
+		}
+
+		if typeURL == ethTxTypeURL {
+			return fmt.Errorf("cosmos message %s is not allowed", typeURL)
+		}
+
+		if _, ok := seenTypeURLs[typeURL]; ok {
+			return fmt.Errorf("duplicate cosmos message %s", typeURL)
+		}
+		seenTypeURLs[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateStargateQueries checks if the gRPC query method paths are valid and unique.
+// A valid path has the "/<package>.<Service>/<Method>" format.
+func validateStargateQueries(i interface{}) error {
+	paths, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid stargate queries slice type: %T", i)
+	}
+
+	seenPaths := make(map[string]struct{})
+	for _, path := range paths {
+		parts := strings.Split(path, "/")
+		if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" {
+			return fmt.Errorf("invalid stargate query path: %q", path)
+		}
+
+		if _, ok := seenPaths[path]; ok {
+			return fmt.Errorf("duplicate stargate query %s", path)
+		}
+		seenPaths[path] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
 func ValidatePrecompiles(i interface{}) error {
 	precompiles, ok := i.([]string)
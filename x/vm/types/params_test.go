@@ -49,6 +49,55 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid cosmos messages",
+			params: Params{
+				AllowedCosmosMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid cosmos message type URL",
+			params: Params{
+				AllowedCosmosMessages: []string{"cosmos.bank.v1beta1.MsgSend"},
+			},
+			errContains: "invalid cosmos message type URL",
+		},
+		{
+			name: "duplicate cosmos messages",
+			params: Params{
+				AllowedCosmosMessages: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+			},
+			errContains: "duplicate cosmos message",
+		},
+		{
+			name: "ethereum tx cosmos message",
+			params: Params{
+				AllowedCosmosMessages: []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+			},
+			errContains: "is not allowed",
+		},
+		{
+			name: "valid stargate queries",
+			params: Params{
+				AllowedStargateQueries: []string{"/cosmos.mint.v1beta1.Query/Inflation"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid stargate query path",
+			params: Params{
+				AllowedStargateQueries: []string{"/cosmos.mint.v1beta1.Query"},
+			},
+			errContains: "invalid stargate query path",
+		},
+		{
+			name: "duplicate stargate queries",
+			params: Params{
+				AllowedStargateQueries: []string{"/cosmos.mint.v1beta1.Query/Inflation", "/cosmos.mint.v1beta1.Query/Inflation"},
+			},
+			errContains: "duplicate stargate query",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,6 +4,11 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+// MaxStorageRootSlots is the maximum number of storage slots that the
+// Query/StorageRoot method iterates over. Accounts with a larger storage are
+// rejected so that a single query cannot walk an unbounded store prefix.
+const MaxStorageRootSlots = 10_000
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m QueryTraceTxRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, msg := range m.Predecessors {
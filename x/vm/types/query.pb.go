@@ -606,6 +606,96 @@ func (m *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryStorageRootRequest is the request type for the Query/StorageRoot RPC
+// method.
+type QueryStorageRootRequest struct {
+	// address is the ethereum hex address to query the storage root for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryStorageRootRequest) Reset()         { *m = QueryStorageRootRequest{} }
+func (m *QueryStorageRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRootRequest) ProtoMessage()    {}
+func (*QueryStorageRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+}
+func (m *QueryStorageRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRootRequest.Merge(m, src)
+}
+func (m *QueryStorageRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRootRequest proto.InternalMessageInfo
+
+// QueryStorageRootResponse is the response type for the Query/StorageRoot RPC
+// method.
+type QueryStorageRootResponse struct {
+	// storage_root defines the hex encoded root hash of a trie built over the raw
+	// storage keys and values of the account. It is a non-standard commitment and
+	// not the Ethereum storage trie root, as the keys aren't hashed and the values
+	// aren't RLP encoded. Accounts with more than 10000 storage slots are rejected.
+	StorageRoot string `protobuf:"bytes,1,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+}
+
+func (m *QueryStorageRootResponse) Reset()         { *m = QueryStorageRootResponse{} }
+func (m *QueryStorageRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRootResponse) ProtoMessage()    {}
+func (*QueryStorageRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+}
+func (m *QueryStorageRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRootResponse.Merge(m, src)
+}
+func (m *QueryStorageRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRootResponse proto.InternalMessageInfo
+
+func (m *QueryStorageRootResponse) GetStorageRoot() string {
+	if m != nil {
+		return m.StorageRoot
+	}
+	return ""
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	// address is the ethereum hex address to query the code for.
@@ -616,7 +706,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +746,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +794,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +835,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +886,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +925,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +977,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1049,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1126,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1228,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1288,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1383,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1428,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1467,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1505,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1544,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,6 +1586,8 @@ func init() {
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
 	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
 	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryStorageRootRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRootRequest")
+	proto.RegisterType((*QueryStorageRootResponse)(nil), "cosmos.evm.vm.v1.QueryStorageRootResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
@@ -1517,109 +1609,113 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0xf8, 0xe2, 0xec, 0x37, 0xb1, 0xc3, 0x42,
-	0x7e, 0x12, 0x76, 0x49, 0x4a, 0x2b, 0x95, 0x1e, 0xda, 0x24, 0x0a, 0x81, 0x02, 0x15, 0xdd, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0xaf, 0xe2, 0xdd, 0x31, 0x3b, 0xeb, 0xd4, 0x81, 0xd2,
-	0x43, 0xa5, 0x22, 0x28, 0x17, 0xa4, 0xde, 0x5b, 0x8e, 0xbd, 0xb5, 0x37, 0xfe, 0x05, 0x8e, 0x48,
-	0xbd, 0x54, 0x3d, 0xd0, 0x0a, 0x2a, 0xb5, 0x7f, 0x43, 0x4f, 0xd5, 0xfc, 0x58, 0xdb, 0xeb, 0xf5,
-	0xda, 0xa1, 0xa2, 0xb7, 0x4a, 0x11, 0xec, 0xcc, 0xbc, 0x1f, 0x9f, 0xf7, 0xe6, 0xcd, 0x7b, 0x9f,
-	0x04, 0xcc, 0x58, 0x84, 0xba, 0x84, 0x1a, 0x78, 0xdf, 0x35, 0xd8, 0xcf, 0x9a, 0x71, 0xab, 0x81,
-	0xfd, 0x03, 0xbd, 0xee, 0x93, 0x80, 0xc0, 0x49, 0x71, 0xaa, 0xe3, 0x7d, 0x57, 0x67, 0x3f, 0x6b,
-	0xea, 0x31, 0xe4, 0x3a, 0x1e, 0x31, 0xf8, 0xbf, 0x42, 0x48, 0x5d, 0x91, 0x26, 0xca, 0x88, 0x62,
-	0xa1, 0x6d, 0xec, 0xaf, 0x95, 0x71, 0x80, 0xd6, 0x8c, 0x3a, 0xb2, 0x1d, 0x0f, 0x05, 0x0e, 0xf1,
-	0xa4, 0xec, 0x94, 0x4d, 0x6c, 0xc2, 0x3f, 0x0d, 0xf6, 0x25, 0x77, 0x67, 0x6c, 0x42, 0xec, 0x1a,
-	0x36, 0x50, 0xdd, 0x31, 0x90, 0xe7, 0x91, 0x80, 0xab, 0x50, 0x79, 0x5a, 0x94, 0xa7, 0x7c, 0x55,
-	0x6e, 0xdc, 0x34, 0x02, 0xc7, 0xc5, 0x34, 0x40, 0x6e, 0x5d, 0x0a, 0xa8, 0xb1, 0x18, 0x18, 0x5e,
-	0x71, 0x36, 0x1d, 0x3b, 0x0b, 0x9a, 0xe2, 0x48, 0x9b, 0x02, 0xf0, 0x43, 0x86, 0x76, 0x8b, 0x78,
-	0x37, 0x1d, 0xdb, 0xc4, 0xb7, 0x1a, 0x98, 0x06, 0xda, 0x35, 0x70, 0x3c, 0xb2, 0x4b, 0xeb, 0xc4,
-	0xa3, 0x18, 0xbe, 0x09, 0x46, 0x2d, 0xbe, 0x93, 0x57, 0xe6, 0x94, 0xa5, 0xdc, 0xfa, 0xac, 0xde,
-	0x9d, 0x1a, 0x7d, 0xab, 0x8a, 0x1c, 0x4f, 0xaa, 0x49, 0x61, 0xed, 0x6d, 0x69, 0x6d, 0xc3, 0xb2,
-	0x48, 0xc3, 0x0b, 0xa4, 0x13, 0x98, 0x07, 0x19, 0x54, 0xa9, 0xf8, 0x98, 0x52, 0x6e, 0x6e, 0xcc,
-	0x0c, 0x97, 0x17, 0xb3, 0xf7, 0x1f, 0x17, 0x87, 0xfe, 0x7c, 0x5c, 0x1c, 0xd2, 0x2c, 0x30, 0x15,
-	0x55, 0x95, 0x48, 0xf2, 0x20, 0x53, 0x46, 0x35, 0xe4, 0x59, 0x38, 0xd4, 0x95, 0x4b, 0xf8, 0x7f,
-	0x30, 0x66, 0x91, 0x0a, 0x2e, 0x55, 0x11, 0xad, 0xe6, 0x87, 0xf9, 0x59, 0x96, 0x6d, 0x5c, 0x46,
-	0xb4, 0x0a, 0xa7, 0xc0, 0x88, 0x47, 0x98, 0x52, 0x6a, 0x4e, 0x59, 0x4a, 0x9b, 0x62, 0xa1, 0xbd,
-	0x0b, 0xa6, 0x65, 0xb4, 0x2c, 0x98, 0x7f, 0x80, 0xf2, 0x9e, 0x02, 0xd4, 0x5e, 0x16, 0x24, 0xd8,
-	0x79, 0x70, 0x44, 0xe4, 0xa9, 0x14, 0xb5, 0x34, 0x21, 0x76, 0x37, 0xc4, 0x26, 0x54, 0x41, 0x96,
-	0x32, 0xa7, 0x0c, 0xdf, 0x30, 0xc7, 0xd7, 0x5a, 0x33, 0x13, 0x48, 0x58, 0x2d, 0x79, 0x0d, 0xb7,
-	0x8c, 0x7d, 0x19, 0xc1, 0x84, 0xdc, 0xfd, 0x80, 0x6f, 0x6a, 0x57, 0xc1, 0x0c, 0xc7, 0xf1, 0x31,
-	0xaa, 0x39, 0x15, 0x14, 0x10, 0xbf, 0x2b, 0x98, 0x53, 0x60, 0xdc, 0x22, 0x5e, 0x37, 0x8e, 0x1c,
-	0xdb, 0xdb, 0x88, 0x45, 0xf5, 0x50, 0x01, 0xb3, 0x09, 0xd6, 0x64, 0x60, 0x8b, 0xe0, 0x68, 0x88,
-	0x2a, 0x6a, 0x31, 0x04, 0xfb, 0x1a, 0x43, 0x0b, 0x8b, 0x68, 0x53, 0xdc, 0xf3, 0xab, 0x5c, 0xcf,
-	0x79, 0x59, 0x44, 0x2d, 0xd5, 0x41, 0x45, 0xa4, 0x5d, 0x95, 0xce, 0x3e, 0x0a, 0x88, 0x8f, 0xec,
-	0xc1, 0xce, 0xe0, 0x24, 0x48, 0xed, 0xe1, 0x03, 0x59, 0x6f, 0xec, 0xb3, 0xc3, 0xfd, 0xaa, 0x74,
-	0xdf, 0x32, 0x26, 0xdd, 0x4f, 0x81, 0x91, 0x7d, 0x54, 0x6b, 0x84, 0xce, 0xc5, 0x42, 0x7b, 0x0b,
-	0x4c, 0xca, 0x52, 0xaa, 0xbc, 0x52, 0x90, 0x8b, 0xe0, 0x58, 0x87, 0x9e, 0x74, 0x01, 0x41, 0x9a,
-	0xd5, 0x3e, 0xd7, 0x1a, 0x37, 0xf9, 0xb7, 0x76, 0x5b, 0xbe, 0xf8, 0xdd, 0xe6, 0x35, 0x62, 0xd3,
-	0xd0, 0x05, 0x04, 0x69, 0xfe, 0x62, 0x84, 0x7d, 0xfe, 0x0d, 0x2f, 0x01, 0xd0, 0xee, 0x5d, 0x3c,
-	0xb6, 0xdc, 0xfa, 0x42, 0xf8, 0xe4, 0x59, 0xa3, 0xd3, 0x45, 0x9b, 0x94, 0x8d, 0x4e, 0xbf, 0xd1,
-	0x4e, 0x95, 0xd9, 0xa1, 0xd9, 0x01, 0xf2, 0x81, 0x22, 0x13, 0x1b, 0x3a, 0x97, 0x38, 0x97, 0x41,
-	0xba, 0x46, 0x6c, 0x16, 0x5d, 0x6a, 0x29, 0xb7, 0x7e, 0x22, 0xde, 0x56, 0xae, 0x11, 0xdb, 0xe4,
-	0x22, 0x70, 0xa7, 0x07, 0xa8, 0xc5, 0x81, 0xa0, 0x84, 0x9f, 0x4e, 0x54, 0xad, 0xce, 0x77, 0x03,
-	0xf9, 0xc8, 0x0d, 0xf3, 0xa0, 0x99, 0x12, 0x60, 0xb8, 0x2b, 0x01, 0xbe, 0x03, 0x46, 0xeb, 0x7c,
-	0x47, 0x76, 0xbe, 0x7c, 0x1c, 0xa2, 0xd0, 0xd8, 0x1c, 0x7b, 0xfa, 0xbc, 0x38, 0xf4, 0xfd, 0x1f,
-	0x3f, 0xae, 0x28, 0xa6, 0x54, 0xd1, 0x9e, 0x28, 0xe0, 0xc8, 0x76, 0x50, 0xdd, 0x42, 0xb5, 0x5a,
-	0x47, 0xba, 0x91, 0x6f, 0xd3, 0xf0, 0x62, 0xd8, 0x37, 0x3c, 0x09, 0x32, 0x36, 0xa2, 0x25, 0x0b,
-	0xd5, 0xe5, 0x1b, 0x19, 0xb5, 0x11, 0xdd, 0x42, 0x75, 0xf8, 0x29, 0x98, 0xac, 0xfb, 0xa4, 0x4e,
-	0x28, 0xf6, 0x5b, 0xef, 0x8c, 0xbd, 0x91, 0xf1, 0xcd, 0xf5, 0xbf, 0x9e, 0x17, 0x75, 0xdb, 0x09,
-	0xaa, 0x8d, 0xb2, 0x6e, 0x11, 0xd7, 0x90, 0x7d, 0x5e, 0xfc, 0x77, 0x8e, 0x56, 0xf6, 0x8c, 0xe0,
-	0xa0, 0x8e, 0xa9, 0xbe, 0xd5, 0x7e, 0xe0, 0xe6, 0xd1, 0xd0, 0x56, 0xf8, 0x38, 0xa7, 0x41, 0xd6,
-	0x62, 0x5d, 0xbb, 0xe4, 0x54, 0xf2, 0xe9, 0x39, 0x65, 0x29, 0x65, 0x66, 0xf8, 0xfa, 0x4a, 0x45,
-	0xdb, 0x05, 0xc7, 0xb7, 0x69, 0xe0, 0xb8, 0x28, 0xc0, 0x3b, 0xa8, 0x9d, 0x8d, 0x49, 0x90, 0xb2,
-	0x91, 0x00, 0x9f, 0x36, 0xd9, 0x27, 0xdb, 0xf1, 0x71, 0xc0, 0x71, 0x8f, 0x9b, 0xec, 0x93, 0x59,
-	0xdd, 0x77, 0x4b, 0xd8, 0xf7, 0x89, 0x78, 0xd0, 0x63, 0x66, 0x66, 0xdf, 0xdd, 0x66, 0x4b, 0xed,
-	0x41, 0x3a, 0xac, 0x02, 0x1f, 0x59, 0x78, 0xb7, 0x19, 0x26, 0x65, 0x0d, 0xa4, 0x5c, 0x1a, 0xce,
-	0x96, 0x62, 0x3c, 0xc3, 0xd7, 0xa9, 0xbd, 0x1d, 0x54, 0xb1, 0x8f, 0x1b, 0xee, 0x6e, 0xd3, 0x64,
-	0xb2, 0xf0, 0x3d, 0x30, 0x1e, 0x30, 0x23, 0x25, 0x39, 0x97, 0x52, 0x49, 0x73, 0x89, 0xbb, 0x92,
-	0x73, 0x29, 0x17, 0xb4, 0x17, 0x70, 0x0b, 0x8c, 0xd7, 0x7d, 0x5c, 0xc1, 0x16, 0xa6, 0x94, 0xf8,
-	0x34, 0x9f, 0xe6, 0x25, 0x38, 0xd0, 0x7b, 0x44, 0x89, 0xf5, 0xd5, 0x72, 0x8d, 0x58, 0x7b, 0x61,
-	0x07, 0x1b, 0xe1, 0x69, 0xcc, 0xf1, 0x3d, 0xd1, 0xbf, 0xe0, 0x2c, 0x00, 0x42, 0x84, 0x3f, 0xb3,
-	0x51, 0x9e, 0x91, 0x31, 0xbe, 0xc3, 0x27, 0xd3, 0xe5, 0xf0, 0x98, 0xcd, 0xf5, 0x7c, 0x86, 0x87,
-	0xa1, 0xea, 0x62, 0xe8, 0xeb, 0xe1, 0xd0, 0xd7, 0x77, 0xc3, 0xa1, 0xbf, 0x39, 0xc1, 0xca, 0xec,
-	0xd1, 0xaf, 0x45, 0x45, 0x94, 0x9a, 0xb0, 0xc4, 0x8e, 0x7b, 0x56, 0x4b, 0xf6, 0xdf, 0xa9, 0x96,
-	0xb1, 0x48, 0xb5, 0x40, 0x0d, 0x4c, 0x88, 0x18, 0x5c, 0xd4, 0x2c, 0xb1, 0x02, 0x01, 0x1d, 0x69,
-	0xb8, 0x8e, 0x9a, 0x3b, 0x88, 0xbe, 0x9f, 0xce, 0x0e, 0x4f, 0xa6, 0xcc, 0x6c, 0xd0, 0x2c, 0x39,
-	0x5e, 0x05, 0x37, 0xb5, 0x15, 0xd9, 0x1c, 0x5b, 0xa5, 0xd0, 0xee, 0x5c, 0x15, 0x14, 0xa0, 0xf0,
-	0x81, 0xb0, 0x6f, 0xed, 0x49, 0x0a, 0xfc, 0xaf, 0x2d, 0xbc, 0xc9, 0xac, 0x76, 0x94, 0x4e, 0xd0,
-	0x0c, 0xfb, 0xc7, 0xe0, 0xd2, 0x09, 0x9a, 0xf4, 0x35, 0x94, 0xce, 0x7f, 0xb7, 0x7e, 0xc8, 0x5b,
-	0xd7, 0xce, 0x81, 0x93, 0xb1, 0x8b, 0xeb, 0x73, 0xd1, 0x27, 0x5a, 0xb3, 0x9e, 0xe2, 0x4b, 0x18,
-	0xb7, 0x59, 0xe9, 0x54, 0x74, 0x5b, 0x9a, 0xb8, 0x00, 0xb2, 0xac, 0xf1, 0x97, 0x6e, 0x62, 0x39,
-	0x4b, 0x37, 0xa7, 0x7f, 0x79, 0x5e, 0x3c, 0x21, 0x22, 0xa4, 0x95, 0x3d, 0xdd, 0x21, 0x86, 0x8b,
-	0x82, 0xaa, 0x7e, 0xc5, 0x0b, 0xd8, 0x8c, 0xe7, 0xda, 0x5a, 0x51, 0xb2, 0x9b, 0x9d, 0x1a, 0x29,
-	0xa3, 0xda, 0x75, 0xc7, 0xdb, 0x41, 0xf4, 0x86, 0xef, 0xb4, 0xa8, 0x85, 0x66, 0x81, 0x42, 0x92,
-	0x80, 0x74, 0xbc, 0x01, 0x26, 0x5c, 0xc7, 0x63, 0x41, 0x97, 0xea, 0xec, 0x40, 0x7a, 0x9f, 0x65,
-	0xb7, 0x94, 0x8c, 0x20, 0xe7, 0xb6, 0x4d, 0xad, 0x7f, 0x7d, 0x14, 0x8c, 0x70, 0x2f, 0xf0, 0x2b,
-	0x05, 0x64, 0x24, 0xc1, 0x82, 0xf3, 0xf1, 0x2a, 0xec, 0xc1, 0xa0, 0xd5, 0x85, 0x41, 0x62, 0x02,
-	0xa7, 0x76, 0xf6, 0xcb, 0x9f, 0x7e, 0xff, 0x66, 0x78, 0x1e, 0x9e, 0x36, 0x62, 0xbf, 0x08, 0x48,
-	0x92, 0x65, 0xdc, 0x91, 0x45, 0x73, 0x17, 0x7e, 0xab, 0x80, 0x89, 0x08, 0x8f, 0x85, 0x67, 0x13,
-	0xdc, 0xf4, 0xe2, 0xcb, 0xea, 0xea, 0xe1, 0x84, 0x25, 0xb2, 0x75, 0x8e, 0x6c, 0x15, 0xae, 0xc4,
-	0x91, 0x85, 0x94, 0x39, 0x06, 0xf0, 0x07, 0x05, 0x4c, 0x76, 0x53, 0x52, 0xa8, 0x27, 0xb8, 0x4d,
-	0x60, 0xc2, 0xaa, 0x71, 0x68, 0x79, 0x89, 0xf4, 0x22, 0x47, 0x7a, 0x01, 0xae, 0xc7, 0x91, 0xee,
-	0x87, 0x3a, 0x6d, 0xb0, 0x9d, 0x2c, 0xfb, 0x2e, 0xbc, 0xa7, 0x80, 0x8c, 0x24, 0x9f, 0x89, 0x57,
-	0x1b, 0xe5, 0xb5, 0x89, 0x57, 0xdb, 0xc5, 0x61, 0xb5, 0x55, 0x0e, 0x6b, 0x01, 0x9e, 0x89, 0xc3,
-	0x92, 0x64, 0x96, 0x76, 0xa4, 0xee, 0xa1, 0x02, 0x32, 0x92, 0x86, 0x26, 0x02, 0x89, 0x72, 0xde,
-	0x44, 0x20, 0x5d, 0x6c, 0x56, 0x5b, 0xe3, 0x40, 0xce, 0xc2, 0xe5, 0x38, 0x10, 0x2a, 0x44, 0xdb,
-	0x38, 0x8c, 0x3b, 0x7b, 0xf8, 0xe0, 0x2e, 0xbc, 0x0d, 0xd2, 0x8c, 0xad, 0x42, 0x2d, 0xb1, 0x64,
-	0x5a, 0x14, 0x58, 0x3d, 0xdd, 0x57, 0x46, 0x62, 0x58, 0xe6, 0x18, 0x4e, 0xc3, 0x53, 0xbd, 0xaa,
-	0xa9, 0x12, 0xc9, 0xc4, 0x67, 0x60, 0x54, 0x10, 0x36, 0x78, 0x26, 0xc1, 0x72, 0x84, 0x17, 0xaa,
-	0xf3, 0x03, 0xa4, 0x24, 0x82, 0x39, 0x8e, 0x40, 0x85, 0xf9, 0x38, 0x02, 0x41, 0x06, 0x61, 0x13,
-	0x64, 0x24, 0x17, 0x84, 0x73, 0x71, 0x9b, 0x51, 0x9a, 0xa8, 0x2e, 0x0e, 0x9a, 0x64, 0xa1, 0x5f,
-	0x8d, 0xfb, 0x9d, 0x81, 0x6a, 0xdc, 0x2f, 0x0e, 0xaa, 0x25, 0x8b, 0xb9, 0xfb, 0x02, 0xe4, 0x3a,
-	0xc8, 0xdc, 0x21, 0xbc, 0xf7, 0x88, 0xb9, 0x07, 0x1b, 0xd4, 0x16, 0xb8, 0xef, 0x39, 0x58, 0xe8,
-	0xe1, 0x5b, 0x8a, 0xb3, 0x16, 0x09, 0x3f, 0x07, 0x19, 0x39, 0xe5, 0x13, 0x6b, 0x2f, 0x4a, 0x08,
-	0x13, 0x6b, 0xaf, 0x8b, 0x2c, 0xf4, 0x8b, 0x5e, 0x8c, 0xf8, 0xa0, 0x09, 0xef, 0x2b, 0x00, 0xb4,
-	0xc7, 0x0f, 0x5c, 0xea, 0x67, 0xba, 0x93, 0x5a, 0xa8, 0xcb, 0x87, 0x90, 0x94, 0x38, 0xe6, 0x39,
-	0x8e, 0x22, 0x9c, 0x4d, 0xc2, 0xc1, 0x67, 0x22, 0x4b, 0x84, 0x1c, 0x61, 0x7d, 0xba, 0x41, 0xe7,
-	0xe4, 0xeb, 0xd3, 0x0d, 0x22, 0x93, 0xb0, 0x5f, 0x22, 0xc2, 0x09, 0xc9, 0x2a, 0x5f, 0xf2, 0x97,
-	0x33, 0x89, 0x6f, 0xaa, 0xe3, 0x6f, 0x41, 0x89, 0x95, 0x1f, 0xfd, 0xdb, 0x50, 0xbf, 0xca, 0x17,
-	0x04, 0x0b, 0x7e, 0xa7, 0x80, 0x63, 0xb1, 0x59, 0x0a, 0x93, 0x1a, 0x71, 0xd2, 0x58, 0x56, 0xcf,
-	0x1f, 0x5e, 0x41, 0x42, 0x5b, 0xe4, 0xd0, 0x4e, 0xc1, 0x62, 0x1c, 0x5a, 0x64, 0x7c, 0x6f, 0x5e,
-	0x7c, 0xfa, 0xa2, 0xa0, 0x3c, 0x7b, 0x51, 0x50, 0x7e, 0x7b, 0x51, 0x50, 0x1e, 0xbd, 0x2c, 0x0c,
-	0x3d, 0x7b, 0x59, 0x18, 0xfa, 0xf9, 0x65, 0x61, 0xe8, 0x93, 0xb9, 0x38, 0x81, 0x62, 0x46, 0x9a,
-	0xcc, 0x0c, 0xa7, 0x4f, 0xe5, 0x51, 0x4e, 0xd7, 0xde, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x36,
-	0xbb, 0x12, 0xbc, 0x4c, 0x14, 0x00, 0x00,
+	// 1682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x9a, 0xb4, 0x48, 0x3d, 0x4a, 0x09, 0x3d, 0xa6, 0x1b, 0x7a, 0x6b, 0x91, 0xf4, 0xda,
+	0xa2, 0x3e, 0xac, 0xec, 0x5a, 0x6a, 0x5a, 0xa0, 0x2e, 0x82, 0x56, 0x12, 0x14, 0x25, 0x8d, 0x5d,
+	0xb8, 0xac, 0xd0, 0x43, 0x81, 0x82, 0x18, 0x2e, 0xc7, 0xcb, 0x85, 0xb8, 0x3b, 0xcc, 0xce, 0x52,
+	0xa5, 0x92, 0xba, 0x87, 0x02, 0x0d, 0x12, 0xe4, 0x12, 0xa0, 0x40, 0x8f, 0x6d, 0x80, 0x5e, 0x7a,
+	0x6b, 0x6f, 0xf9, 0x17, 0x72, 0x0c, 0xd0, 0x4b, 0xd1, 0x83, 0x5b, 0xd8, 0x05, 0xda, 0xbf, 0xa1,
+	0xa7, 0x62, 0x3e, 0x56, 0xdc, 0xd5, 0x72, 0xb5, 0x74, 0x91, 0xde, 0x0a, 0x10, 0xf6, 0xce, 0x9b,
+	0xf7, 0xf1, 0x9b, 0x37, 0x6f, 0xde, 0xfb, 0xd9, 0x70, 0xcb, 0xa6, 0xcc, 0xa3, 0xcc, 0x22, 0xa7,
+	0x9e, 0xc5, 0x7f, 0x3b, 0xd6, 0x7b, 0x63, 0x12, 0x9c, 0x99, 0xa3, 0x80, 0x86, 0x14, 0x55, 0xe5,
+	0xae, 0x49, 0x4e, 0x3d, 0x93, 0xff, 0x76, 0xf4, 0x6b, 0xd8, 0x73, 0x7d, 0x6a, 0x89, 0x3f, 0xa5,
+	0x92, 0xbe, 0xa5, 0x5c, 0xf4, 0x30, 0x23, 0xd2, 0xda, 0x3a, 0xdd, 0xe9, 0x91, 0x10, 0xef, 0x58,
+	0x23, 0xec, 0xb8, 0x3e, 0x0e, 0x5d, 0xea, 0x2b, 0xdd, 0x9a, 0x43, 0x1d, 0x2a, 0x3e, 0x2d, 0xfe,
+	0xa5, 0xa4, 0xb7, 0x1c, 0x4a, 0x9d, 0x21, 0xb1, 0xf0, 0xc8, 0xb5, 0xb0, 0xef, 0xd3, 0x50, 0x98,
+	0x30, 0xb5, 0xdb, 0x54, 0xbb, 0x62, 0xd5, 0x1b, 0x3f, 0xb1, 0x42, 0xd7, 0x23, 0x2c, 0xc4, 0xde,
+	0x48, 0x29, 0xe8, 0xa9, 0x33, 0x70, 0xbc, 0x72, 0xef, 0x66, 0x6a, 0x2f, 0x9c, 0xc8, 0x2d, 0xa3,
+	0x06, 0xe8, 0x87, 0x1c, 0xed, 0x01, 0xf5, 0x9f, 0xb8, 0x4e, 0x87, 0xbc, 0x37, 0x26, 0x2c, 0x34,
+	0x1e, 0xc2, 0xf5, 0x84, 0x94, 0x8d, 0xa8, 0xcf, 0x08, 0xfa, 0x26, 0x2c, 0xda, 0x42, 0x52, 0xd7,
+	0x5a, 0xda, 0x46, 0x65, 0x77, 0xd5, 0xbc, 0x98, 0x1a, 0xf3, 0x60, 0x80, 0x5d, 0x5f, 0x99, 0x29,
+	0x65, 0xe3, 0xdb, 0xca, 0xdb, 0x9e, 0x6d, 0xd3, 0xb1, 0x1f, 0xaa, 0x20, 0xa8, 0x0e, 0x25, 0xdc,
+	0xef, 0x07, 0x84, 0x31, 0xe1, 0x6e, 0xa9, 0x13, 0x2d, 0x1f, 0x94, 0x3f, 0xfa, 0xac, 0xb9, 0xf0,
+	0xaf, 0xcf, 0x9a, 0x0b, 0x86, 0x0d, 0xb5, 0xa4, 0xa9, 0x42, 0x52, 0x87, 0x52, 0x0f, 0x0f, 0xb1,
+	0x6f, 0x93, 0xc8, 0x56, 0x2d, 0xd1, 0xd7, 0x61, 0xc9, 0xa6, 0x7d, 0xd2, 0x1d, 0x60, 0x36, 0xa8,
+	0x5f, 0x11, 0x7b, 0x65, 0x2e, 0x78, 0x1b, 0xb3, 0x01, 0xaa, 0xc1, 0x55, 0x9f, 0x72, 0xa3, 0x42,
+	0x4b, 0xdb, 0x28, 0x76, 0xe4, 0xc2, 0xf8, 0x2e, 0xdc, 0x54, 0xa7, 0xe5, 0x87, 0xf9, 0x2f, 0x50,
+	0x7e, 0xa8, 0x81, 0x3e, 0xcb, 0x83, 0x02, 0xbb, 0x06, 0xaf, 0xc8, 0x3c, 0x75, 0x93, 0x9e, 0x56,
+	0xa4, 0x74, 0x4f, 0x0a, 0x91, 0x0e, 0x65, 0xc6, 0x83, 0x72, 0x7c, 0x57, 0x04, 0xbe, 0xf3, 0x35,
+	0x77, 0x81, 0xa5, 0xd7, 0xae, 0x3f, 0xf6, 0x7a, 0x24, 0x50, 0x27, 0x58, 0x51, 0xd2, 0x1f, 0x08,
+	0xa1, 0xf1, 0x2e, 0xdc, 0x12, 0x38, 0x7e, 0x8c, 0x87, 0x6e, 0x1f, 0x87, 0x34, 0xb8, 0x70, 0x98,
+	0xdb, 0xb0, 0x6c, 0x53, 0xff, 0x22, 0x8e, 0x0a, 0x97, 0xed, 0xa5, 0x4e, 0xf5, 0x89, 0x06, 0xab,
+	0x19, 0xde, 0xd4, 0xc1, 0xd6, 0xe1, 0xd5, 0x08, 0x55, 0xd2, 0x63, 0x04, 0xf6, 0x2b, 0x3c, 0x5a,
+	0x54, 0x44, 0xfb, 0xf2, 0x9e, 0x5f, 0xe6, 0x7a, 0xee, 0x43, 0x2d, 0x69, 0x9a, 0x57, 0x44, 0xc6,
+	0xbb, 0x2a, 0xd8, 0x8f, 0x42, 0x1a, 0x60, 0x27, 0x3f, 0x18, 0xaa, 0x42, 0xe1, 0x84, 0x9c, 0xa9,
+	0x7a, 0xe3, 0x9f, 0xb1, 0xf0, 0xdb, 0x50, 0x4b, 0x3a, 0x53, 0xe1, 0x6b, 0x70, 0xf5, 0x14, 0x0f,
+	0xc7, 0x51, 0x70, 0xb9, 0x30, 0xde, 0x84, 0xd7, 0x12, 0xda, 0x94, 0xbe, 0x54, 0x29, 0xbe, 0x09,
+	0xf5, 0xb4, 0xb9, 0x0a, 0x78, 0x1b, 0x96, 0x99, 0x14, 0x77, 0x03, 0x4a, 0xc3, 0xe8, 0xf6, 0xd9,
+	0x54, 0xd5, 0xf8, 0x16, 0x54, 0x55, 0x21, 0xf7, 0x5f, 0x2a, 0xc5, 0xeb, 0x70, 0x2d, 0x66, 0xa7,
+	0xe2, 0x21, 0x28, 0xf2, 0x97, 0x27, 0xac, 0x96, 0x3b, 0xe2, 0xdb, 0x78, 0x5f, 0xf5, 0x9b, 0xe3,
+	0xc9, 0x43, 0xea, 0xb0, 0x28, 0x04, 0x82, 0xa2, 0x78, 0xaf, 0xd2, 0xbf, 0xf8, 0x46, 0x6f, 0x01,
+	0x4c, 0x3b, 0xa7, 0xc8, 0x6c, 0x65, 0xb7, 0x1d, 0x35, 0x1c, 0xde, 0x66, 0x4d, 0xd9, 0xa4, 0x55,
+	0x9b, 0x35, 0x1f, 0x4f, 0x2f, 0xaa, 0x13, 0xb3, 0x8c, 0x81, 0xfc, 0x58, 0x83, 0xeb, 0x89, 0xe0,
+	0x0a, 0xe7, 0x26, 0x14, 0x87, 0xd4, 0xe1, 0xa7, 0x2b, 0x6c, 0x54, 0x76, 0x6f, 0xa4, 0x9b, 0xda,
+	0x43, 0xea, 0x74, 0x84, 0x0a, 0x3a, 0x9a, 0x01, 0x6a, 0x3d, 0x17, 0x94, 0x8c, 0x13, 0x47, 0x75,
+	0xde, 0x77, 0x1f, 0xe3, 0x00, 0x7b, 0x51, 0x1e, 0x8c, 0x0e, 0x5c, 0x4f, 0x48, 0x15, 0xc0, 0xef,
+	0xc0, 0xe2, 0x48, 0x48, 0x54, 0xdf, 0xad, 0xa7, 0x21, 0x4a, 0x8b, 0xfd, 0xa5, 0x2f, 0x9e, 0x35,
+	0x17, 0xfe, 0xf0, 0xcf, 0x3f, 0x6d, 0x69, 0x1d, 0x65, 0x62, 0x7c, 0xae, 0xc1, 0x2b, 0x87, 0xe1,
+	0xe0, 0x00, 0x0f, 0x87, 0xb1, 0x74, 0xe3, 0xc0, 0x61, 0xd1, 0xc5, 0xf0, 0x6f, 0xf4, 0x1a, 0x94,
+	0x1c, 0xcc, 0xba, 0x36, 0x1e, 0xa9, 0x17, 0xba, 0xe8, 0x60, 0x76, 0x80, 0x47, 0xe8, 0xa7, 0x50,
+	0x1d, 0x05, 0x74, 0x44, 0x19, 0x09, 0xce, 0x5f, 0x39, 0x7f, 0xa1, 0xcb, 0xfb, 0xbb, 0xff, 0x7e,
+	0xd6, 0x34, 0x1d, 0x37, 0x1c, 0x8c, 0x7b, 0xa6, 0x4d, 0x3d, 0x4b, 0x4d, 0x19, 0xf9, 0xd7, 0xeb,
+	0xac, 0x7f, 0x62, 0x85, 0x67, 0x23, 0xc2, 0xcc, 0x83, 0x69, 0x7b, 0xe9, 0xbc, 0x1a, 0xf9, 0x52,
+	0x02, 0x74, 0x13, 0xca, 0x36, 0x9f, 0x19, 0x5d, 0xb7, 0x5f, 0x2f, 0xb6, 0xb4, 0x8d, 0x42, 0xa7,
+	0x24, 0xd6, 0xef, 0xf4, 0x8d, 0x63, 0xb8, 0x7e, 0xc8, 0x42, 0xd7, 0xc3, 0x21, 0x39, 0xc2, 0xd3,
+	0x6c, 0x54, 0xa1, 0xe0, 0x60, 0x09, 0xbe, 0xd8, 0xe1, 0x9f, 0x5c, 0x12, 0x90, 0x50, 0xe0, 0x5e,
+	0xee, 0xf0, 0x4f, 0xee, 0xf5, 0xd4, 0xeb, 0x92, 0x20, 0xa0, 0xb2, 0x9d, 0x2c, 0x75, 0x4a, 0xa7,
+	0xde, 0x21, 0x5f, 0x1a, 0x1f, 0x17, 0xa3, 0x2a, 0x08, 0xb0, 0x4d, 0x8e, 0x27, 0x51, 0x52, 0x76,
+	0xa0, 0xe0, 0xb1, 0x68, 0xb2, 0x35, 0xd3, 0x19, 0x7e, 0xc4, 0x9c, 0xc3, 0x70, 0x40, 0x02, 0x32,
+	0xf6, 0x8e, 0x27, 0x1d, 0xae, 0x8b, 0xbe, 0x07, 0xcb, 0x21, 0x77, 0xd2, 0x55, 0x53, 0xb1, 0x90,
+	0x35, 0x15, 0x45, 0x28, 0x35, 0x15, 0x2b, 0xe1, 0x74, 0x81, 0x0e, 0x60, 0x79, 0x14, 0x90, 0x3e,
+	0xb1, 0x09, 0x63, 0x34, 0x60, 0xf5, 0x62, 0xab, 0x30, 0x4f, 0xf4, 0x84, 0x11, 0x7f, 0xd7, 0xbd,
+	0x21, 0xb5, 0x4f, 0xa2, 0xfe, 0x79, 0x55, 0xa4, 0xb1, 0x22, 0x64, 0xb2, 0x7b, 0xa2, 0x55, 0x00,
+	0xa9, 0x22, 0x9e, 0xd9, 0xa2, 0xc8, 0xc8, 0x92, 0x90, 0x88, 0xb9, 0xf8, 0x76, 0xb4, 0xcd, 0x59,
+	0x45, 0xbd, 0x24, 0x8e, 0xa1, 0x9b, 0x92, 0x72, 0x98, 0x11, 0xe5, 0x30, 0x8f, 0x23, 0xca, 0xb1,
+	0xbf, 0xc2, 0xcb, 0xec, 0xd3, 0xbf, 0x35, 0x35, 0x59, 0x6a, 0xd2, 0x13, 0xdf, 0x9e, 0x59, 0x2d,
+	0xe5, 0xff, 0x4d, 0xb5, 0x2c, 0x25, 0xaa, 0x05, 0x19, 0xb0, 0x22, 0xcf, 0xe0, 0xe1, 0x49, 0x97,
+	0x17, 0x08, 0xc4, 0xd2, 0xf0, 0x08, 0x4f, 0x8e, 0x30, 0xfb, 0x7e, 0xb1, 0x7c, 0xa5, 0x5a, 0xe8,
+	0x94, 0xc3, 0x49, 0xd7, 0xf5, 0xfb, 0x64, 0x62, 0x6c, 0xa9, 0xd6, 0x7c, 0x5e, 0x0a, 0xd3, 0xce,
+	0xd5, 0xc7, 0x21, 0x8e, 0x1e, 0x08, 0xff, 0x36, 0x3e, 0x2f, 0xc0, 0xd7, 0xa6, 0xca, 0xfb, 0xdc,
+	0x6b, 0xac, 0x74, 0xc2, 0x49, 0xd4, 0x3f, 0xf2, 0x4b, 0x27, 0x9c, 0xb0, 0xaf, 0xa0, 0x74, 0xfe,
+	0x7f, 0xeb, 0x73, 0xde, 0xba, 0xf1, 0xba, 0x1a, 0xa9, 0xf1, 0x8b, 0xbb, 0xe4, 0xa2, 0x6f, 0x9c,
+	0x33, 0x0d, 0x46, 0xde, 0x22, 0x64, 0xca, 0x89, 0x6b, 0x49, 0xb1, 0x72, 0xf1, 0x06, 0x94, 0x79,
+	0xe3, 0xef, 0x3e, 0x21, 0x6a, 0x92, 0xef, 0xdf, 0xfc, 0xeb, 0xb3, 0xe6, 0x0d, 0x79, 0x42, 0xd6,
+	0x3f, 0x31, 0x5d, 0x6a, 0x79, 0x38, 0x1c, 0x98, 0xef, 0xf8, 0x21, 0x67, 0x18, 0xc2, 0xda, 0x68,
+	0x2a, 0x6e, 0x75, 0x34, 0xa4, 0x3d, 0x3c, 0x7c, 0xe4, 0xfa, 0x47, 0x98, 0x3d, 0x0e, 0xdc, 0x73,
+	0x62, 0x63, 0xd8, 0xd0, 0xc8, 0x52, 0x50, 0x81, 0xf7, 0x60, 0xc5, 0x73, 0x7d, 0x7e, 0xe8, 0xee,
+	0x88, 0x6f, 0xa8, 0xe8, 0xab, 0xfc, 0x96, 0xb2, 0x11, 0x54, 0xbc, 0xa9, 0xab, 0xdd, 0xdf, 0x57,
+	0xe1, 0xaa, 0x88, 0x82, 0x7e, 0xa5, 0x41, 0x49, 0xd1, 0x3b, 0xb4, 0x96, 0xae, 0xc2, 0x19, 0xfc,
+	0x5d, 0x6f, 0xe7, 0xa9, 0x49, 0x9c, 0xc6, 0xbd, 0x5f, 0xfe, 0xf9, 0x1f, 0xbf, 0xbe, 0xb2, 0x86,
+	0xee, 0x58, 0xa9, 0x7f, 0x86, 0x28, 0x8a, 0x67, 0x7d, 0xa0, 0x8a, 0xe6, 0x29, 0xfa, 0xad, 0x06,
+	0x2b, 0x09, 0x16, 0x8d, 0xee, 0x65, 0x84, 0x99, 0xc5, 0xd6, 0xf5, 0xed, 0xf9, 0x94, 0x15, 0xb2,
+	0x5d, 0x81, 0x6c, 0x1b, 0x6d, 0xa5, 0x91, 0x45, 0x84, 0x3d, 0x05, 0xf0, 0x8f, 0x1a, 0x54, 0x2f,
+	0x12, 0x62, 0x64, 0x66, 0x84, 0xcd, 0xe0, 0xe1, 0xba, 0x35, 0xb7, 0xbe, 0x42, 0xfa, 0x40, 0x20,
+	0x7d, 0x03, 0xed, 0xa6, 0x91, 0x9e, 0x46, 0x36, 0x53, 0xb0, 0x71, 0x8e, 0xff, 0x14, 0x7d, 0xa8,
+	0x41, 0x49, 0x51, 0xdf, 0xcc, 0xab, 0x4d, 0xb2, 0x6a, 0xbd, 0x9d, 0xa7, 0xa6, 0x60, 0x6d, 0x0b,
+	0x58, 0x6d, 0x74, 0x37, 0x0d, 0x4b, 0x51, 0x69, 0x16, 0x4b, 0xdd, 0x27, 0x1a, 0x94, 0x14, 0x2f,
+	0xcd, 0x04, 0x92, 0x64, 0xdc, 0x7a, 0x3b, 0x4f, 0x4d, 0x01, 0xd9, 0x11, 0x40, 0xee, 0xa1, 0xcd,
+	0x34, 0x10, 0x45, 0x6f, 0xa7, 0x38, 0xac, 0x0f, 0x4e, 0xc8, 0xd9, 0x53, 0xf4, 0x1b, 0x0d, 0x2a,
+	0x31, 0x96, 0x8c, 0x36, 0x73, 0x42, 0x4d, 0x89, 0xb8, 0xbe, 0x35, 0x8f, 0xaa, 0x42, 0x76, 0x5f,
+	0x20, 0xdb, 0x42, 0x1b, 0x99, 0xc8, 0x04, 0x19, 0x8f, 0xa5, 0xe9, 0x7d, 0x28, 0x72, 0x1a, 0x8d,
+	0x8c, 0xcc, 0x5a, 0x3e, 0xe7, 0xe6, 0xfa, 0x9d, 0x4b, 0x75, 0x14, 0x84, 0x4d, 0x01, 0xe1, 0x0e,
+	0xba, 0x3d, 0xab, 0xcc, 0xfb, 0x89, 0x2b, 0xfa, 0x19, 0x2c, 0x4a, 0x26, 0x89, 0xee, 0x66, 0x78,
+	0x4e, 0x10, 0x56, 0x7d, 0x2d, 0x47, 0x4b, 0x21, 0x68, 0x09, 0x04, 0x3a, 0xaa, 0xa7, 0x11, 0x48,
+	0x96, 0x8a, 0x26, 0x50, 0x52, 0x24, 0x15, 0xb5, 0xd2, 0x3e, 0x93, 0xfc, 0x55, 0x5f, 0xcf, 0x1b,
+	0xb1, 0x51, 0x5c, 0x43, 0xc4, 0xbd, 0x85, 0xf4, 0x74, 0x5c, 0x12, 0x0e, 0xba, 0x36, 0x0f, 0xf7,
+	0x0b, 0xa8, 0xc4, 0x58, 0xe6, 0x1c, 0xd1, 0x67, 0x9c, 0x79, 0x06, 0x4d, 0x35, 0xda, 0x22, 0x76,
+	0x0b, 0x35, 0x66, 0xc4, 0x56, 0xea, 0xbc, 0x77, 0xa3, 0x9f, 0x43, 0x49, 0xd1, 0x8f, 0xcc, 0x47,
+	0x91, 0x64, 0xaa, 0x7a, 0x3b, 0x4f, 0x2d, 0xff, 0xf4, 0x92, 0x7b, 0x84, 0x13, 0xf4, 0x91, 0x06,
+	0x30, 0x9d, 0x8b, 0x68, 0xe3, 0x32, 0xd7, 0x71, 0xce, 0xa3, 0x6f, 0xce, 0xa1, 0xa9, 0x70, 0xac,
+	0x09, 0x1c, 0x4d, 0xb4, 0x9a, 0x85, 0x43, 0x0c, 0x6b, 0x9e, 0x08, 0x35, 0x5b, 0x2f, 0x69, 0x53,
+	0xf1, 0x91, 0xac, 0xb7, 0xf3, 0xd4, 0xf2, 0x13, 0x11, 0x8d, 0x6e, 0x5e, 0xf9, 0x8a, 0x58, 0xdd,
+	0xcd, 0x7c, 0x53, 0xb1, 0xff, 0x22, 0xd3, 0xd7, 0x72, 0xb4, 0xf2, 0x2b, 0x5f, 0x32, 0x3f, 0xf4,
+	0x3b, 0x0d, 0xae, 0xa5, 0x86, 0x3c, 0xca, 0x9a, 0x10, 0x59, 0x7c, 0x41, 0xbf, 0x3f, 0xbf, 0x81,
+	0x82, 0xb6, 0x2e, 0xa0, 0xdd, 0x46, 0xcd, 0x34, 0xb4, 0x04, 0xaf, 0xd8, 0x7f, 0xf0, 0xc5, 0xf3,
+	0x86, 0xf6, 0xe5, 0xf3, 0x86, 0xf6, 0xf7, 0xe7, 0x0d, 0xed, 0xd3, 0x17, 0x8d, 0x85, 0x2f, 0x5f,
+	0x34, 0x16, 0xfe, 0xf2, 0xa2, 0xb1, 0xf0, 0x93, 0x56, 0x9a, 0xd9, 0x71, 0x27, 0x13, 0xee, 0x46,
+	0xf0, 0xba, 0xde, 0xa2, 0xe0, 0x91, 0xdf, 0xf8, 0xcf, 0x00, 0xf7, 0xb0, 0x4a, 0x85, 0x63, 0x15,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1646,6 +1742,9 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageRoot queries the root hash of a trie built over the raw storage of
+	// an account. The root is not the Ethereum storage trie root.
+	StorageRoot(ctx context.Context, in *QueryStorageRootRequest, opts ...grpc.CallOption) (*QueryStorageRootResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
@@ -1725,6 +1824,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) StorageRoot(ctx context.Context, in *QueryStorageRootRequest, opts ...grpc.CallOption) (*QueryStorageRootResponse, error) {
+	out := new(QueryStorageRootResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/StorageRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Code", in, out, opts...)
@@ -1820,6 +1928,9 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageRoot queries the root hash of a trie built over the raw storage of
+	// an account. The root is not the Ethereum storage trie root.
+	StorageRoot(context.Context, *QueryStorageRootRequest) (*QueryStorageRootResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
@@ -1865,6 +1976,9 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (*UnimplementedQueryServer) StorageRoot(ctx context.Context, req *QueryStorageRootRequest) (*QueryStorageRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRoot not implemented")
+}
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -1987,6 +2101,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/StorageRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRoot(ctx, req.(*QueryStorageRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -2149,6 +2281,7 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2173,6 +2306,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "StorageRoot",
+			Handler:    _Query_StorageRoot_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -2611,6 +2748,66 @@ func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStorageRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3451,6 +3648,32 @@ func (m *QueryStorageResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStorageRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryCodeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4845,6 +5068,170 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStorageRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
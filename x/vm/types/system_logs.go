@@ -0,0 +1,81 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// System logs are EVM logs that are not emitted by a transaction but by the
+// EVM module itself during BeginBlock and EndBlock, in order to expose the
+// validator infractions and the unbonding completions to the Ethereum JSON-RPC.
+// They are emitted from the slashing precompile address and are declared in its
+// ABI, so they can be decoded with the ISlashing interface.
+var (
+	// SystemLogsAddress is the address that emits the system logs.
+	SystemLogsAddress = common.HexToAddress(SlashingPrecompileAddress)
+
+	// ValidatorSlashedEvent is emitted when a validator is slashed.
+	ValidatorSlashedEvent = abi.NewEvent(
+		"ValidatorSlashed",
+		"ValidatorSlashed",
+		false,
+		abi.Arguments{
+			{Name: "validator", Type: mustNewType("address"), Indexed: true},
+			{Name: "power", Type: mustNewType("uint256")},
+			{Name: "burnedAmount", Type: mustNewType("uint256")},
+			{Name: "reason", Type: mustNewType("string")},
+		},
+	)
+
+	// ValidatorJailedEvent is emitted when a validator is jailed.
+	ValidatorJailedEvent = abi.NewEvent(
+		"ValidatorJailed",
+		"ValidatorJailed",
+		false,
+		abi.Arguments{
+			{Name: "validator", Type: mustNewType("address"), Indexed: true},
+		},
+	)
+
+	// UnbondingCompletedEvent is emitted when an unbonding delegation matures
+	// and the tokens are returned to the delegator.
+	UnbondingCompletedEvent = abi.NewEvent(
+		"UnbondingCompleted",
+		"UnbondingCompleted",
+		false,
+		abi.Arguments{
+			{Name: "validator", Type: mustNewType("address"), Indexed: true},
+			{Name: "delegator", Type: mustNewType("address"), Indexed: true},
+			{Name: "amount", Type: mustNewType("uint256")},
+		},
+	)
+)
+
+// NewSystemLog creates a system log for the given event, with the event signature
+// and the given addresses as topics and the packed non-indexed values as data.
+func NewSystemLog(event abi.Event, indexed []common.Address, values ...interface{}) (*Log, error) {
+	topics := make([]string, 0, len(indexed)+1)
+	topics = append(topics, event.ID.String())
+	for _, addr := range indexed {
+		topics = append(topics, common.BytesToHash(addr.Bytes()).String())
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Log{
+		Address: SystemLogsAddress.Hex(),
+		Topics:  topics,
+		Data:    data,
+	}, nil
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}